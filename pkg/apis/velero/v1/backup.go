@@ -82,6 +82,23 @@ type BackupSpec struct {
 	// VolumeSnapshotLocations is a list containing names of VolumeSnapshotLocations associated with this backup.
 	// +optional
 	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations,omitempty"`
+
+	// DefaultVolumesToRestic specifies whether restic should be used to take a
+	// backup of all pod volumes by default.
+	// +optional
+	// +nullable
+	DefaultVolumesToRestic *bool `json:"defaultVolumesToRestic,omitempty"`
+
+	// ItemWorkers is the number of items in each resource that are backed
+	// up concurrently. If unset, the server's default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ItemWorkers int `json:"itemWorkers,omitempty"`
+
+	// Cancel requests that the backup be stopped. A backup that's New or
+	// InProgress when it's set ends in the Canceled phase.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -179,7 +196,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Canceled;Deleting
 type BackupPhase string
 
 const (
@@ -206,16 +223,54 @@ const (
 	// prevented it from completing successfully.
 	BackupPhaseFailed BackupPhase = "Failed"
 
+	// BackupPhaseCanceled means the backup was stopped before it finished
+	// because it was canceled.
+	BackupPhaseCanceled BackupPhase = "Canceled"
+
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
 
+// BackupCompression is the compression format of a backup tarball.
+// +kubebuilder:validation:Enum=gzip;zstd;none
+type BackupCompression string
+
+const (
+	// BackupCompressionGzip means the backup tarball is gzip-compressed.
+	BackupCompressionGzip BackupCompression = "gzip"
+
+	// BackupCompressionZstd means the backup tarball is zstd-compressed.
+	BackupCompressionZstd BackupCompression = "zstd"
+
+	// BackupCompressionNone means the backup tarball is not compressed.
+	BackupCompressionNone BackupCompression = "none"
+)
+
 // BackupStatus captures the current status of a Velero backup.
 type BackupStatus struct {
 	// Version is the backup format version.
 	// +optional
 	Version int `json:"version,omitempty"`
 
+	// Compression is the compression format of the backup tarball. Backups
+	// without a value are gzip-compressed.
+	// +optional
+	Compression BackupCompression `json:"compression,omitempty"`
+
+	// EncryptionKeyID is the ID of the key that the backup's contents, log
+	// and metadata files in object storage are encrypted with. The key is
+	// read from the backup encryption keys secret in the Velero server's
+	// namespace. Backups without a value are not encrypted.
+	// +optional
+	EncryptionKeyID string `json:"encryptionKeyID,omitempty"`
+
+	// Checksums are the SHA-256 checksums of the backup's tarball, pod volume
+	// backups and volume snapshots files, keyed by file name. They're
+	// computed before the files are encrypted, if the backup is encrypted.
+	// +optional
+	// +nullable
+	Checksums map[string]string `json:"checksums,omitempty"`
+
 	// Expiration is when this Backup is eligible for garbage-collection.
 	// +optional
 	// +nullable
@@ -268,6 +323,78 @@ type BackupStatus struct {
 	// file in object storage.
 	// +optional
 	Errors int `json:"errors,omitempty"`
+
+	// FailureReason is an error that caused the entire backup to fail.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// Progress contains information about the backup's execution progress. Note
+	// that this information is best-effort only -- if Velero fails to update it
+	// during a backup for any reason, it may be inaccurate/stale.
+	// +optional
+	// +nullable
+	Progress *BackupProgress `json:"progress,omitempty"`
+
+	// Verification is the result of the most recent verification of the
+	// backup's data in object storage.
+	// +optional
+	// +nullable
+	Verification *BackupVerification `json:"verification,omitempty"`
+}
+
+// BackupVerificationPhase is a string representation of the lifecycle phase
+// of a backup verification.
+// +kubebuilder:validation:Enum=InProgress;Passed;Failed
+type BackupVerificationPhase string
+
+const (
+	// BackupVerificationPhaseInProgress means the backup is being verified.
+	BackupVerificationPhaseInProgress BackupVerificationPhase = "InProgress"
+
+	// BackupVerificationPhasePassed means no problems were found with the
+	// backup's data.
+	BackupVerificationPhasePassed BackupVerificationPhase = "Passed"
+
+	// BackupVerificationPhaseFailed means at least one problem was found
+	// with the backup's data.
+	BackupVerificationPhaseFailed BackupVerificationPhase = "Failed"
+)
+
+// BackupVerification records the result of verifying a backup's data in
+// object storage.
+type BackupVerification struct {
+	// Phase is the current state of the verification.
+	// +optional
+	Phase BackupVerificationPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the verification was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the verification was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Errors is a list of the problems found with the backup's data.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+}
+
+// BackupProgress stores information about the progress of a Backup's execution.
+type BackupProgress struct {
+	// TotalItems is the total number of items to be backed up. This number may change
+	// throughout the execution of the backup due to plugins that return additional related
+	// items to back up, and because items are only counted once their resource is listed.
+	// +optional
+	TotalItems int `json:"totalItems,omitempty"`
+
+	// ItemsBackedUp is the number of items that have actually been written to the
+	// backup tarball so far.
+	// +optional
+	ItemsBackedUp int `json:"itemsBackedUp,omitempty"`
 }
 
 // +genclient
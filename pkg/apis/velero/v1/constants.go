@@ -36,4 +36,13 @@ const (
 	// NamespaceScopedDir is the name of the directory containing namespace-scoped
 	// resource within a Velero backup.
 	NamespaceScopedDir = "namespaces"
+
+	// PreferredVersionDir is the suffix of the directory containing a resource's items
+	// at the API version preferred by the source cluster, e.g. "v1-preferredversion".
+	// Version directories are only written for backups taken with the
+	// EnableAPIGroupVersions feature flag enabled.
+	PreferredVersionDir = "-preferredversion"
+
+	// CSIFeatureFlag is the feature flag string that defines whether or not CSI features are being used.
+	CSIFeatureFlag = "EnableCSI"
 )
@@ -43,6 +43,24 @@ const (
 	// restic backups/restores).
 	PodVolumeOperationTimeoutAnnotation = "velero.io/pod-volume-timeout"
 
+	// VerifyRequestedAnnotation is the annotation key used to request that
+	// the Velero server verify a backup's data in object storage. The server
+	// removes the annotation once it has recorded the verification's result
+	// in the backup's status.
+	VerifyRequestedAnnotation = "velero.io/verify-requested"
+
+	// ServerInstanceIDAnnotation is the annotation key used to record which
+	// run of the Velero server started processing a backup or restore, so
+	// that ones left InProgress by a server that has since exited can be
+	// identified.
+	ServerInstanceIDAnnotation = "velero.io/server-instance-id"
+
+	// VerificationServerInstanceIDAnnotation is the annotation key used to
+	// record which run of the Velero server started verifying a backup, so
+	// that it can be reported when a verification that was left InProgress
+	// is run again.
+	VerificationServerInstanceIDAnnotation = "velero.io/verification-server-instance-id"
+
 	// StorageLocationLabel is the label key used to identify the storage
 	// location of a backup.
 	StorageLocationLabel = "velero.io/storage-location"
@@ -50,4 +68,8 @@ const (
 	// ResticVolumeNamespaceLabel is the label key used to identify which
 	// namespace a restic repository stores pod volume backups for.
 	ResticVolumeNamespaceLabel = "velero.io/volume-namespace"
+
+	// VolumeSnapshotLabel is the label key used to identify the CSI
+	// VolumeSnapshot that was created for a backed-up persistent volume claim.
+	VolumeSnapshotLabel = "velero.io/volume-snapshot-name"
 )
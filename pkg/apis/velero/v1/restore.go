@@ -16,7 +16,10 @@ limitations under the License.
 
 package v1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // RestoreSpec defines the specification for a Velero restore.
 type RestoreSpec struct {
@@ -80,11 +83,164 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// Hooks represent custom behaviors that should be executed during or after the restore.
+	// +optional
+	Hooks RestoreHooks `json:"hooks,omitempty"`
+
+	// ExistingResourcePolicy specifies the restore behavior for objects that already
+	// exist in the cluster. If empty, defaults to "none".
+	// +optional
+	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
+
+	// ResourceModifier is a reference to a ConfigMap, in the Velero server's
+	// namespace, containing rules for modifying the restored objects before
+	// they're created.
+	// +optional
+	// +nullable
+	ResourceModifier *corev1api.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
+	// DryRun specifies whether the restore should only report what it would
+	// do, without creating or modifying anything in the cluster. The plan of
+	// what would be restored is uploaded to object storage.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// ItemWorkers is the number of items that are restored concurrently.
+	// Items are only restored concurrently with items of resources that
+	// share their priority. If unset, the server's default is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ItemWorkers int `json:"itemWorkers,omitempty"`
+
+	// WaitForReady specifies whether the restore should wait, once all items
+	// have been restored, for the restored Deployments, StatefulSets, DaemonSets,
+	// PersistentVolumeClaims and Pods to become ready. Items that aren't ready
+	// when ReadyTimeout elapses are reported as warnings.
+	// +optional
+	WaitForReady bool `json:"waitForReady,omitempty"`
+
+	// ReadyTimeout is how long to wait for the restored items to become
+	// ready when WaitForReady is set. If unset, defaults to 10 minutes.
+	// +optional
+	ReadyTimeout metav1.Duration `json:"readyTimeout,omitempty"`
+
+	// Cancel requests that the restore be stopped. A restore that's New or
+	// InProgress when it's set ends in the Canceled phase.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// PolicyType defines how Velero should treat an object from the backup that
+// already exists in the cluster.
+// +kubebuilder:validation:Enum=none;update
+type PolicyType string
+
+const (
+	// PolicyTypeNone means that an existing in-cluster object is left as-is, and a
+	// warning is recorded if it differs from the backed-up version.
+	PolicyTypeNone PolicyType = "none"
+
+	// PolicyTypeUpdate means that an existing in-cluster object is patched to match
+	// the backed-up version.
+	PolicyTypeUpdate PolicyType = "update"
+)
+
+// RestoreHooks contains custom behaviors that should be executed during or after the restore.
+type RestoreHooks struct {
+	// Resources are hooks that should be executed when restoring individual instances of a resource.
+	// +optional
+	// +nullable
+	Resources []RestoreResourceHookSpec `json:"resources,omitempty"`
+}
+
+// RestoreResourceHookSpec defines one or more RestoreResourceHooks that should be executed based on
+// the rules defined for namespaces, resources, and label selector.
+type RestoreResourceHookSpec struct {
+	// Name is the name of this hook.
+	Name string `json:"name"`
+
+	// IncludedNamespaces specifies the namespaces to which this hook spec applies. If empty, it applies
+	// to all namespaces.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces specifies the namespaces to which this hook spec does not apply.
+	// +optional
+	// +nullable
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// IncludedResources specifies the resources to which this hook spec applies. If empty, it applies
+	// to all resources.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources specifies the resources to which this hook spec does not apply.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+
+	// LabelSelector, if specified, filters the resources to which this hook spec applies.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// PostHooks is a list of RestoreResourceHooks to execute during and after restoring a resource.
+	// +optional
+	PostHooks []RestoreResourceHook `json:"postHooks,omitempty"`
+}
+
+// RestoreResourceHook defines a restore hook for a resource.
+type RestoreResourceHook struct {
+	// Exec defines an exec restore hook.
+	// +optional
+	Exec *ExecRestoreHook `json:"exec,omitempty"`
+
+	// Init defines an init restore hook.
+	// +optional
+	Init *InitRestoreHook `json:"init,omitempty"`
+}
+
+// ExecRestoreHook is a hook that uses pod exec API to execute a command inside a container in a pod
+// once the container is running.
+type ExecRestoreHook struct {
+	// Container is the container in the pod where the command should be executed. If not specified,
+	// the pod's first container is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Command is the command and arguments to execute from within a container after a pod has been restored.
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+
+	// OnError specifies how Velero should behave if it encounters an error executing this hook.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// ExecTimeout defines the maximum amount of time Velero should wait for the hook to complete before
+	// considering the execution a failure.
+	// +optional
+	ExecTimeout metav1.Duration `json:"execTimeout,omitempty"`
+
+	// WaitTimeout defines the maximum amount of time Velero should wait for the container to be running
+	// before attempting to run the command.
+	// +optional
+	WaitTimeout metav1.Duration `json:"waitTimeout,omitempty"`
+}
+
+// InitRestoreHook is a hook that adds init containers to a restored pod to run commands before the
+// pod's regular containers are started.
+type InitRestoreHook struct {
+	// InitContainers is list of init containers to be added to a pod during its restore.
+	// +optional
+	InitContainers []corev1api.Container `json:"initContainers"`
 }
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Canceled
 type RestorePhase string
 
 const (
@@ -110,6 +266,10 @@ const (
 	// RestorePhaseFailed means the restore was unable to execute.
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
+
+	// RestorePhaseCanceled means the restore was stopped before it finished
+	// because it was canceled.
+	RestorePhaseCanceled RestorePhase = "Canceled"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -137,6 +297,46 @@ type RestoreStatus struct {
 	// FailureReason is an error that caused the entire restore to fail.
 	// +optional
 	FailureReason string `json:"failureReason,omitempty"`
+
+	// StartTimestamp records the time the restore operation was started.
+	// The server's time is used for StartTimestamps
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the restore operation was completed.
+	// Completion time is recorded even on failed restore.
+	// The server's time is used for CompletionTimestamps
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress contains information about the restore's execution progress. Note
+	// that this information is best-effort only -- if Velero fails to update it
+	// during a restore for any reason, it may be inaccurate/stale.
+	// +optional
+	// +nullable
+	Progress *RestoreProgress `json:"progress,omitempty"`
+
+	// UnreadyItems is the number of restored items that weren't ready when
+	// the restore finished waiting for them. Only set if spec.waitForReady
+	// is true.
+	// +optional
+	UnreadyItems int `json:"unreadyItems,omitempty"`
+}
+
+// RestoreProgress stores information about the restore's execution progress
+type RestoreProgress struct {
+	// TotalItems is the total number of items to be restored. This number may change
+	// throughout the execution of the restore as items are excluded by the restore's
+	// label selector.
+	// +optional
+	TotalItems int `json:"totalItems,omitempty"`
+
+	// ItemsRestored is the number of items that have been processed so far. Items that
+	// fail to be restored are included, and are reported in the restore's errors.
+	// +optional
+	ItemsRestored int `json:"itemsRestored,omitempty"`
 }
 
 // +genclient
@@ -27,11 +27,65 @@ type ScheduleSpec struct {
 	// Schedule is a Cron expression defining when to run
 	// the Backup.
 	Schedule string `json:"schedule"`
+
+	// Paused specifies whether the schedule is paused. A paused
+	// schedule does not trigger any Backups until it is unpaused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Retention is a grandfather-father-son retention policy for the
+	// schedule's backups. Successful backups that aren't kept by any of
+	// its rules are deleted. If unset, backups are only deleted when
+	// their TTL expires.
+	// +optional
+	// +nullable
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+}
+
+// RetentionPolicy defines which of a schedule's successful backups to
+// keep. A backup is kept if any of the rules keeps it, and the newest
+// Completed backup is always kept. A policy with no rules keeps every
+// backup.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// KeepHourly keeps the newest backup of each hour, for this many of the
+	// most recent hours that have a backup. Times are in UTC.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepHourly int `json:"keepHourly,omitempty"`
+
+	// KeepDaily keeps the newest backup of each day, for this many of the
+	// most recent days that have a backup. Times are in UTC.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepDaily int `json:"keepDaily,omitempty"`
+
+	// KeepWeekly keeps the newest backup of each ISO week, for this many of the
+	// most recent ISO weeks that have a backup. Times are in UTC.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+
+	// KeepMonthly keeps the newest backup of each month, for this many of the
+	// most recent months that have a backup. Times are in UTC.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepMonthly int `json:"keepMonthly,omitempty"`
+
+	// KeepYearly keeps the newest backup of each year, for this many of the
+	// most recent years that have a backup. Times are in UTC.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepYearly int `json:"keepYearly,omitempty"`
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
 // of a Velero schedule
-// +kubebuilder:validation:Enum=New;Enabled;FailedValidation
+// +kubebuilder:validation:Enum=New;Enabled;Paused;FailedValidation
 type SchedulePhase string
 
 const (
@@ -43,6 +97,11 @@ const (
 	// will now be triggering backups according to the schedule spec.
 	SchedulePhaseEnabled SchedulePhase = "Enabled"
 
+	// SchedulePhasePaused means the schedule has been validated but
+	// has been paused by the user, and will not trigger backups until
+	// it is unpaused.
+	SchedulePhasePaused SchedulePhase = "Paused"
+
 	// SchedulePhaseFailedValidation means the schedule has failed
 	// the controller's validations and therefore will not trigger backups.
 	SchedulePhaseFailedValidation SchedulePhase = "FailedValidation"
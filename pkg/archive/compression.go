@@ -0,0 +1,100 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateCompression returns an error if the given compression format
+// is not supported.
+func ValidateCompression(compression velerov1api.BackupCompression) error {
+	switch compression {
+	case "", velerov1api.BackupCompressionGzip, velerov1api.BackupCompressionZstd, velerov1api.BackupCompressionNone:
+		return nil
+	default:
+		return errors.Errorf("unsupported compression %q, must be one of %q, %q or %q", compression, velerov1api.BackupCompressionGzip, velerov1api.BackupCompressionZstd, velerov1api.BackupCompressionNone)
+	}
+}
+
+// NewCompressor returns a writer that compresses the data written to it in the
+// given format and writes it to w. An empty format means gzip. The returned writer
+// must be closed to flush any buffered data; closing it does not close w.
+func NewCompressor(w io.Writer, compression velerov1api.BackupCompression) (io.WriteCloser, error) {
+	switch compression {
+	case "", velerov1api.BackupCompressionGzip:
+		return gzip.NewWriter(w), nil
+	case velerov1api.BackupCompressionZstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating zstd writer")
+		}
+		return zw, nil
+	case velerov1api.BackupCompressionNone:
+		return nopWriteCloser{w}, nil
+	default:
+		return nil, ValidateCompression(compression)
+	}
+}
+
+// NewDecompressor returns a reader that decompresses the data read from r,
+// which must be in the given format. An empty format means gzip. Closing the
+// returned reader does not close r.
+func NewDecompressor(r io.Reader, compression velerov1api.BackupCompression) (io.ReadCloser, error) {
+	switch compression {
+	case "", velerov1api.BackupCompressionGzip:
+		gzr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating gzip reader")
+		}
+		return gzr, nil
+	case velerov1api.BackupCompressionZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating zstd reader")
+		}
+		return zstdReadCloser{zr}, nil
+	case velerov1api.BackupCompressionNone:
+		return ioutil.NopCloser(r), nil
+	default:
+		return nil, ValidateCompression(compression)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// zstdReadCloser adapts a zstd.Decoder, whose Close doesn't return an error,
+// to io.ReadCloser. Closing it releases the decoder's goroutines.
+type zstdReadCloser struct {
+	*zstd.Decoder
+}
+
+func (r zstdReadCloser) Close() error {
+	r.Decoder.Close()
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression velerov1api.BackupCompression
+		compressed  bool
+	}{
+		{name: "empty compression means gzip", compression: "", compressed: true},
+		{name: "gzip", compression: velerov1api.BackupCompressionGzip, compressed: true},
+		{name: "zstd", compression: velerov1api.BackupCompressionZstd, compressed: true},
+		{name: "none", compression: velerov1api.BackupCompressionNone, compressed: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data := bytes.Repeat([]byte("velero"), 1000)
+
+			buf := new(bytes.Buffer)
+			w, err := NewCompressor(buf, tc.compression)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			if tc.compressed {
+				assert.True(t, buf.Len() < len(data))
+			} else {
+				assert.Equal(t, data, buf.Bytes())
+			}
+
+			r, err := NewDecompressor(buf, tc.compression)
+			require.NoError(t, err)
+			res, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, res)
+		})
+	}
+}
+
+func TestUnsupportedCompression(t *testing.T) {
+	expectErr := `unsupported compression "lz4", must be one of "gzip", "zstd" or "none"`
+
+	assert.EqualError(t, ValidateCompression("lz4"), expectErr)
+
+	_, err := NewCompressor(new(bytes.Buffer), "lz4")
+	assert.EqualError(t, err, expectErr)
+
+	_, err = NewDecompressor(new(bytes.Buffer), "lz4")
+	assert.EqualError(t, err, expectErr)
+}
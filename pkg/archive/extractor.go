@@ -18,12 +18,12 @@ package archive
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"io"
 	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -41,16 +41,17 @@ func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
 	}
 }
 
-// UnzipAndExtractBackup extracts a reader on a gzipped tarball to a local temp directory
-func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
-	gzr, err := gzip.NewReader(src)
+// UnzipAndExtractBackup extracts a reader on a tarball compressed in the given
+// format to a local temp directory
+func (e *Extractor) UnzipAndExtractBackup(src io.Reader, compression velerov1api.BackupCompression) (string, error) {
+	r, err := NewDecompressor(src, compression)
 	if err != nil {
-		e.log.Infof("error creating gzip reader: %v", err)
+		e.log.Infof("error creating decompressor: %v", err)
 		return "", err
 	}
-	defer gzr.Close()
+	defer r.Close()
 
-	return e.readBackup(tar.NewReader(gzr))
+	return e.readBackup(tar.NewReader(r))
 }
 
 func (e *Extractor) writeFile(target string, tarRdr *tar.Reader) error {
@@ -0,0 +1,41 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"path/filepath"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// GetItemFilePath returns the path of an item within a backup that's been
+// extracted to rootDir. namespace should be empty for cluster-scoped items.
+func GetItemFilePath(rootDir, groupResource, namespace, name string) string {
+	return GetVersionedItemFilePath(rootDir, groupResource, "", namespace, name)
+}
+
+// GetVersionedItemFilePath returns the path of an item within a backup that's
+// been extracted to rootDir, under the resource's versionDir API version
+// directory. If versionDir is empty, the item's non-versioned path is returned.
+func GetVersionedItemFilePath(rootDir, groupResource, versionDir, namespace, name string) string {
+	resourceDir := filepath.Join(rootDir, velerov1api.ResourcesDir, groupResource, versionDir)
+
+	if namespace == "" {
+		return filepath.Join(resourceDir, velerov1api.ClusterScopedDir, name+".json")
+	}
+	return filepath.Join(resourceDir, velerov1api.NamespaceScopedDir, namespace, name+".json")
+}
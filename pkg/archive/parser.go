@@ -22,6 +22,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -145,6 +147,58 @@ func (p *Parser) Parse(dir string) (map[string]*ResourceItems, error) {
 	return resources, nil
 }
 
+// ParseGroupVersions reads an extracted backup on the file system and returns
+// the API versions that each resource was backed up at, keyed by group resource.
+// Versions are only recorded in backups taken with the
+// features.APIGroupVersionsFeatureFlag enabled, so resources in other backups are
+// not included in the result. The returned APIGroup's preferred version is the
+// version that was preferred by the cluster the backup was taken from.
+func (p *Parser) ParseGroupVersions(dir string) (map[string]metav1.APIGroup, error) {
+	resourcesDir := filepath.Join(dir, velerov1api.ResourcesDir)
+	resourceDirs, err := p.fs.ReadDir(resourcesDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading contents of directory %q", strings.TrimPrefix(resourcesDir, dir+"/"))
+	}
+
+	resourceGroups := map[string]metav1.APIGroup{}
+	for _, resourceDir := range resourceDirs {
+		if !resourceDir.IsDir() {
+			continue
+		}
+
+		versionDirs, err := p.fs.ReadDir(filepath.Join(resourcesDir, resourceDir.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading contents of directory %q", strings.TrimPrefix(filepath.Join(resourcesDir, resourceDir.Name()), dir+"/"))
+		}
+
+		group := schema.ParseGroupResource(resourceDir.Name()).Group
+		apiGroup := metav1.APIGroup{Name: group}
+
+		for _, versionDir := range versionDirs {
+			if !versionDir.IsDir() || versionDir.Name() == velerov1api.ClusterScopedDir || versionDir.Name() == velerov1api.NamespaceScopedDir {
+				continue
+			}
+
+			version := strings.TrimSuffix(versionDir.Name(), velerov1api.PreferredVersionDir)
+			groupVersion := metav1.GroupVersionForDiscovery{
+				GroupVersion: schema.GroupVersion{Group: group, Version: version}.String(),
+				Version:      version,
+			}
+
+			apiGroup.Versions = append(apiGroup.Versions, groupVersion)
+			if strings.HasSuffix(versionDir.Name(), velerov1api.PreferredVersionDir) {
+				apiGroup.PreferredVersion = groupVersion
+			}
+		}
+
+		if len(apiGroup.Versions) > 0 {
+			resourceGroups[resourceDir.Name()] = apiGroup
+		}
+	}
+
+	return resourceGroups, nil
+}
+
 // getResourceItemsForScope returns the list of items with a namespace or
 // cluster-scoped subdirectory for a specific resource.
 func (p *Parser) getResourceItemsForScope(dir, archiveRootDir string) ([]string, error) {
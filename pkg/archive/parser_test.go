@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -118,3 +119,86 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGroupVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   []string
+		dir     string
+		wantErr error
+		want    map[string]metav1.APIGroup
+	}{
+		{
+			name:    "when there is no top-level resources directory, an error is returned",
+			dir:     "root-dir",
+			wantErr: errors.New("error reading contents of directory \"resources\": open root-dir/resources: file does not exist"),
+		},
+		{
+			name: "when resources have no version directories, an empty map is returned",
+			dir:  "root-dir",
+			files: []string{
+				"root-dir/resources/widgets.foo/cluster/item-1.json",
+				"root-dir/resources/dongles.bar/namespaces/ns-1/item-1.json",
+			},
+			want: map[string]metav1.APIGroup{},
+		},
+		{
+			name: "versions of each resource are returned, with the preferred version marked",
+			dir:  "root-dir",
+			files: []string{
+				"root-dir/resources/widgets.foo/cluster/item-1.json",
+				"root-dir/resources/widgets.foo/v1-preferredversion/cluster/item-1.json",
+				"root-dir/resources/widgets.foo/v1beta1/cluster/item-1.json",
+
+				"root-dir/resources/pods/namespaces/ns-1/item-1.json",
+				"root-dir/resources/pods/v1-preferredversion/namespaces/ns-1/item-1.json",
+
+				"root-dir/resources/dongles.bar/namespaces/ns-1/item-1.json",
+			},
+			want: map[string]metav1.APIGroup{
+				"widgets.foo": {
+					Name: "foo",
+					Versions: []metav1.GroupVersionForDiscovery{
+						{GroupVersion: "foo/v1", Version: "v1"},
+						{GroupVersion: "foo/v1beta1", Version: "v1beta1"},
+					},
+					PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "foo/v1", Version: "v1"},
+				},
+				"pods": {
+					Name: "",
+					Versions: []metav1.GroupVersionForDiscovery{
+						{GroupVersion: "v1", Version: "v1"},
+					},
+					PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "v1", Version: "v1"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &Parser{
+				log: test.NewLogger(),
+				fs:  test.NewFakeFileSystem(),
+			}
+
+			for _, file := range tc.files {
+				require.NoError(t, p.fs.MkdirAll(file, 0755))
+
+				if !strings.HasSuffix(file, "/") {
+					res, err := p.fs.Create(file)
+					require.NoError(t, err)
+					require.NoError(t, res.Close())
+				}
+			}
+
+			res, err := p.ParseGroupVersions(tc.dir)
+			if tc.wantErr != nil {
+				assert.Equal(t, err.Error(), tc.wantErr.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.want, res)
+			}
+		})
+	}
+}
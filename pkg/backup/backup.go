@@ -18,11 +18,12 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,18 +31,32 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/progress"
 )
 
 // BackupVersion is the current backup version for Velero.
-const BackupVersion = 1
+//
+// Version 2 backups may contain a directory per API version under each resource's
+// directory, if they were taken with the features.APIGroupVersionsFeatureFlag
+// enabled. Items are still written to their version 1 locations as well, so version
+// 2 backups can be restored by servers that only understand version 1.
+const BackupVersion = 2
+
+// progressUpdateInterval is how often a backup's progress is patched onto its
+// status while it's being processed.
+const progressUpdateInterval = 10 * time.Second
 
 // Backupper performs backups.
 type Backupper interface {
@@ -52,12 +67,14 @@ type Backupper interface {
 
 // kubernetesBackupper implements Backupper.
 type kubernetesBackupper struct {
+	backupClient           velerov1client.BackupsGetter
 	dynamicFactory         client.DynamicFactory
 	discoveryHelper        discovery.Helper
 	podCommandExecutor     podexec.PodCommandExecutor
 	groupBackupperFactory  groupBackupperFactory
 	resticBackupperFactory restic.BackupperFactory
 	resticTimeout          time.Duration
+	credentialFileStore    credentials.FileStore
 }
 
 type resolvedAction struct {
@@ -84,19 +101,23 @@ func cohabitatingResources() map[string]*cohabitatingResource {
 
 // NewKubernetesBackupper creates a new kubernetesBackupper.
 func NewKubernetesBackupper(
+	backupClient velerov1client.BackupsGetter,
 	discoveryHelper discovery.Helper,
 	dynamicFactory client.DynamicFactory,
 	podCommandExecutor podexec.PodCommandExecutor,
 	resticBackupperFactory restic.BackupperFactory,
 	resticTimeout time.Duration,
+	credentialFileStore credentials.FileStore,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
+		backupClient:           backupClient,
 		discoveryHelper:        discoveryHelper,
 		dynamicFactory:         dynamicFactory,
 		podCommandExecutor:     podCommandExecutor,
 		groupBackupperFactory:  &defaultGroupBackupperFactory{},
 		resticBackupperFactory: resticBackupperFactory,
 		resticTimeout:          resticTimeout,
+		credentialFileStore:    credentialFileStore,
 	}, nil
 }
 
@@ -194,20 +215,47 @@ func getResourceHook(hookSpec api.BackupResourceHookSpec, discoveryHelper discov
 	return h, nil
 }
 
+// resolveSnapshotLocationCredentials replaces each of the request's volume snapshot locations
+// that has a credential with a copy whose config contains the path to the credentials file, so
+// that it's passed to the location's volume snapshotter when it's initialized.
+func (kb *kubernetesBackupper) resolveSnapshotLocationCredentials(backupRequest *Request) error {
+	for i, location := range backupRequest.SnapshotLocations {
+		if location == nil || location.Spec.Credential == nil {
+			continue
+		}
+
+		config, err := credentials.ConfigWithCredentialsFile(location.Spec.Config, location.Spec.Credential, kb.credentialFileStore)
+		if err != nil {
+			return errors.Wrapf(err, "error getting credentials for volume snapshot location %s", location.Name)
+		}
+
+		// don't modify the original location, since it may be from an informer cache.
+		location = location.DeepCopy()
+		location.Spec.Config = config
+		backupRequest.SnapshotLocations[i] = location
+	}
+
+	return nil
+}
+
 type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 }
 
-// Backup backs up the items specified in the Backup, placing them in a gzip-compressed tar file
-// written to backupFile. The finalized api.Backup is written to metadata. Any error that represents
+// Backup backs up the items specified in the Backup, placing them in a tar file compressed in the
+// format specified by the backup's status and written to backupFile. The finalized api.Backup is written to metadata. Any error that represents
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
-// to the backup log.
+// to the backup log. If the request's Context is canceled, the remaining items are skipped and no error
+// is returned.
 func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Request, backupFile io.Writer, actions []velero.BackupItemAction, volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressedData, err := archive.NewCompressor(backupFile, backupRequest.Status.Compression)
+	if err != nil {
+		return err
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
+	tw := tar.NewWriter(compressedData)
 	defer tw.Close()
 
 	log.Info("Writing backup version file")
@@ -223,7 +271,6 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 	log.Infof("Including resources: %s", backupRequest.ResourceIncludesExcludes.IncludesString())
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		return err
@@ -234,6 +281,10 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		return err
 	}
 
+	if err := kb.resolveSnapshotLocationCredentials(backupRequest); err != nil {
+		return err
+	}
+
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
 
 	podVolumeTimeout := kb.resticTimeout
@@ -246,7 +297,9 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	// the restic backupper stops waiting for pod volume backups when the
+	// timeout elapses or the backup is canceled, whichever comes first.
+	ctx, cancelFunc := context.WithTimeout(backupRequest.context(), podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticBackupper restic.Backupper
@@ -257,6 +310,10 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		}
 	}
 
+	// items may be backed up concurrently, so they're written to the tarball
+	// by a single goroutine.
+	itemWriter := newTarItemWriter(tw)
+
 	gb := kb.groupBackupperFactory.newGroupBackupper(
 		log,
 		backupRequest,
@@ -264,18 +321,68 @@ func (kb *kubernetesBackupper) Backup(log logrus.FieldLogger, backupRequest *Req
 		kb.discoveryHelper,
 		cohabitatingResources(),
 		kb.podCommandExecutor,
-		tw,
+		itemWriter,
 		resticBackupper,
 		newPVCSnapshotTracker(),
 		volumeSnapshotterGetter,
 	)
 
+	backupRequest.progress = progress.NewTracker()
+
+	quit := make(chan struct{})
+	var wg sync.WaitGroup
+	if kb.backupClient != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			progress.Report(backupRequest.progress, progressUpdateInterval, quit, func(totalItems, itemsBackedUp int) error {
+				return kb.patchProgress(log, backupRequest, api.BackupProgress{TotalItems: totalItems, ItemsBackedUp: itemsBackedUp})
+			})
+		}()
+	}
+
 	for _, group := range kb.discoveryHelper.Resources() {
+		if backupRequest.canceled() {
+			break
+		}
 		if err := gb.backupGroup(group); err != nil {
 			log.WithError(err).WithField("apiGroup", group.String()).Error("Error backing up API group")
 		}
 	}
 
+	if backupRequest.canceled() {
+		log.Warn("Backup was canceled before all items were backed up")
+	}
+
+	itemWriter.close()
+
+	close(quit)
+	wg.Wait()
+
+	// the final progress is persisted along with the rest of the backup's status
+	// by the backup controller.
+	totalItems, itemsBackedUp := backupRequest.progress.Counts()
+	backupRequest.Status.Progress = &api.BackupProgress{TotalItems: totalItems, ItemsBackedUp: itemsBackedUp}
+
+	return nil
+}
+
+// patchProgress patches the backup's status with its current progress.
+func (kb *kubernetesBackupper) patchProgress(log logrus.FieldLogger, backupRequest *Request, progress api.BackupProgress) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"progress": progress,
+		},
+	})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error marshaling backup progress patch")
+		return err
+	}
+
+	if _, err := kb.backupClient.Backups(backupRequest.Namespace).Patch(backupRequest.Name, types.MergePatchType, patch); err != nil {
+		log.WithError(errors.WithStack(err)).Warn("Error updating backup progress")
+		return err
+	}
 	return nil
 }
 
@@ -45,6 +45,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -104,6 +105,111 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version")...)
 }
 
+// TestBackupProgressIsUpdated verifies that after a backup has run, its Status.Progress
+// field is updated to reflect the total progress of the backup.
+func TestBackupProgressIsUpdated(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{Backup: defaultBackup().Result()}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("foo", "bar").Result(),
+			builder.ForPod("zoo", "raz").Result(),
+		),
+		test.Deployments(
+			builder.ForDeployment("foo", "bar").Result(),
+			builder.ForDeployment("zoo", "raz").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("bar").Result(),
+			builder.ForPersistentVolume("baz").Result(),
+		),
+	}
+	for _, resource := range apiResources {
+		h.addItems(t, resource)
+	}
+
+	h.backupper.Backup(h.log, req, backupFile, nil, nil)
+
+	require.NotNil(t, req.Status.Progress)
+	assert.Len(t, req.BackedUpItems, req.Status.Progress.TotalItems)
+	assert.Len(t, req.BackedUpItems, req.Status.Progress.ItemsBackedUp)
+}
+
+// TestBackupWithItemWorkers verifies that when a backup's items are backed up
+// concurrently, every item is written to the tarball exactly once, including
+// items returned as additional items by actions for several items at once, and
+// every volume is snapshotted.
+func TestBackupWithItemWorkers(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup: defaultBackup().ItemWorkers(4).Result(),
+		SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+			newSnapshotLocation("velero", "default", "default"),
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	var (
+		pods      []metav1.Object
+		pvs       []metav1.Object
+		want      = []string{"metadata/version"}
+		snapshots = new(fakeVolumeSnapshotter)
+	)
+	for i := 0; i < 20; i++ {
+		ns, name := fmt.Sprintf("ns-%d", i%3), fmt.Sprintf("pod-%d", i)
+		pods = append(pods, builder.ForPod(ns, name).Result())
+		want = append(want, fmt.Sprintf("resources/pods/namespaces/%s/%s.json", ns, name))
+
+		pvName := fmt.Sprintf("pv-%d", i)
+		pvs = append(pvs, builder.ForPersistentVolume(pvName).Result())
+		want = append(want, fmt.Sprintf("resources/persistentvolumes/cluster/%s.json", pvName))
+		snapshots.WithVolume(pvName, "vol-"+pvName, "", "type-1", 100, false)
+	}
+
+	h.addItems(t, test.Pods(pods...))
+	h.addItems(t, test.PVs(pvs...))
+
+	// every pod returns the same PV as an additional item.
+	actions := []velero.BackupItemAction{
+		&pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+				return item, []velero.ResourceIdentifier{{GroupResource: kuberesource.PersistentVolumes, Name: "pv-0"}}, nil
+			},
+		},
+	}
+
+	err := h.backupper.Backup(h.log, req, backupFile, actions, volumeSnapshotterGetter{"default": snapshots})
+	require.NoError(t, err)
+
+	assertTarballContents(t, backupFile, want...)
+	assert.Len(t, req.BackedUpItems, len(want)-1)
+	assert.Len(t, req.VolumeSnapshots, len(pvs))
+	assert.Equal(t, len(want)-1, req.Status.Progress.ItemsBackedUp)
+}
+
+// TestBackupCanceled verifies that no items are backed up when the backup's
+// context has been canceled, and that the backup doesn't return an error.
+func TestBackupCanceled(t *testing.T) {
+	h := newHarness(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req := &Request{Backup: defaultBackup().Result(), Context: ctx}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "bar").Result(),
+		builder.ForPod("zoo", "raz").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+	assert.Empty(t, req.BackedUpItems)
+}
+
 // TestBackupResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -850,6 +956,107 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json")
 }
 
+// TestBackupAPIGroupVersions runs backups with the APIGroupVersionsFeatureFlag enabled
+// and verifies that items are written to the backup tarball at every API version served
+// for their group, with the preferred version marked, as well as at the non-versioned path.
+func TestBackupAPIGroupVersions(t *testing.T) {
+	features.NewFeatureFlagSet(features.APIGroupVersionsFeatureFlag)
+	defer features.NewFeatureFlagSet()
+
+	deploymentsV1beta1 := func(items ...metav1.Object) *test.APIResource {
+		res := test.Deployments(items...)
+		res.Version = "v1beta1"
+		return res
+	}
+
+	tests := []struct {
+		name         string
+		backup       *velerov1.Backup
+		apiResources []*test.APIResource
+		want         []string
+	}{
+		{
+			name:   "resources in a group with a single version are written to the preferred version directory",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("foo", "bar").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("bar").Result(),
+				),
+			},
+			want: []string{
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/persistentvolumes/cluster/bar.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/bar.json",
+			},
+		},
+		{
+			name:   "resources in a group with multiple versions are written to every version directory",
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+				deploymentsV1beta1(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/namespaces/zoo/raz.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/zoo/raz.json",
+				"resources/deployments.apps/v1beta1/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1beta1/namespaces/zoo/raz.json",
+			},
+		},
+		{
+			name: "items that aren't backed up at the preferred version aren't written to other version directories",
+			backup: defaultBackup().
+				IncludedNamespaces("foo").
+				Result(),
+			apiResources: []*test.APIResource{
+				test.Deployments(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+				deploymentsV1beta1(
+					builder.ForDeployment("foo", "bar").Result(),
+					builder.ForDeployment("zoo", "raz").Result(),
+				),
+			},
+			want: []string{
+				"resources/deployments.apps/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1-preferredversion/namespaces/foo/bar.json",
+				"resources/deployments.apps/v1beta1/namespaces/foo/bar.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range tc.apiResources {
+				h.addItems(t, resource)
+			}
+
+			h.backupper.Backup(h.log, req, backupFile, nil, nil)
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+		})
+	}
+}
+
 // TestBackupResourceOrdering runs backups of the core API group and ensures that items are backed
 // up in the expected order (pods, PVCs, PVs, everything else). Verification is done by looking
 // at the order of files written to the backup tarball.
@@ -2428,6 +2635,53 @@ func TestBackupWithRestic(t *testing.T) {
 				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-2").Result(),
 			},
 		},
+		{
+			name:   "when restic is used by default, all of a pod's eligible volumes that aren't excluded are backed up",
+			backup: defaultBackup().DefaultVolumesToRestic(true).Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes-excludes", "excluded")).
+						Volumes(
+							builder.ForVolume("foo").Result(),
+							builder.ForVolume("excluded").Result(),
+							&corev1.Volume{Name: "host-path", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/tmp"}}},
+							&corev1.Volume{Name: "secret", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "secret-1"}}},
+							&corev1.Volume{Name: "config-map", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+							&corev1.Volume{Name: "projected", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{}}},
+							&corev1.Volume{Name: "downward-api", VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{}}},
+						).
+						Result(),
+				),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-foo").Result(),
+			},
+		},
+		{
+			name:   "when restic is used by default, claimed PVs of the pod's volumes are not also snapshotted",
+			backup: defaultBackup().DefaultVolumesToRestic(true).Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").
+						Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+						Result(),
+				),
+				test.PVCs(
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+				),
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+				),
+			},
+			vsl: newSnapshotLocation("velero", "default", "default"),
+			snapshotterGetter: map[string]velero.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+			},
+			want: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-1").Result(),
+			},
+		},
 	}
 
 	for _, tc := range tests {
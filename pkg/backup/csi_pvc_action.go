@@ -0,0 +1,213 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/csi"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// CSIPVCAction takes a CSI VolumeSnapshot of each PersistentVolumeClaim that's
+// bound to a CSI-provisioned PersistentVolume, and backs up the snapshot objects.
+type CSIPVCAction struct {
+	log           logrus.FieldLogger
+	pvClient      corev1client.PersistentVolumesGetter
+	podClient     corev1client.PodsGetter
+	dynamicClient dynamic.Interface
+}
+
+// NewCSIPVCAction creates a new CSIPVCAction.
+func NewCSIPVCAction(
+	logger logrus.FieldLogger,
+	pvClient corev1client.PersistentVolumesGetter,
+	podClient corev1client.PodsGetter,
+	dynamicClient dynamic.Interface,
+) *CSIPVCAction {
+	return &CSIPVCAction{
+		log:           logger,
+		pvClient:      pvClient,
+		podClient:     podClient,
+		dynamicClient: dynamicClient,
+	}
+}
+
+func (a *CSIPVCAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}, nil
+}
+
+// Execute creates a VolumeSnapshot for the provided PersistentVolumeClaim if its
+// PersistentVolume is provisioned by a CSI driver, waits for the snapshot to be
+// ready to use, and returns the VolumeSnapshot, its VolumeSnapshotContent, and its
+// VolumeSnapshotClass as additional items to back up.
+func (a *CSIPVCAction) Execute(item runtime.Unstructured, backup *velerov1api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	return a.ExecuteWithContext(context.Background(), item, backup)
+}
+
+// ExecuteWithContext is the same as Execute, but stops waiting for the snapshot to be
+// ready to use if ctx is canceled.
+func (a *CSIPVCAction) ExecuteWithContext(ctx context.Context, item runtime.Unstructured, backup *velerov1api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		return item, nil, nil
+	}
+
+	a.log.Info("Executing CSIPVCAction")
+
+	if boolptr.IsSetToFalse(backup.Spec.SnapshotVolumes) {
+		a.log.Info("Backup has volume snapshots disabled; skipping CSI snapshot")
+		return item, nil, nil
+	}
+
+	var pvc corev1api.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), &pvc); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to convert unstructured item to persistent volume claim")
+	}
+
+	log := a.log.WithField("persistentVolumeClaim", fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+
+	if pvc.Status.Phase != corev1api.ClaimBound || pvc.Spec.VolumeName == "" {
+		log.Info("Persistent volume claim is not bound; skipping CSI snapshot")
+		return item, nil, nil
+	}
+
+	pv, err := a.pvClient.PersistentVolumes().Get(pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+
+	if pv.Spec.CSI == nil {
+		log.Info("Persistent volume is not provisioned by a CSI driver; skipping CSI snapshot")
+		return item, nil, nil
+	}
+
+	backedUpByRestic, err := a.isBackedUpByRestic(&pvc, boolptr.IsSetToTrue(backup.Spec.DefaultVolumesToRestic))
+	if err != nil {
+		return nil, nil, err
+	}
+	if backedUpByRestic {
+		log.Info("Persistent volume claim is backed up by restic; skipping CSI snapshot")
+		return item, nil, nil
+	}
+
+	class, err := csi.GetVolumeSnapshotClassForDriver(a.dynamicClient, pv.Spec.CSI.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vs, err := a.dynamicClient.Resource(csi.VolumeSnapshotsGVR).Namespace(pvc.Namespace).Create(
+		csi.NewVolumeSnapshot(pvc.Namespace, pvc.Name, class.GetName(), backup.Name),
+		metav1.CreateOptions{},
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error creating volume snapshot")
+	}
+	log = log.WithField("volumeSnapshot", vs.GetName())
+
+	log.Info("Waiting for volume snapshot to be ready to use")
+	vs, err = csi.WaitUntilVolumeSnapshotReady(ctx, a.dynamicClient, vs.GetNamespace(), vs.GetName(), csi.DefaultSnapshotReadyTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contentName := csi.GetBoundVolumeSnapshotContentName(vs)
+
+	// Label the content so it can be found when the backup is deleted.
+	patch := []byte(fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, velerov1api.BackupNameLabel, label.GetValidName(backup.Name)))
+	if _, err := a.dynamicClient.Resource(csi.VolumeSnapshotContentsGVR).Patch(contentName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return nil, nil, errors.Wrapf(err, "error labeling volume snapshot content %s", contentName)
+	}
+
+	// Label the claim with the snapshot name so it can be provisioned from the
+	// snapshot on restore.
+	labels := pvc.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[velerov1api.VolumeSnapshotLabel] = vs.GetName()
+	pvc.SetLabels(labels)
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pvc)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to convert persistent volume claim to unstructured item")
+	}
+
+	log.Info("CSI volume snapshot is ready to use")
+
+	additionalItems := []velero.ResourceIdentifier{
+		{
+			GroupResource: kuberesource.VolumeSnapshotClasses,
+			Name:          class.GetName(),
+		},
+		{
+			GroupResource: kuberesource.VolumeSnapshots,
+			Namespace:     vs.GetNamespace(),
+			Name:          vs.GetName(),
+		},
+		{
+			GroupResource: kuberesource.VolumeSnapshotContents,
+			Name:          contentName,
+		},
+	}
+
+	return &unstructured.Unstructured{Object: res}, additionalItems, nil
+}
+
+// isBackedUpByRestic returns true if the provided claim is mounted by a pod in its
+// namespace whose volume for the claim will be backed up with restic, either because
+// it's listed in the pod's annotations or because restic is used by default.
+func (a *CSIPVCAction) isBackedUpByRestic(pvc *corev1api.PersistentVolumeClaim, defaultVolumesToRestic bool) (bool, error) {
+	pods, err := a.podClient.Pods(pvc.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "error listing pods in namespace %s", pvc.Namespace)
+	}
+
+	for _, pod := range pods.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != pvc.Name {
+				continue
+			}
+
+			for _, resticVolume := range restic.GetPodVolumesUsingRestic(&pod, defaultVolumesToRestic) {
+				if resticVolume == volume.Name {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/csi"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCSIPVCActionExecute(t *testing.T) {
+	class := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"driver": "driver-1",
+		},
+	}
+	class.SetAPIVersion(csi.SnapshotAPIVersion)
+	class.SetKind("VolumeSnapshotClass")
+	class.SetName("class-1")
+
+	content := &unstructured.Unstructured{}
+	content.SetAPIVersion(csi.SnapshotAPIVersion)
+	content.SetKind("VolumeSnapshotContent")
+	content.SetName("content-1")
+
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Phase(corev1api.ClaimBound).Result()
+
+	tests := []struct {
+		name            string
+		featureDisabled bool
+		backup          *velerov1api.Backup
+		pv              *corev1api.PersistentVolume
+		pods            []*corev1api.Pod
+		wantItems       []velero.ResourceIdentifier
+		wantLabel       string
+	}{
+		{
+			name:            "feature flag disabled does not snapshot",
+			featureDisabled: true,
+			backup:          builder.ForBackup("velero", "backup-1").Result(),
+			pv:              builder.ForPersistentVolume("pv-1").CSI("driver-1", "handle-1").Result(),
+		},
+		{
+			name:   "backup with snapshotVolumes=false does not snapshot",
+			backup: builder.ForBackup("velero", "backup-1").SnapshotVolumes(false).Result(),
+			pv:     builder.ForPersistentVolume("pv-1").CSI("driver-1", "handle-1").Result(),
+		},
+		{
+			name:   "non-CSI volume does not snapshot",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			pv:     builder.ForPersistentVolume("pv-1").AWSEBSVolumeID("vol-1").Result(),
+		},
+		{
+			name:   "volume backed up by restic does not snapshot",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			pv:     builder.ForPersistentVolume("pv-1").CSI("driver-1", "handle-1").Result(),
+			pods: []*corev1api.Pod{
+				builder.ForPod("ns-1", "pod-1").
+					ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "data")).
+					Volumes(&corev1api.Volume{
+						Name: "data",
+						VolumeSource: corev1api.VolumeSource{
+							PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-1"},
+						},
+					}).
+					Result(),
+			},
+		},
+		{
+			name:   "CSI volume is snapshotted and snapshot objects are returned as additional items",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			pv:     builder.ForPersistentVolume("pv-1").CSI("driver-1", "handle-1").Result(),
+			wantItems: []velero.ResourceIdentifier{
+				{GroupResource: kuberesource.VolumeSnapshotClasses, Name: "class-1"},
+				{GroupResource: kuberesource.VolumeSnapshots, Namespace: "ns-1", Name: "velero-pvc-1-abcde"},
+				{GroupResource: kuberesource.VolumeSnapshotContents, Name: "content-1"},
+			},
+			wantLabel: "velero-pvc-1-abcde",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.featureDisabled {
+				features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag)
+				defer features.NewFeatureFlagSet()
+			}
+
+			kubeClient := kubefake.NewSimpleClientset(tc.pv)
+			for _, pod := range tc.pods {
+				_, err := kubeClient.CoreV1().Pods(pod.Namespace).Create(pod)
+				require.NoError(t, err)
+			}
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), class, content)
+			// the fake client doesn't generate names or run a snapshot controller, so
+			// simulate both when the snapshot is created.
+			dynamicClient.PrependReactor("create", "volumesnapshots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				vs := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+				vs.SetName(vs.GetGenerateName() + "abcde")
+				vs.Object["status"] = map[string]interface{}{
+					"readyToUse":                     true,
+					"boundVolumeSnapshotContentName": "content-1",
+				}
+				return false, nil, nil
+			})
+
+			action := NewCSIPVCAction(velerotest.NewLogger(), kubeClient.CoreV1(), kubeClient.CoreV1(), dynamicClient)
+
+			item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+			require.NoError(t, err)
+
+			res, additionalItems, err := action.Execute(&unstructured.Unstructured{Object: item}, tc.backup)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantItems, additionalItems)
+			assert.Equal(t, tc.wantLabel, res.(*unstructured.Unstructured).GetLabels()[velerov1api.VolumeSnapshotLabel])
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// DeleteItemActionContext provides the necessary environment to run DeleteItemAction plugins
+// against the contents of a backup that's being deleted.
+type DeleteItemActionContext struct {
+	Backup          *velerov1api.Backup
+	BackupReader    io.Reader
+	Actions         []velero.DeleteItemAction
+	Filesystem      filesystem.Interface
+	DiscoveryHelper discovery.Helper
+	Log             logrus.FieldLogger
+}
+
+type resolvedDeleteItemAction struct {
+	velero.DeleteItemAction
+
+	resourceIncludesExcludes  *collections.IncludesExcludes
+	namespaceIncludesExcludes *collections.IncludesExcludes
+	selector                  labels.Selector
+}
+
+// InvokeDeleteActions extracts the backup tarball and invokes each DeleteItemAction on every
+// item in it that matches the action's AppliesTo selector. Errors from individual actions are
+// collected and returned so that one failing action doesn't prevent the others from running.
+func InvokeDeleteActions(ctx *DeleteItemActionContext) []error {
+	resolvedActions, err := resolveDeleteItemActions(ctx.Actions, ctx.DiscoveryHelper)
+	if err != nil {
+		return []error{errors.Wrap(err, "error resolving delete item actions")}
+	}
+
+	// no actions installed means there's no need to download and extract the backup.
+	if len(resolvedActions) == 0 {
+		ctx.Log.Debug("No delete item actions present, skipping")
+		return nil
+	}
+
+	dir, err := archive.NewExtractor(ctx.Log, ctx.Filesystem).UnzipAndExtractBackup(ctx.BackupReader, ctx.Backup.Status.Compression)
+	if err != nil {
+		return []error{errors.Wrap(err, "error extracting backup")}
+	}
+	defer ctx.Filesystem.RemoveAll(dir)
+
+	backupResources, err := archive.NewParser(ctx.Log, ctx.Filesystem).Parse(dir)
+	if err != nil {
+		return []error{errors.Wrap(err, "error parsing backup")}
+	}
+
+	// sort the resources so that actions are invoked in a deterministic order.
+	resources := make([]string, 0, len(backupResources))
+	for resource := range backupResources {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	var errs []error
+	for _, resource := range resources {
+		groupResource := schema.ParseGroupResource(resource)
+
+		for namespace, items := range backupResources[resource].ItemsByNamespace {
+			for _, name := range items {
+				log := ctx.Log.WithFields(logrus.Fields{
+					"groupResource": resource,
+					"namespace":     namespace,
+					"name":          name,
+				})
+
+				var obj *unstructured.Unstructured
+
+				for _, action := range resolvedActions {
+					if !action.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+						continue
+					}
+
+					if namespace != "" && !action.namespaceIncludesExcludes.ShouldInclude(namespace) {
+						continue
+					}
+
+					if namespace == "" && !action.namespaceIncludesExcludes.IncludeEverything() {
+						continue
+					}
+
+					// only read the item from disk once it's known that at least one action applies to it.
+					if obj == nil {
+						if obj, err = unmarshalItem(ctx.Filesystem, archive.GetItemFilePath(dir, resource, namespace, name)); err != nil {
+							errs = append(errs, errors.Wrapf(err, "error reading item (groupResource=%s, namespace=%s, name=%s)", resource, namespace, name))
+							break
+						}
+					}
+
+					if !action.selector.Matches(labels.Set(obj.GetLabels())) {
+						continue
+					}
+
+					log.Info("Executing delete item action")
+
+					if err := action.Execute(&velero.DeleteItemActionExecuteInput{
+						Item:   obj,
+						Backup: ctx.Backup,
+					}); err != nil {
+						errs = append(errs, errors.Wrapf(err, "error executing delete item action (groupResource=%s, namespace=%s, name=%s)", resource, namespace, name))
+					}
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func resolveDeleteItemActions(actions []velero.DeleteItemAction, helper discovery.Helper) ([]resolvedDeleteItemAction, error) {
+	var resolved []resolvedDeleteItemAction
+
+	for _, action := range actions {
+		resourceSelector, err := action.AppliesTo()
+		if err != nil {
+			return nil, err
+		}
+
+		resources := getResourceIncludesExcludes(helper, resourceSelector.IncludedResources, resourceSelector.ExcludedResources)
+		namespaces := collections.NewIncludesExcludes().Includes(resourceSelector.IncludedNamespaces...).Excludes(resourceSelector.ExcludedNamespaces...)
+
+		selector := labels.Everything()
+		if resourceSelector.LabelSelector != "" {
+			if selector, err = labels.Parse(resourceSelector.LabelSelector); err != nil {
+				return nil, err
+			}
+		}
+
+		resolved = append(resolved, resolvedDeleteItemAction{
+			DeleteItemAction:          action,
+			resourceIncludesExcludes:  resources,
+			namespaceIncludesExcludes: namespaces,
+			selector:                  selector,
+		})
+	}
+
+	return resolved, nil
+}
+
+func unmarshalItem(fs filesystem.Interface, filePath string) (*unstructured.Unstructured, error) {
+	bytes, err := fs.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var obj unstructured.Unstructured
+	if err := json.Unmarshal(bytes, &obj); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &obj, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// recordResourcesDeleteAction is a delete item action that can be configured to run
+// for specific resources/namespaces and simply records the items that it is executed for.
+type recordResourcesDeleteAction struct {
+	selector velero.ResourceSelector
+	err      error
+	ids      []string
+}
+
+func (a *recordResourcesDeleteAction) AppliesTo() (velero.ResourceSelector, error) {
+	return a.selector, nil
+}
+
+func (a *recordResourcesDeleteAction) Execute(input *velero.DeleteItemActionExecuteInput) error {
+	metadata, err := meta.Accessor(input.Item)
+	if err != nil {
+		return err
+	}
+
+	a.ids = append(a.ids, kubeutil.NamespaceAndName(metadata))
+
+	return a.err
+}
+
+func TestInvokeDeleteActions(t *testing.T) {
+	backupTarball := newDeleteTestTarWriter(t).
+		addItems("pods", builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "foo")).Result()).
+		addItems("pods", builder.ForPod("ns-2", "pod-2").Result()).
+		addItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+		done()
+
+	tests := []struct {
+		name    string
+		actions []*recordResourcesDeleteAction
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name:    "no actions doesn't error",
+			actions: nil,
+		},
+		{
+			name: "action with no selector is invoked for all items",
+			actions: []*recordResourcesDeleteAction{
+				new(recordResourcesDeleteAction),
+			},
+			want: [][]string{{"pv-1", "ns-1/pod-1", "ns-2/pod-2"}},
+		},
+		{
+			name: "actions are only invoked for matching resources, namespaces and labels",
+			actions: []*recordResourcesDeleteAction{
+				{selector: velero.ResourceSelector{IncludedResources: []string{"pods"}}},
+				{selector: velero.ResourceSelector{IncludedNamespaces: []string{"ns-2"}}},
+				{selector: velero.ResourceSelector{LabelSelector: "app=foo"}},
+				{selector: velero.ResourceSelector{ExcludedResources: []string{"pods"}}},
+			},
+			want: [][]string{
+				{"ns-1/pod-1", "ns-2/pod-2"},
+				{"ns-2/pod-2"},
+				{"ns-1/pod-1"},
+				{"pv-1"},
+			},
+		},
+		{
+			name: "errors from an action are returned and don't prevent other actions from running",
+			actions: []*recordResourcesDeleteAction{
+				{selector: velero.ResourceSelector{IncludedResources: []string{"persistentvolumes"}}, err: errors.New("boom")},
+				{selector: velero.ResourceSelector{IncludedResources: []string{"persistentvolumes"}}},
+			},
+			want:    [][]string{{"pv-1"}, {"pv-1"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actions []velero.DeleteItemAction
+			for _, action := range tc.actions {
+				actions = append(actions, action)
+			}
+
+			errs := InvokeDeleteActions(&DeleteItemActionContext{
+				Backup:          builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result(),
+				BackupReader:    bytes.NewReader(backupTarball.Bytes()),
+				Actions:         actions,
+				Filesystem:      velerotest.NewFakeFileSystem(),
+				DiscoveryHelper: velerotest.NewFakeDiscoveryHelper(true, nil),
+				Log:             velerotest.NewLogger(),
+			})
+
+			if tc.wantErr {
+				assert.NotEmpty(t, errs)
+			} else {
+				assert.Empty(t, errs)
+			}
+
+			for i, action := range tc.actions {
+				assert.ElementsMatch(t, tc.want[i], action.ids)
+			}
+		})
+	}
+}
+
+type deleteTestTarWriter struct {
+	t   *testing.T
+	buf *bytes.Buffer
+	gzw *gzip.Writer
+	tw  *tar.Writer
+}
+
+func newDeleteTestTarWriter(t *testing.T) *deleteTestTarWriter {
+	tw := new(deleteTestTarWriter)
+	tw.t = t
+	tw.buf = new(bytes.Buffer)
+	tw.gzw = gzip.NewWriter(tw.buf)
+	tw.tw = tar.NewWriter(tw.gzw)
+
+	return tw
+}
+
+func (tw *deleteTestTarWriter) addItems(groupResource string, items ...metav1.Object) *deleteTestTarWriter {
+	tw.t.Helper()
+
+	for _, obj := range items {
+		var path string
+		if obj.GetNamespace() == "" {
+			path = fmt.Sprintf("resources/%s/cluster/%s.json", groupResource, obj.GetName())
+		} else {
+			path = fmt.Sprintf("resources/%s/namespaces/%s/%s.json", groupResource, obj.GetNamespace(), obj.GetName())
+		}
+
+		data, err := json.Marshal(obj)
+		require.NoError(tw.t, err)
+
+		require.NoError(tw.t, tw.tw.WriteHeader(&tar.Header{
+			Name:     path,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+			Mode:     0755,
+			ModTime:  time.Now(),
+		}))
+
+		_, err = tw.tw.Write(data)
+		require.NoError(tw.t, err)
+	}
+
+	return tw
+}
+
+func (tw *deleteTestTarWriter) done() *bytes.Buffer {
+	require.NoError(tw.t, tw.tw.Close())
+	require.NoError(tw.t, tw.gzw.Close())
+
+	return tw.buf
+}
@@ -39,7 +39,7 @@ type groupBackupperFactory interface {
 		discoveryHelper discovery.Helper,
 		cohabitatingResources map[string]*cohabitatingResource,
 		podCommandExecutor podexec.PodCommandExecutor,
-		tarWriter tarWriter,
+		itemWriter *tarItemWriter,
 		resticBackupper restic.Backupper,
 		resticSnapshotTracker *pvcSnapshotTracker,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
@@ -55,7 +55,7 @@ func (f *defaultGroupBackupperFactory) newGroupBackupper(
 	discoveryHelper discovery.Helper,
 	cohabitatingResources map[string]*cohabitatingResource,
 	podCommandExecutor podexec.PodCommandExecutor,
-	tarWriter tarWriter,
+	itemWriter *tarItemWriter,
 	resticBackupper restic.Backupper,
 	resticSnapshotTracker *pvcSnapshotTracker,
 	volumeSnapshotterGetter VolumeSnapshotterGetter,
@@ -67,7 +67,7 @@ func (f *defaultGroupBackupperFactory) newGroupBackupper(
 		discoveryHelper:         discoveryHelper,
 		cohabitatingResources:   cohabitatingResources,
 		podCommandExecutor:      podCommandExecutor,
-		tarWriter:               tarWriter,
+		itemWriter:              itemWriter,
 		resticBackupper:         resticBackupper,
 		resticSnapshotTracker:   resticSnapshotTracker,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
@@ -87,7 +87,7 @@ type defaultGroupBackupper struct {
 	discoveryHelper          discovery.Helper
 	cohabitatingResources    map[string]*cohabitatingResource
 	podCommandExecutor       podexec.PodCommandExecutor
-	tarWriter                tarWriter
+	itemWriter               *tarItemWriter
 	resticBackupper          restic.Backupper
 	resticSnapshotTracker    *pvcSnapshotTracker
 	resourceBackupperFactory resourceBackupperFactory
@@ -118,7 +118,7 @@ func (gb *defaultGroupBackupper) backupGroup(group *metav1.APIResourceList) erro
 		gb.discoveryHelper,
 		gb.cohabitatingResources,
 		gb.podCommandExecutor,
-		gb.tarWriter,
+		gb.itemWriter,
 		gb.resticBackupper,
 		gb.resticSnapshotTracker,
 		gb.volumeSnapshotterGetter,
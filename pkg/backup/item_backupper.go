@@ -20,7 +20,7 @@ import (
 	"archive/tar"
 	"encoding/json"
 	"fmt"
-	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,6 +28,7 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,12 +36,15 @@ import (
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -48,7 +52,7 @@ type itemBackupperFactory interface {
 	newItemBackupper(
 		backup *Request,
 		podCommandExecutor podexec.PodCommandExecutor,
-		tarWriter tarWriter,
+		itemWriter *tarItemWriter,
 		dynamicFactory client.DynamicFactory,
 		discoveryHelper discovery.Helper,
 		resticBackupper restic.Backupper,
@@ -62,7 +66,7 @@ type defaultItemBackupperFactory struct{}
 func (f *defaultItemBackupperFactory) newItemBackupper(
 	backupRequest *Request,
 	podCommandExecutor podexec.PodCommandExecutor,
-	tarWriter tarWriter,
+	itemWriter *tarItemWriter,
 	dynamicFactory client.DynamicFactory,
 	discoveryHelper discovery.Helper,
 	resticBackupper restic.Backupper,
@@ -71,7 +75,7 @@ func (f *defaultItemBackupperFactory) newItemBackupper(
 ) ItemBackupper {
 	ib := &defaultItemBackupper{
 		backupRequest:           backupRequest,
-		tarWriter:               tarWriter,
+		itemWriter:              itemWriter,
 		dynamicFactory:          dynamicFactory,
 		discoveryHelper:         discoveryHelper,
 		resticBackupper:         resticBackupper,
@@ -95,7 +99,7 @@ type ItemBackupper interface {
 
 type defaultItemBackupper struct {
 	backupRequest           *Request
-	tarWriter               tarWriter
+	itemWriter              *tarItemWriter
 	dynamicFactory          client.DynamicFactory
 	discoveryHelper         discovery.Helper
 	resticBackupper         restic.Backupper
@@ -104,10 +108,11 @@ type defaultItemBackupper struct {
 
 	itemHookHandler                    itemHookHandler
 	additionalItemBackupper            ItemBackupper
+	snapshottersLock                   sync.Mutex
 	snapshotLocationVolumeSnapshotters map[string]velero.VolumeSnapshotter
 }
 
-// backupItem backs up an individual item to tarWriter. The item may be excluded based on the
+// backupItem backs up an individual item to the tarball. The item may be excluded based on the
 // namespaces IncludesExcludes list.
 // In addition to the error return, backupItem also returns a bool indicating whether the item
 // was actually backed up.
@@ -159,12 +164,11 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 		name:      name,
 	}
 
-	if _, exists := ib.backupRequest.BackedUpItems[key]; exists {
+	if !ib.backupRequest.markBackedUp(key) {
 		log.Info("Skipping item because it's already been backed up.")
 		// returning true since this item *is* in the backup, even though we're not backing it up here
 		return true, nil
 	}
-	ib.backupRequest.BackedUpItems[key] = struct{}{}
 
 	log.Info("Backing up item")
 
@@ -187,25 +191,28 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 			// nil it on error since it's not valid
 			pod = nil
 		} else {
-			// Get the list of volumes to back up using restic from the pod's annotations. Remove from this list
-			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
-			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			for _, volume := range restic.GetVolumesToBackup(pod) {
-				if found, pvcName := ib.resticSnapshotTracker.HasPVCForPodVolume(pod, volume); found {
-					log.WithFields(map[string]interface{}{
-						"podVolume": volume,
-						"pvcName":   pvcName,
-					}).Info("Pod volume uses a persistent volume claim which has already been backed up with restic from another pod, skipping.")
+			// Get the list of volumes to back up using restic from the pod's annotations, or all of the pod's
+			// eligible volumes if the backup uses restic by default. Remove from this list any volumes that use
+			// a PVC that we've already backed up (this would be in a read-write-many scenario, where it's been
+			// backed up from another pod), since we don't need >1 backup per PVC.
+			//
+			// The volumes that are PVCs are tracked using the PVC snapshot tracker, so that when we backup
+			// PVCs/PVs via an item action in the next step, we don't snapshot PVs that will have their data
+			// backed up with restic.
+			volumes := restic.GetPodVolumesUsingRestic(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToRestic))
+
+			var trackedPVCs map[string]string
+			resticVolumesToBackup, trackedPVCs = ib.resticSnapshotTracker.TrackUntracked(pod, volumes)
+			for _, volume := range volumes {
+				pvcName, found := trackedPVCs[volume]
+				if !found {
 					continue
 				}
-
-				resticVolumesToBackup = append(resticVolumesToBackup, volume)
+				log.WithFields(map[string]interface{}{
+					"podVolume": volume,
+					"pvcName":   pvcName,
+				}).Info("Pod volume uses a persistent volume claim which has already been backed up with restic from another pod, skipping.")
 			}
-
-			// track the volumes that are PVCs using the PVC snapshot tracker, so that when we backup PVCs/PVs
-			// via an item action in the next step, we don't snapshot PVs that will have their data backed up
-			// with restic.
-			ib.resticSnapshotTracker.Track(pod, resticVolumesToBackup)
 		}
 	}
 
@@ -240,7 +247,9 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 		// even if there are errors.
 		podVolumeBackups, errs := ib.backupPodVolumes(log, pod, resticVolumesToBackup)
 
+		ib.backupRequest.lock.Lock()
 		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
+		ib.backupRequest.lock.Unlock()
 		backupErrs = append(backupErrs, errs...)
 	}
 
@@ -253,32 +262,36 @@ func (ib *defaultItemBackupper) backupItem(logger logrus.FieldLogger, obj runtim
 		return false, kubeerrs.NewAggregate(backupErrs)
 	}
 
-	var filePath string
-	if namespace != "" {
-		filePath = filepath.Join(api.ResourcesDir, groupResource.String(), api.NamespaceScopedDir, namespace, name+".json")
-	} else {
-		filePath = filepath.Join(api.ResourcesDir, groupResource.String(), api.ClusterScopedDir, name+".json")
-	}
-
 	itemBytes, err := json.Marshal(obj.UnstructuredContent())
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
 
-	hdr := &tar.Header{
-		Name:     filePath,
-		Size:     int64(len(itemBytes)),
-		Typeflag: tar.TypeReg,
-		Mode:     0755,
-		ModTime:  time.Now(),
+	if err := ib.itemWriter.writeItem(tarballItemPath(groupResource, "", namespace, name), itemBytes); err != nil {
+		return false, err
 	}
 
-	if err := ib.tarWriter.WriteHeader(hdr); err != nil {
-		return false, errors.WithStack(err)
+	// items are always backed up at the version preferred by the cluster, so
+	// also write the item to its preferred version directory if all API group
+	// versions are being backed up.
+	if features.IsEnabled(features.APIGroupVersionsFeatureFlag) {
+		versionDir := obj.GetObjectKind().GroupVersionKind().Version + api.PreferredVersionDir
+		if err := ib.itemWriter.writeItem(tarballItemPath(groupResource, versionDir, namespace, name), itemBytes); err != nil {
+			return false, err
+		}
 	}
 
-	if _, err := ib.tarWriter.Write(itemBytes); err != nil {
-		return false, errors.WithStack(err)
+	ib.backupRequest.progress.ItemDone()
+
+	if features.IsEnabled(api.CSIFeatureFlag) {
+		ib.backupRequest.lock.Lock()
+		switch groupResource {
+		case kuberesource.VolumeSnapshots:
+			ib.backupRequest.CSISnapshots = append(ib.backupRequest.CSISnapshots, &unstructured.Unstructured{Object: obj.UnstructuredContent()})
+		case kuberesource.VolumeSnapshotContents:
+			ib.backupRequest.CSISnapshotContents = append(ib.backupRequest.CSISnapshotContents, &unstructured.Unstructured{Object: obj.UnstructuredContent()})
+		}
+		ib.backupRequest.lock.Unlock()
 	}
 
 	return true, nil
@@ -329,7 +342,16 @@ func (ib *defaultItemBackupper) executeActions(
 
 		log.Info("Executing custom action")
 
-		updatedItem, additionalItemIdentifiers, err := action.Execute(obj, ib.backupRequest.Backup)
+		var (
+			updatedItem               runtime.Unstructured
+			additionalItemIdentifiers []velero.ResourceIdentifier
+			err                       error
+		)
+		if contextAction, ok := action.BackupItemAction.(velero.ContextBackupItemAction); ok {
+			updatedItem, additionalItemIdentifiers, err = contextAction.ExecuteWithContext(ib.backupRequest.context(), obj, ib.backupRequest.Backup)
+		} else {
+			updatedItem, additionalItemIdentifiers, err = action.Execute(obj, ib.backupRequest.Backup)
+		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 		}
@@ -363,6 +385,9 @@ func (ib *defaultItemBackupper) executeActions(
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *defaultItemBackupper) volumeSnapshotter(snapshotLocation *api.VolumeSnapshotLocation) (velero.VolumeSnapshotter, error) {
+	ib.snapshottersLock.Lock()
+	defer ib.snapshottersLock.Unlock()
+
 	if bs, ok := ib.snapshotLocationVolumeSnapshotters[snapshotLocation.Name]; ok {
 		return bs, nil
 	}
@@ -493,7 +518,9 @@ func (ib *defaultItemBackupper) takePVSnapshot(obj runtime.Unstructured, log log
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
 		snapshot.Status.ProviderSnapshotID = snapshotID
 	}
+	ib.backupRequest.lock.Lock()
 	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	ib.backupRequest.lock.Unlock()
 
 	// nil errors are automatically removed
 	return kubeerrs.NewAggregate(errs)
@@ -517,6 +544,33 @@ func volumeSnapshot(backup *api.Backup, volumeName, volumeID, volumeType, az, lo
 	}
 }
 
+// tarballItemPath returns the path of an item within the backup tarball. If versionDir
+// is non-empty, the path is within that API version directory of the resource.
+func tarballItemPath(groupResource schema.GroupResource, versionDir, namespace, name string) string {
+	return archive.GetVersionedItemFilePath("", groupResource.String(), versionDir, namespace, name)
+}
+
+// writeItem writes the JSON-encoded item to the tarball at the provided path.
+func writeItem(tarWriter tarWriter, filePath string, itemBytes []byte) error {
+	hdr := &tar.Header{
+		Name:     filePath,
+		Size:     int64(len(itemBytes)),
+		Typeflag: tar.TypeReg,
+		Mode:     0755,
+		ModTime:  time.Now(),
+	}
+
+	if err := tarWriter.WriteHeader(hdr); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := tarWriter.Write(itemBytes); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 // resourceKey returns a string representing the object's GroupVersionKind (e.g.
 // apps/v1/Deployment).
 func resourceKey(obj runtime.Unstructured) string {
@@ -18,14 +18,16 @@ package backup
 
 import (
 	"fmt"
+	"sync"
 
 	corev1api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // pvcSnapshotTracker keeps track of persistent volume claims that have been snapshotted
-// with restic.
+// with restic. It's safe to use from multiple goroutines.
 type pvcSnapshotTracker struct {
+	lock sync.RWMutex
 	pvcs sets.String
 }
 
@@ -35,9 +37,9 @@ func newPVCSnapshotTracker() *pvcSnapshotTracker {
 	}
 }
 
-// Track takes a pod and a list of volumes from that pod that were snapshotted, and
-// tracks each snapshotted volume that's a PVC.
-func (t *pvcSnapshotTracker) Track(pod *corev1api.Pod, snapshottedVolumes []string) {
+// track takes a pod and a list of volumes from that pod that were snapshotted, and
+// tracks each snapshotted volume that's a PVC. The caller must hold t.lock.
+func (t *pvcSnapshotTracker) track(pod *corev1api.Pod, snapshottedVolumes []string) {
 	for _, volumeName := range snapshottedVolumes {
 		// if the volume is a PVC, track it
 		for _, volume := range pod.Spec.Volumes {
@@ -51,14 +53,47 @@ func (t *pvcSnapshotTracker) Track(pod *corev1api.Pod, snapshottedVolumes []stri
 	}
 }
 
+// TrackUntracked takes a pod and a list of volumes from that pod to be snapshotted, and
+// tracks each volume that's a PVC which hasn't already been tracked. It returns the volumes
+// that should be snapshotted, and a map of volume name -> PVC name of the volumes whose
+// PVC had already been tracked. Checking and tracking happen atomically, so that pods
+// sharing a PVC that are backed up concurrently only snapshot it once.
+func (t *pvcSnapshotTracker) TrackUntracked(pod *corev1api.Pod, volumes []string) ([]string, map[string]string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var (
+		untracked []string
+		tracked   map[string]string
+	)
+	for _, volume := range volumes {
+		if found, pvcName := t.hasPVCForPodVolume(pod, volume); found {
+			if tracked == nil {
+				tracked = make(map[string]string)
+			}
+			tracked[volume] = pvcName
+			continue
+		}
+
+		untracked = append(untracked, volume)
+	}
+
+	t.track(pod, untracked)
+
+	return untracked, tracked
+}
+
 // Has returns true if the PVC with the specified namespace and name has been tracked.
 func (t *pvcSnapshotTracker) Has(namespace, name string) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	return t.pvcs.Has(key(namespace, name))
 }
 
-// HasPVCForPodVolume returns true and the PVC's name if the pod volume with the specified name uses a
-// PVC and that PVC has been tracked.
-func (t *pvcSnapshotTracker) HasPVCForPodVolume(pod *corev1api.Pod, volume string) (bool, string) {
+// hasPVCForPodVolume returns true and the PVC's name if the pod volume with the specified name uses a
+// PVC and that PVC has been tracked. The caller must hold t.lock.
+func (t *pvcSnapshotTracker) hasPVCForPodVolume(pod *corev1api.Pod, volume string) (bool, string) {
 	for _, podVolume := range pod.Spec.Volumes {
 		if podVolume.Name != volume {
 			continue
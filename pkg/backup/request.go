@@ -17,11 +17,16 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/progress"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -46,6 +51,52 @@ type Request struct {
 	VolumeSnapshots  []*volume.Snapshot
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	BackedUpItems    map[itemKey]struct{}
+
+	// CSISnapshots and CSISnapshotContents are the CSI VolumeSnapshots and
+	// VolumeSnapshotContents included in the backup. They're only populated
+	// when the CSI feature flag is enabled.
+	CSISnapshots        []*unstructured.Unstructured
+	CSISnapshotContents []*unstructured.Unstructured
+
+	// lock guards BackedUpItems, VolumeSnapshots, PodVolumeBackups, CSISnapshots
+	// and CSISnapshotContents while items are being backed up concurrently.
+	lock sync.Mutex
+
+	// progress tracks the number of items backed up so far, so it can be
+	// reported on the backup's status while it's being processed.
+	progress *progress.Tracker
+
+	// Context is canceled if the backup is canceled while it's running, in
+	// which case the items that haven't been backed up yet are skipped. If
+	// it's nil, the backup can't be canceled.
+	Context context.Context
+}
+
+// context returns the request's Context, or a context that's never canceled
+// if it doesn't have one.
+func (r *Request) context() context.Context {
+	if r.Context == nil {
+		return context.Background()
+	}
+	return r.Context
+}
+
+// canceled returns true if the backup has been canceled.
+func (r *Request) canceled() bool {
+	return r.context().Err() != nil
+}
+
+// markBackedUp records that the item identified by key is in the backup. It returns
+// false if the item had already been recorded.
+func (r *Request) markBackedUp(key itemKey) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, exists := r.BackedUpItems[key]; exists {
+		return false
+	}
+	r.BackedUpItems[key] = struct{}{}
+	return true
 }
 
 // BackupResourceList returns the list of backed up resources grouped by the API
@@ -17,6 +17,9 @@ limitations under the License.
 package backup
 
 import (
+	"encoding/json"
+	"sync"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,13 +29,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/parallel"
 )
 
 type resourceBackupperFactory interface {
@@ -43,7 +49,7 @@ type resourceBackupperFactory interface {
 		discoveryHelper discovery.Helper,
 		cohabitatingResources map[string]*cohabitatingResource,
 		podCommandExecutor podexec.PodCommandExecutor,
-		tarWriter tarWriter,
+		itemWriter *tarItemWriter,
 		resticBackupper restic.Backupper,
 		resticSnapshotTracker *pvcSnapshotTracker,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
@@ -59,7 +65,7 @@ func (f *defaultResourceBackupperFactory) newResourceBackupper(
 	discoveryHelper discovery.Helper,
 	cohabitatingResources map[string]*cohabitatingResource,
 	podCommandExecutor podexec.PodCommandExecutor,
-	tarWriter tarWriter,
+	itemWriter *tarItemWriter,
 	resticBackupper restic.Backupper,
 	resticSnapshotTracker *pvcSnapshotTracker,
 	volumeSnapshotterGetter VolumeSnapshotterGetter,
@@ -71,7 +77,7 @@ func (f *defaultResourceBackupperFactory) newResourceBackupper(
 		discoveryHelper:         discoveryHelper,
 		cohabitatingResources:   cohabitatingResources,
 		podCommandExecutor:      podCommandExecutor,
-		tarWriter:               tarWriter,
+		itemWriter:              itemWriter,
 		resticBackupper:         resticBackupper,
 		resticSnapshotTracker:   resticSnapshotTracker,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
@@ -91,7 +97,7 @@ type defaultResourceBackupper struct {
 	discoveryHelper         discovery.Helper
 	cohabitatingResources   map[string]*cohabitatingResource
 	podCommandExecutor      podexec.PodCommandExecutor
-	tarWriter               tarWriter
+	itemWriter              *tarItemWriter
 	resticBackupper         restic.Backupper
 	resticSnapshotTracker   *pvcSnapshotTracker
 	itemBackupperFactory    itemBackupperFactory
@@ -154,7 +160,7 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 	itemBackupper := rb.itemBackupperFactory.newItemBackupper(
 		rb.backupRequest,
 		rb.podCommandExecutor,
-		rb.tarWriter,
+		rb.itemWriter,
 		rb.dynamicFactory,
 		rb.discoveryHelper,
 		rb.resticBackupper,
@@ -179,6 +185,8 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 				}
 			}
 
+			rb.backupRequest.progress.AddTotalItems(len(namespacesToList))
+
 			for _, ns := range namespacesToList {
 				log = log.WithField("namespace", ns)
 				log.Info("Getting namespace")
@@ -208,47 +216,53 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 		namespacesToList = []string{""}
 	}
 
-	backedUpItem := false
-	for _, namespace := range namespacesToList {
-		log = log.WithField("namespace", namespace)
+	// list the items in each namespace, then back them all up, using up to the
+	// backup's number of item workers for each.
+	workers := rb.backupRequest.Spec.ItemWorkers
 
-		resourceClient, err := rb.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
-		if err != nil {
-			log.WithError(err).Error("Error getting dynamic client")
-			continue
-		}
+	itemLists := make([][]runtime.Unstructured, len(namespacesToList))
+	parallel.ForEach(workers, len(namespacesToList), func(i int) {
+		itemLists[i] = rb.listItems(log.WithField("namespace", namespacesToList[i]), gv, resource, namespacesToList[i])
+	})
 
-		var labelSelector string
-		if selector := rb.backupRequest.Spec.LabelSelector; selector != nil {
-			labelSelector = metav1.FormatLabelSelector(selector)
+	var (
+		itemLogs []logrus.FieldLogger
+		items    []runtime.Unstructured
+	)
+	for i, namespace := range namespacesToList {
+		for _, item := range itemLists[i] {
+			itemLogs = append(itemLogs, log.WithField("namespace", namespace))
+			items = append(items, item)
 		}
+	}
 
-		log.Info("Listing items")
-		unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector})
-		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error listing items")
-			continue
+	var (
+		lock         sync.Mutex
+		backedUpItem bool
+		// backedUpNames tracks the namespace/name of every item backed up for this
+		// resource, so that the same items can be backed up at the resource's other
+		// API versions.
+		backedUpNames = sets.NewString()
+	)
+	parallel.ForEach(workers, len(items), func(i int) {
+		if rb.backupRequest.canceled() {
+			return
 		}
-
-		// do the backup
-		items, err := meta.ExtractList(unstructuredList)
-		if err != nil {
-			log.WithError(errors.WithStack(err)).Error("Error extracting list")
-			continue
+		if !rb.backupItem(itemLogs[i], gr, itemBackupper, items[i]) {
+			return
 		}
 
-		log.Infof("Retrieved %d items", len(items))
+		lock.Lock()
+		defer lock.Unlock()
 
-		for _, item := range items {
-			unstructured, ok := item.(runtime.Unstructured)
-			if !ok {
-				log.Errorf("Unexpected type %T", item)
-				continue
-			}
-			if rb.backupItem(log, gr, itemBackupper, unstructured) {
-				backedUpItem = true
-			}
+		backedUpItem = true
+		if metadata, err := meta.Accessor(items[i]); err == nil {
+			backedUpNames.Insert(itemName(metadata.GetNamespace(), metadata.GetName()))
 		}
+	})
+
+	if backedUpItem && features.IsEnabled(features.APIGroupVersionsFeatureFlag) {
+		rb.backupNonPreferredVersions(log, gv, resource, gr, namespacesToList, backedUpNames)
 	}
 
 	// back up CRD for resource if found. We should only need to do this if we've backed up at least
@@ -261,6 +275,49 @@ func (rb *defaultResourceBackupper) backupResource(group *metav1.APIResourceList
 	return nil
 }
 
+// listItems returns the items of the resource in the specified namespace that match
+// the backup's label selector. Errors are logged rather than returned.
+func (rb *defaultResourceBackupper) listItems(log logrus.FieldLogger, gv schema.GroupVersion, resource metav1.APIResource, namespace string) []runtime.Unstructured {
+	resourceClient, err := rb.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+	if err != nil {
+		log.WithError(err).Error("Error getting dynamic client")
+		return nil
+	}
+
+	var labelSelector string
+	if selector := rb.backupRequest.Spec.LabelSelector; selector != nil {
+		labelSelector = metav1.FormatLabelSelector(selector)
+	}
+
+	log.Info("Listing items")
+	unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing items")
+		return nil
+	}
+
+	list, err := meta.ExtractList(unstructuredList)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error extracting list")
+		return nil
+	}
+
+	log.Infof("Retrieved %d items", len(list))
+	rb.backupRequest.progress.AddTotalItems(len(list))
+
+	var items []runtime.Unstructured
+	for _, item := range list {
+		unstructured, ok := item.(runtime.Unstructured)
+		if !ok {
+			log.Errorf("Unexpected type %T", item)
+			continue
+		}
+		items = append(items, unstructured)
+	}
+
+	return items
+}
+
 func (rb *defaultResourceBackupper) backupItem(
 	log logrus.FieldLogger,
 	gr schema.GroupResource,
@@ -296,6 +353,106 @@ func (rb *defaultResourceBackupper) backupItem(
 	return backedUpItem
 }
 
+// backupNonPreferredVersions writes the items that were backed up for a resource to the
+// backup at each of the API versions served for the resource's group other than the
+// preferred one, so that the backup can be restored into clusters that don't serve the
+// preferred version. Items are written as they're returned by the API server; backup
+// item actions and hooks are only run for the preferred version.
+func (rb *defaultResourceBackupper) backupNonPreferredVersions(
+	log logrus.FieldLogger,
+	preferredGV schema.GroupVersion,
+	resource metav1.APIResource,
+	gr schema.GroupResource,
+	namespaces []string,
+	backedUpNames sets.String,
+) {
+	var labelSelector string
+	if selector := rb.backupRequest.Spec.LabelSelector; selector != nil {
+		labelSelector = metav1.FormatLabelSelector(selector)
+	}
+
+	for _, version := range rb.servedVersions(preferredGV.Group) {
+		if version == preferredGV.Version {
+			continue
+		}
+
+		gv := schema.GroupVersion{Group: preferredGV.Group, Version: version}
+		log := log.WithField("version", version)
+
+		for _, namespace := range namespaces {
+			resourceClient, err := rb.dynamicFactory.ClientForGroupVersionResource(gv, resource, namespace)
+			if err != nil {
+				log.WithError(err).Error("Error getting dynamic client")
+				continue
+			}
+
+			log.Info("Listing items for non-preferred API version")
+			unstructuredList, err := resourceClient.List(metav1.ListOptions{LabelSelector: labelSelector})
+			if apierrors.IsNotFound(err) {
+				// not every version of a group serves every resource in the group.
+				log.Debug("Resource is not served at this API version")
+				break
+			}
+			if err != nil {
+				log.WithError(errors.WithStack(err)).Error("Error listing items")
+				continue
+			}
+
+			items, err := meta.ExtractList(unstructuredList)
+			if err != nil {
+				log.WithError(errors.WithStack(err)).Error("Error extracting list")
+				continue
+			}
+
+			for _, item := range items {
+				metadata, err := meta.Accessor(item)
+				if err != nil {
+					log.WithError(errors.WithStack(err)).Error("Error getting a metadata accessor")
+					continue
+				}
+
+				if !backedUpNames.Has(itemName(metadata.GetNamespace(), metadata.GetName())) {
+					continue
+				}
+
+				itemBytes, err := json.Marshal(item)
+				if err != nil {
+					log.WithError(errors.WithStack(err)).WithField("name", metadata.GetName()).Error("Error marshaling item")
+					continue
+				}
+
+				if err := rb.itemWriter.writeItem(tarballItemPath(gr, version, metadata.GetNamespace(), metadata.GetName()), itemBytes); err != nil {
+					log.WithError(err).WithField("name", metadata.GetName()).Error("Error backing up item")
+				}
+			}
+		}
+	}
+}
+
+// servedVersions returns the API versions served for the provided group, in the
+// cluster's order of preference.
+func (rb *defaultResourceBackupper) servedVersions(group string) []string {
+	var versions []string
+	for _, apiGroup := range rb.discoveryHelper.APIGroups() {
+		if apiGroup.Name != group {
+			continue
+		}
+
+		for _, version := range apiGroup.Versions {
+			versions = append(versions, version.Version)
+		}
+	}
+
+	return versions
+}
+
+func itemName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
 // backupCRD checks if the resource is a custom resource, and if so, backs up the custom resource definition
 // associated with it.
 func (rb *defaultResourceBackupper) backupCRD(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper ItemBackupper) {
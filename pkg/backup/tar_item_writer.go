@@ -0,0 +1,63 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+// tarItemWriter writes items to a tarWriter from a single goroutine, so that items
+// backed up concurrently are never interleaved within the tarball.
+type tarItemWriter struct {
+	items chan tarItem
+	done  chan struct{}
+}
+
+type tarItem struct {
+	filePath  string
+	itemBytes []byte
+	result    chan error
+}
+
+// newTarItemWriter returns a tarItemWriter that owns tw. Its close method must
+// be called once no more items will be written.
+func newTarItemWriter(tw tarWriter) *tarItemWriter {
+	w := &tarItemWriter{
+		items: make(chan tarItem),
+		done:  make(chan struct{}),
+	}
+
+	go func() {
+		defer close(w.done)
+		for item := range w.items {
+			item.result <- writeItem(tw, item.filePath, item.itemBytes)
+		}
+	}()
+
+	return w
+}
+
+// writeItem writes the JSON-encoded item to the tarball at the provided path,
+// returning once it's been written. It's safe to call from multiple goroutines.
+func (w *tarItemWriter) writeItem(filePath string, itemBytes []byte) error {
+	result := make(chan error, 1)
+	w.items <- tarItem{filePath: filePath, itemBytes: itemBytes, result: result}
+	return <-result
+}
+
+// close stops the goroutine that owns the tarWriter, once it's written all
+// pending items. It doesn't close the tarWriter itself.
+func (w *tarItemWriter) close() {
+	close(w.items)
+	<-w.done
+}
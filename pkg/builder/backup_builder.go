@@ -122,6 +122,24 @@ func (b *BackupBuilder) LabelSelector(selector *metav1.LabelSelector) *BackupBui
 	return b
 }
 
+// DefaultVolumesToRestic sets the Backup's "default volumes to restic" flag.
+func (b *BackupBuilder) DefaultVolumesToRestic(val bool) *BackupBuilder {
+	b.object.Spec.DefaultVolumesToRestic = &val
+	return b
+}
+
+// ItemWorkers sets the number of items in each resource that the Backup backs up concurrently.
+func (b *BackupBuilder) ItemWorkers(val int) *BackupBuilder {
+	b.object.Spec.ItemWorkers = val
+	return b
+}
+
+// Cancel sets the Backup's cancel flag.
+func (b *BackupBuilder) Cancel(val bool) *BackupBuilder {
+	b.object.Spec.Cancel = val
+	return b
+}
+
 // SnapshotVolumes sets the Backup's "snapshot volumes" flag.
 func (b *BackupBuilder) SnapshotVolumes(val bool) *BackupBuilder {
 	b.object.Spec.SnapshotVolumes = &val
@@ -134,6 +152,12 @@ func (b *BackupBuilder) Phase(phase velerov1api.BackupPhase) *BackupBuilder {
 	return b
 }
 
+// Verification sets the Backup's verification status.
+func (b *BackupBuilder) Verification(verification *velerov1api.BackupVerification) *BackupBuilder {
+	b.object.Status.Verification = verification
+	return b
+}
+
 // StorageLocation sets the Backup's storage location.
 func (b *BackupBuilder) StorageLocation(location string) *BackupBuilder {
 	b.object.Spec.StorageLocation = location
@@ -17,6 +17,7 @@ limitations under the License.
 package builder
 
 import (
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -86,3 +87,27 @@ func (b *BackupStorageLocationBuilder) AccessMode(accessMode velerov1api.BackupS
 	b.object.Spec.AccessMode = accessMode
 	return b
 }
+
+// Default sets the BackupStorageLocation's default flag.
+func (b *BackupStorageLocationBuilder) Default(isDefault bool) *BackupStorageLocationBuilder {
+	b.object.Spec.Default = isDefault
+	return b
+}
+
+// Credential sets the BackupStorageLocation's credential selector.
+func (b *BackupStorageLocationBuilder) Credential(selector *corev1api.SecretKeySelector) *BackupStorageLocationBuilder {
+	b.object.Spec.Credential = selector
+	return b
+}
+
+// Phase sets the BackupStorageLocation's status phase.
+func (b *BackupStorageLocationBuilder) Phase(phase velerov1api.BackupStorageLocationPhase) *BackupStorageLocationBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// Message sets the BackupStorageLocation's status message.
+func (b *BackupStorageLocationBuilder) Message(message string) *BackupStorageLocationBuilder {
+	b.object.Status.Message = message
+	return b
+}
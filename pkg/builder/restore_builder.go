@@ -17,6 +17,9 @@ limitations under the License.
 package builder
 
 import (
+	"time"
+
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -133,3 +136,48 @@ func (b *RestoreBuilder) RestorePVs(val bool) *RestoreBuilder {
 	b.object.Spec.RestorePVs = &val
 	return b
 }
+
+// ExistingResourcePolicy sets the Restore's existing resource policy.
+func (b *RestoreBuilder) ExistingResourcePolicy(policy velerov1api.PolicyType) *RestoreBuilder {
+	b.object.Spec.ExistingResourcePolicy = policy
+	return b
+}
+
+// ResourceModifier sets the Restore's resource modifier ConfigMap.
+func (b *RestoreBuilder) ResourceModifier(configMapName string) *RestoreBuilder {
+	b.object.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+		Kind: "ConfigMap",
+		Name: configMapName,
+	}
+	return b
+}
+
+// DryRun sets the Restore's dry run flag.
+func (b *RestoreBuilder) DryRun(val bool) *RestoreBuilder {
+	b.object.Spec.DryRun = val
+	return b
+}
+
+// ItemWorkers sets the number of items that the Restore restores concurrently.
+func (b *RestoreBuilder) ItemWorkers(val int) *RestoreBuilder {
+	b.object.Spec.ItemWorkers = val
+	return b
+}
+
+// WaitForReady sets whether the Restore waits for its restored items to become ready.
+func (b *RestoreBuilder) WaitForReady(val bool) *RestoreBuilder {
+	b.object.Spec.WaitForReady = val
+	return b
+}
+
+// Cancel sets the Restore's cancel flag.
+func (b *RestoreBuilder) Cancel(val bool) *RestoreBuilder {
+	b.object.Spec.Cancel = val
+	return b
+}
+
+// ReadyTimeout sets how long the Restore waits for its restored items to become ready.
+func (b *RestoreBuilder) ReadyTimeout(val time.Duration) *RestoreBuilder {
+	b.object.Spec.ReadyTimeout.Duration = val
+	return b
+}
@@ -89,3 +89,15 @@ func (b *ScheduleBuilder) Template(spec velerov1api.BackupSpec) *ScheduleBuilder
 	b.object.Spec.Template = spec
 	return b
 }
+
+// Paused sets the Schedule's paused flag.
+func (b *ScheduleBuilder) Paused(val bool) *ScheduleBuilder {
+	b.object.Spec.Paused = val
+	return b
+}
+
+// Retention sets the Schedule's retention policy.
+func (b *ScheduleBuilder) Retention(policy *velerov1api.RetentionPolicy) *ScheduleBuilder {
+	b.object.Spec.Retention = policy
+	return b
+}
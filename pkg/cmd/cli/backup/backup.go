@@ -36,6 +36,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewDescribeCommand(f, "describe"),
 		NewDownloadCommand(f),
 		NewDeleteCommand(f, "delete"),
+		NewVerifyCommand(f),
+		NewGetItemCommand(f),
+		NewTreeCommand(f),
+		NewDiffCommand(f),
+		NewCancelCommand(f),
 	)
 
 	return c
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewCancelCommand creates and returns a new cobra command for canceling a backup
+// that hasn't finished.
+func NewCancelCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "cancel NAME",
+		Short: "Cancel a backup that's in progress",
+		Long: `Request that the Velero server stop a backup that's New or InProgress. Items that
+haven't been backed up yet are skipped, pending restic pod volume backups are stopped, and
+the backup ends in the Canceled phase. The backup's log is still uploaded to object storage.`,
+		Example: `	# cancel a backup named "backup-1"
+	velero backup cancel backup-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(Cancel(f, args[0]))
+		},
+	}
+
+	return c
+}
+
+// Cancel sets the cancel field on the spec of the backup identified by name.
+func Cancel(f client.Factory, name string) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch backup.Status.Phase {
+	case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+		// hasn't finished, so it can be canceled
+	default:
+		return errors.Errorf("backup %q is %s, only New or InProgress backups can be canceled", backup.Name, backup.Status.Phase)
+	}
+
+	patch := []byte(`{"spec":{"cancel":true}}`)
+	if _, err := veleroClient.VeleroV1().Backups(backup.Namespace).Patch(backup.Name, types.MergePatchType, patch); err != nil {
+		return errors.Wrapf(err, "error patching backup %q", backup.Name)
+	}
+
+	fmt.Printf("Cancellation of backup %q requested successfully.\n", backup.Name)
+	fmt.Printf("Run `velero backup describe %s` to check that it was canceled.\n", backup.Name)
+	return nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// streamBackupContents starts streaming the named backup's tarball from object
+// storage via a download request, decrypting it if needed, and returns a reader
+// for the still-compressed tarball along with the backup. The caller must close
+// the reader, which stops the download if it hasn't finished.
+func streamBackupContents(f client.Factory, name string, timeout time.Duration, insecureSkipTLSVerify bool) (io.ReadCloser, *velerov1api.Backup, error) {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubeClient, err := f.KubeClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	encryptionKey, err := downloadrequest.BackupEncryptionKey(kubeClient.CoreV1(), backup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(downloadrequest.Stream(veleroClient.VeleroV1(), f.Namespace(), name, velerov1api.DownloadTargetKindBackupContents, encryptionKey, pw, timeout, insecureSkipTLSVerify))
+	}()
+
+	return pr, backup, nil
+}
+
+// downloadAndExtractBackup streams the named backup's tarball from object
+// storage via a download request and extracts it to a temp directory, returning
+// the directory's path. The caller should remove the directory when done with it.
+func downloadAndExtractBackup(f client.Factory, fs filesystem.Interface, log logrus.FieldLogger, name string, timeout time.Duration, insecureSkipTLSVerify bool) (string, error) {
+	contents, backup, err := streamBackupContents(f, name, timeout, insecureSkipTLSVerify)
+	if err != nil {
+		return "", err
+	}
+	// unblock the download if extracting stopped early.
+	defer contents.Close()
+
+	// extract the tarball as it's downloaded, rather than saving it to disk first.
+	dir, err := archive.NewExtractor(log, fs).UnzipAndExtractBackup(contents, backup.Status.Compression)
+	if err != nil {
+		return "", errors.Wrap(err, "error extracting backup")
+	}
+
+	return dir, nil
+}
+
+// readBackupItem reads the backup tarball from r, compressed with the given format,
+// until it finds the file of a single item, and returns the decoded item. namespace
+// should be empty for cluster-scoped items.
+func readBackupItem(r io.Reader, compression velerov1api.BackupCompression, groupResource, namespace, name string) (*unstructured.Unstructured, error) {
+	decompressor, err := archive.NewDecompressor(r, compression)
+	if err != nil {
+		return nil, err
+	}
+	defer decompressor.Close()
+
+	resourceDir := path.Join(velerov1api.ResourcesDir, groupResource) + "/"
+
+	var itemPath string
+	if namespace == "" {
+		itemPath = path.Join(resourceDir, velerov1api.ClusterScopedDir, name+".json")
+	} else {
+		itemPath = path.Join(resourceDir, velerov1api.NamespaceScopedDir, namespace, name+".json")
+	}
+
+	var resourceFound bool
+	tarReader := tar.NewReader(decompressor)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading backup tarball")
+		}
+
+		if !strings.HasPrefix(header.Name, resourceDir) {
+			continue
+		}
+		resourceFound = true
+
+		if header.Name != itemPath {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", itemPath)
+		}
+
+		obj := new(unstructured.Unstructured)
+		if err := obj.UnmarshalJSON(data); err != nil {
+			return nil, errors.Wrapf(err, "error decoding %s", path.Base(itemPath))
+		}
+		return obj, nil
+	}
+
+	if !resourceFound {
+		return nil, errors.Errorf("backup does not contain any %s", groupResource)
+	}
+	if namespace == "" {
+		return nil, errors.Errorf("backup does not contain cluster-scoped %s %q", groupResource, name)
+	}
+	return nil, errors.Errorf("backup does not contain %s %q in namespace %q", groupResource, name, namespace)
+}
+
+// readBackupItemFile reads and decodes an item's file from a backup that's been
+// extracted to dir.
+func readBackupItemFile(fs filesystem.Interface, dir, groupResource, namespace, name string) (*unstructured.Unstructured, error) {
+	path := archive.GetItemFilePath(dir, groupResource, namespace, name)
+
+	data, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	obj := new(unstructured.Unstructured)
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s", filepath.Base(path))
+	}
+
+	return obj, nil
+}
+
+// printResourceTree prints the resources in a backup as a tree of
+// resource, then namespace, then item name.
+func printResourceTree(w io.Writer, resources map[string]*archive.ResourceItems) {
+	var groupResources []string
+	for groupResource := range resources {
+		groupResources = append(groupResources, groupResource)
+	}
+	sort.Strings(groupResources)
+
+	for _, groupResource := range groupResources {
+		itemsByNamespace := resources[groupResource].ItemsByNamespace
+		if len(itemsByNamespace) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s\n", groupResource)
+
+		var namespaces []string
+		for namespace := range itemsByNamespace {
+			namespaces = append(namespaces, namespace)
+		}
+		sort.Strings(namespaces)
+
+		for _, namespace := range namespaces {
+			if namespace == "" {
+				fmt.Fprintf(w, "  <cluster-scoped>\n")
+			} else {
+				fmt.Fprintf(w, "  %s\n", namespace)
+			}
+
+			items := append([]string(nil), itemsByNamespace[namespace]...)
+			sort.Strings(items)
+			for _, item := range items {
+				fmt.Fprintf(w, "    %s\n", item)
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+func TestReadBackupItem(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, data := range map[string]string{
+		"metadata/version": "1",
+		"resources/configmaps/namespaces/ns-1/cm-1.json":                                `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"cm-1"},"data":{"key":"value"}}`,
+		"resources/storageclasses.storage.k8s.io/cluster/fast.json":                     `{"apiVersion":"storage.k8s.io/v1","kind":"StorageClass","metadata":{"name":"fast"}}`,
+		"resources/storageclasses.storage.k8s.io/v1-preferredversion/cluster/fast.json": `{"apiVersion":"storage.k8s.io/v1","kind":"StorageClass","metadata":{"name":"fast"}}`,
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(data)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	tests := []struct {
+		name          string
+		groupResource string
+		namespace     string
+		itemName      string
+		wantKind      string
+		wantErr       string
+	}{
+		{
+			name:          "namespaced item is found",
+			groupResource: "configmaps",
+			namespace:     "ns-1",
+			itemName:      "cm-1",
+			wantKind:      "ConfigMap",
+		},
+		{
+			name:          "cluster-scoped item is found",
+			groupResource: "storageclasses.storage.k8s.io",
+			itemName:      "fast",
+			wantKind:      "StorageClass",
+		},
+		{
+			name:          "resource not in backup returns an error",
+			groupResource: "secrets",
+			namespace:     "ns-1",
+			itemName:      "cm-1",
+			wantErr:       "backup does not contain any secrets",
+		},
+		{
+			name:          "item in a different namespace returns an error",
+			groupResource: "configmaps",
+			namespace:     "ns-2",
+			itemName:      "cm-1",
+			wantErr:       `backup does not contain configmaps "cm-1" in namespace "ns-2"`,
+		},
+		{
+			name:          "namespaced item without a namespace returns an error",
+			groupResource: "configmaps",
+			itemName:      "cm-1",
+			wantErr:       `backup does not contain cluster-scoped configmaps "cm-1"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			item, err := readBackupItem(bytes.NewReader(buf.Bytes()), velerov1api.BackupCompressionNone, tc.groupResource, tc.namespace, tc.itemName)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantKind, item.GetKind())
+			assert.Equal(t, tc.namespace, item.GetNamespace())
+			assert.Equal(t, tc.itemName, item.GetName())
+		})
+	}
+}
+
+func TestPrintResourceTree(t *testing.T) {
+	resources := map[string]*archive.ResourceItems{
+		"pods": {
+			GroupResource: "pods",
+			ItemsByNamespace: map[string][]string{
+				"ns-2": {"pod-2"},
+				"ns-1": {"pod-3", "pod-1"},
+			},
+		},
+		"persistentvolumes": {
+			GroupResource: "persistentvolumes",
+			ItemsByNamespace: map[string][]string{
+				"": {"pv-1"},
+			},
+		},
+		"secrets": {
+			GroupResource:    "secrets",
+			ItemsByNamespace: map[string][]string{},
+		},
+	}
+
+	var buf bytes.Buffer
+	printResourceTree(&buf, resources)
+
+	want := `persistentvolumes
+  <cluster-scoped>
+    pv-1
+pods
+  ns-1
+    pod-1
+    pod-3
+  ns-2
+    pod-2
+`
+	assert.Equal(t, want, buf.String())
+}
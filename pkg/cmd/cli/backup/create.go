@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -89,6 +90,8 @@ type CreateOptions struct {
 	StorageLocation         string
 	SnapshotLocations       []string
 	FromSchedule            string
+	DefaultVolumesToRestic  flag.OptionalBool
+	ItemWorkers             int
 
 	client veleroclient.Interface
 }
@@ -100,6 +103,7 @@ func NewCreateOptions() *CreateOptions {
 		Labels:                  flag.NewMap(),
 		SnapshotVolumes:         flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		DefaultVolumesToRestic:  flag.NewOptionalBool(nil),
 	}
 }
 
@@ -120,6 +124,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "include cluster-scoped resources in the backup")
 	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "use restic by default to backup all pod volumes")
+	f.NoOptDefVal = "true"
+
+	flags.IntVar(&o.ItemWorkers, "item-workers", o.ItemWorkers, "how many items in each resource to back up concurrently. If unset, the server's default is used.")
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -139,6 +148,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return err
 	}
 
+	if o.ItemWorkers < 0 {
+		return errors.New("--item-workers must be non-negative")
+	}
+
 	if o.StorageLocation != "" {
 		if _, err := o.client.VeleroV1().BackupStorageLocations(f.Namespace()).Get(o.StorageLocation, metav1.GetOptions{}); err != nil {
 			return err
@@ -272,7 +285,8 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 			LabelSelector(o.Selector.LabelSelector).
 			TTL(o.TTL).
 			StorageLocation(o.StorageLocation).
-			VolumeSnapshotLocations(o.SnapshotLocations...)
+			VolumeSnapshotLocations(o.SnapshotLocations...).
+			ItemWorkers(o.ItemWorkers)
 
 		if o.SnapshotVolumes.Value != nil {
 			backupBuilder.SnapshotVolumes(*o.SnapshotVolumes.Value)
@@ -280,6 +294,9 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
+		if o.DefaultVolumesToRestic.Value != nil {
+			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
@@ -27,6 +27,7 @@ import (
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 )
@@ -72,7 +73,17 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Fprintf(os.Stderr, "error getting PodVolumeBackups for backup %s: %v\n", backup.Name, err)
 				}
 
-				s := output.DescribeBackup(&backup, deleteRequestList.Items, podVolumeBackupList.Items, details, veleroClient, insecureSkipTLSVerify)
+				var encryptionKey []byte
+				if details && backup.Status.EncryptionKeyID != "" {
+					kubeClient, err := f.KubeClient()
+					cmd.CheckError(err)
+
+					if encryptionKey, err = downloadrequest.BackupEncryptionKey(kubeClient.CoreV1(), &backup); err != nil {
+						fmt.Fprintf(os.Stderr, "error getting encryption key for backup %s: %v\n", backup.Name, err)
+					}
+				}
+
+				s := output.DescribeBackup(&backup, deleteRequestList.Items, podVolumeBackupList.Items, details, veleroClient, encryptionKey, insecureSkipTLSVerify)
 				if first {
 					first = false
 					fmt.Print(s)
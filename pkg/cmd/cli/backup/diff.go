@@ -0,0 +1,513 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// NewDiffCommand creates and returns a new cobra command for comparing
+// a backup's contents with another backup or with the cluster.
+func NewDiffCommand(f client.Factory) *cobra.Command {
+	o := NewDiffOptions()
+
+	c := &cobra.Command{
+		Use:   "diff NAME (--against OTHER_BACKUP | --live)",
+		Short: "Compare a backup with another backup or with the cluster",
+		Long: `Compare the items in a backup with the items in another backup, or with the items
+currently in the cluster, and print the items that were added, removed, or changed, with
+field-level differences for changed items. Fields that restores ignore, namely the status
+and all metadata except the name, namespace, labels, and annotations, are not compared.
+
+With --live, the items in the cluster are selected using the backup's included and
+excluded resources and namespaces, and its label selector, so that only the items the
+backup would include if it were taken now are compared.`,
+		Example: `	# show what changed in the cluster since backup "backup-1" was taken
+	velero backup diff backup-1 --live
+
+	# show what changed between backups "backup-1" and "backup-2"
+	velero backup diff backup-1 --against backup-2`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type DiffOptions struct {
+	BackupName            string
+	Against               string
+	Live                  bool
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+}
+
+func NewDiffOptions() *DiffOptions {
+	return &DiffOptions{
+		Timeout: time.Minute,
+	}
+}
+
+func (o *DiffOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Against, "against", o.Against, "name of another backup to compare the backup with")
+	flags.BoolVar(&o.Live, "live", o.Live, "compare the backup with the items currently in the cluster")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "maximum time to wait to process each download request")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+}
+
+func (o *DiffOptions) Complete(args []string) error {
+	o.BackupName = args[0]
+	return nil
+}
+
+func (o *DiffOptions) Validate() error {
+	if (o.Against == "") == !o.Live {
+		return errors.New("exactly one of --against or --live is required")
+	}
+	return nil
+}
+
+func (o *DiffOptions) Run(f client.Factory) error {
+	log := logrus.New()
+	log.Level = logrus.WarnLevel
+	fs := filesystem.NewFileSystem()
+
+	base, err := o.backupItems(f, fs, log, o.BackupName)
+	if err != nil {
+		return err
+	}
+
+	var target map[itemKey]*unstructured.Unstructured
+	if o.Live {
+		target, err = liveItems(f, log, o.BackupName, base)
+	} else {
+		target, err = o.backupItems(f, fs, log, o.Against)
+	}
+	if err != nil {
+		return err
+	}
+
+	printItemsDiff(os.Stdout, diffItems(base, target))
+	return nil
+}
+
+// backupItems downloads, extracts and loads all of the items in the named backup.
+func (o *DiffOptions) backupItems(f client.Factory, fs filesystem.Interface, log logrus.FieldLogger, name string) (map[itemKey]*unstructured.Unstructured, error) {
+	dir, err := downloadAndExtractBackup(f, fs, log, name, o.Timeout, o.InsecureSkipTLSVerify)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error downloading backup %s", name)
+	}
+	defer fs.RemoveAll(dir)
+
+	resources, err := archive.NewParser(log, fs).Parse(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing contents of backup %s", name)
+	}
+
+	return loadBackupItems(fs, dir, resources)
+}
+
+// liveItems returns the items currently in the cluster that the named backup
+// would include, along with the items of the resources and namespaces in base.
+func liveItems(f client.Factory, log logrus.FieldLogger, backupName string, base map[itemKey]*unstructured.Unstructured) (map[itemKey]*unstructured.Unstructured, error) {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(backupName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	kubeClient, err := f.KubeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := f.DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryHelper, err := discovery.NewHelper(kubeClient.Discovery(), log)
+	if err != nil {
+		return nil, errors.Wrap(err, "error initializing discovery helper")
+	}
+
+	return loadLiveItems(discoveryHelper, client.NewDynamicFactory(dynamicClient), log, backup, base)
+}
+
+// itemKey identifies an item in a backup or in the cluster.
+type itemKey struct {
+	resource  string
+	namespace string
+	name      string
+}
+
+func (k itemKey) String() string {
+	if k.namespace == "" {
+		return fmt.Sprintf("%s %s", k.resource, k.name)
+	}
+	return fmt.Sprintf("%s %s/%s", k.resource, k.namespace, k.name)
+}
+
+// loadBackupItems reads all of the items from a backup that's been extracted
+// to dir and parsed into resources.
+func loadBackupItems(fs filesystem.Interface, dir string, resources map[string]*archive.ResourceItems) (map[itemKey]*unstructured.Unstructured, error) {
+	items := make(map[itemKey]*unstructured.Unstructured)
+
+	for groupResource, resourceItems := range resources {
+		for namespace, names := range resourceItems.ItemsByNamespace {
+			for _, name := range names {
+				obj, err := readBackupItemFile(fs, dir, groupResource, namespace, name)
+				if err != nil {
+					return nil, err
+				}
+				items[itemKey{resource: groupResource, namespace: namespace, name: name}] = obj
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// loadLiveItems lists the items currently in the cluster that the backup would
+// include, based on its spec's included and excluded resources and namespaces and
+// its label selector. Since plugins can add items to a backup that its filters
+// don't select, the resources and namespaces of the items in base are always
+// listed too; for a cluster-scoped resource that the filters don't select, only
+// the items in base are compared. Resources that no longer exist in the cluster
+// are skipped, so their items show up as removed.
+func loadLiveItems(discoveryHelper discovery.Helper, dynamicFactory client.DynamicFactory, log logrus.FieldLogger, backup *velerov1api.Backup, base map[itemKey]*unstructured.Unstructured) (map[itemKey]*unstructured.Unstructured, error) {
+	resourceIncludesExcludes := collections.GenerateIncludesExcludes(
+		backup.Spec.IncludedResources,
+		backup.Spec.ExcludedResources,
+		func(item string) string {
+			gvr, _, err := discoveryHelper.ResourceFor(schema.ParseGroupResource(item).WithVersion(""))
+			if err != nil {
+				return ""
+			}
+			return gvr.GroupResource().String()
+		},
+	)
+	namespaceIncludesExcludes := collections.NewIncludesExcludes().
+		Includes(backup.Spec.IncludedNamespaces...).
+		Excludes(backup.Spec.ExcludedNamespaces...)
+
+	// selected holds the resources the backup's filters select; groupResources
+	// also holds the resources of the items in base.
+	selected := sets.NewString()
+	groupResources := sets.NewString()
+	namespaces := sets.NewString()
+	for key := range base {
+		groupResources.Insert(key.resource)
+		if key.namespace != "" {
+			namespaces.Insert(key.namespace)
+		}
+	}
+
+	for _, resourceGroup := range discoveryHelper.Resources() {
+		gv, err := schema.ParseGroupVersion(resourceGroup.GroupVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing GroupVersion %s", resourceGroup.GroupVersion)
+		}
+
+		for _, resource := range resourceGroup.APIResources {
+			gr := gv.WithResource(resource.Name).GroupResource()
+			if !resourceIncludesExcludes.ShouldInclude(gr.String()) {
+				continue
+			}
+
+			// cluster-scoped resources are backed up following the same rules as
+			// the backup's IncludeClusterResources setting.
+			if !resource.Namespaced && gr != kuberesource.Namespaces {
+				includeClusterResources := backup.Spec.IncludeClusterResources
+				if includeClusterResources == nil && !namespaceIncludesExcludes.IncludeEverything() {
+					continue
+				}
+				if includeClusterResources != nil && !*includeClusterResources {
+					continue
+				}
+			}
+
+			selected.Insert(gr.String())
+		}
+	}
+	groupResources.Insert(selected.UnsortedList()...)
+
+	var listOptions metav1.ListOptions
+	if backup.Spec.LabelSelector != nil {
+		listOptions.LabelSelector = metav1.FormatLabelSelector(backup.Spec.LabelSelector)
+	}
+
+	// shouldIncludeNamespace returns whether items in, or named after, the namespace are selected.
+	shouldIncludeNamespace := func(namespace string) bool {
+		return namespaces.Has(namespace) || namespaceIncludesExcludes.ShouldInclude(namespace)
+	}
+
+	items := make(map[itemKey]*unstructured.Unstructured)
+
+	for _, groupResource := range groupResources.List() {
+		gvr, apiResource, err := discoveryHelper.ResourceFor(schema.ParseGroupResource(groupResource).WithVersion(""))
+		if err != nil {
+			log.Warnf("Resource %s was not found in the cluster, so its items are shown as removed", groupResource)
+			continue
+		}
+
+		// namespaced items are listed across all namespaces, then filtered.
+		resourceClient, err := dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), apiResource, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting client for %s", groupResource)
+		}
+
+		res, err := resourceClient.List(listOptions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing %s", groupResource)
+		}
+
+		list, ok := res.(*unstructured.UnstructuredList)
+		if !ok {
+			return nil, errors.Errorf("unexpected type %T listing %s", res, groupResource)
+		}
+
+		for i := range list.Items {
+			item := &list.Items[i]
+			key := itemKey{resource: groupResource, namespace: item.GetNamespace(), name: item.GetName()}
+			_, inBase := base[key]
+
+			switch {
+			case inBase:
+				// items in the backup are always compared.
+			case apiResource.Namespaced && !shouldIncludeNamespace(item.GetNamespace()):
+				continue
+			case !apiResource.Namespaced && !selected.Has(groupResource):
+				continue
+			case gvr.GroupResource() == kuberesource.Namespaces && !shouldIncludeNamespace(item.GetName()):
+				continue
+			}
+
+			items[key] = item
+		}
+	}
+
+	return items, nil
+}
+
+// changedItem is an item that's in both sides of a diff, with the
+// differences between its two versions.
+type changedItem struct {
+	key    itemKey
+	fields []string
+}
+
+// itemsDiff is the result of comparing two sets of items.
+type itemsDiff struct {
+	added   []itemKey
+	removed []itemKey
+	changed []changedItem
+}
+
+// diffItems compares the items in base and target, ignoring the fields that
+// restore.ResetMetadataAndStatus strips. Items in base and target are modified.
+func diffItems(base, target map[itemKey]*unstructured.Unstructured) itemsDiff {
+	var res itemsDiff
+
+	for key, baseObj := range base {
+		targetObj, ok := target[key]
+		if !ok {
+			res.removed = append(res.removed, key)
+			continue
+		}
+
+		fields := fieldDiffs("", normalize(baseObj), normalize(targetObj))
+		if len(fields) > 0 {
+			res.changed = append(res.changed, changedItem{key: key, fields: fields})
+		}
+	}
+
+	for key := range target {
+		if _, ok := base[key]; !ok {
+			res.added = append(res.added, key)
+		}
+	}
+
+	sortKeys(res.added)
+	sortKeys(res.removed)
+	sort.Slice(res.changed, func(i, j int) bool {
+		return lessKey(res.changed[i].key, res.changed[j].key)
+	})
+
+	return res
+}
+
+// normalize returns obj's content without the fields that restores ignore.
+// Objects without metadata are compared as-is.
+func normalize(obj *unstructured.Unstructured) map[string]interface{} {
+	if res, err := restore.ResetMetadataAndStatus(obj); err == nil {
+		return res.Object
+	}
+	return obj.Object
+}
+
+// fieldDiffs returns a line for each field that differs between a and b, prefixed
+// with "+" for fields only in b, "-" for fields only in a, and "~" for fields whose
+// values differ. Lists of different lengths are reported as a single changed field.
+func fieldDiffs(path string, a, b interface{}) []string {
+	aMap, aIsMap := a.(map[string]interface{})
+	bMap, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		keys := sets.NewString()
+		for k := range aMap {
+			keys.Insert(k)
+		}
+		for k := range bMap {
+			keys.Insert(k)
+		}
+
+		var res []string
+		for _, k := range keys.List() {
+			childPath := fieldPath(path, k)
+			aVal, inA := aMap[k]
+			bVal, inB := bMap[k]
+			switch {
+			case !inA:
+				res = append(res, fmt.Sprintf("+ %s: %s", childPath, formatValue(bVal)))
+			case !inB:
+				res = append(res, fmt.Sprintf("- %s: %s", childPath, formatValue(aVal)))
+			default:
+				res = append(res, fieldDiffs(childPath, aVal, bVal)...)
+			}
+		}
+		return res
+	}
+
+	aSlice, aIsSlice := a.([]interface{})
+	bSlice, bIsSlice := b.([]interface{})
+	if aIsSlice && bIsSlice && len(aSlice) == len(bSlice) {
+		var res []string
+		for i := range aSlice {
+			res = append(res, fieldDiffs(fmt.Sprintf("%s[%d]", path, i), aSlice[i], bSlice[i])...)
+		}
+		return res
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return []string{fmt.Sprintf("~ %s: %s -> %s", path, formatValue(a), formatValue(b))}
+}
+
+// fieldPath appends key to path, quoting keys that contain dots or slashes,
+// such as label and annotation keys.
+func fieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func formatValue(val interface{}) string {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Sprintf("%v", val)
+	}
+	return string(data)
+}
+
+func lessKey(a, b itemKey) bool {
+	if a.resource != b.resource {
+		return a.resource < b.resource
+	}
+	if a.namespace != b.namespace {
+		return a.namespace < b.namespace
+	}
+	return a.name < b.name
+}
+
+func sortKeys(keys []itemKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		return lessKey(keys[i], keys[j])
+	})
+}
+
+// printItemsDiff prints d in human-readable format.
+func printItemsDiff(w io.Writer, d itemsDiff) {
+	if len(d.added) == 0 && len(d.removed) == 0 && len(d.changed) == 0 {
+		fmt.Fprintln(w, "No differences found.")
+		return
+	}
+
+	if len(d.added) > 0 {
+		fmt.Fprintf(w, "Added (%d):\n", len(d.added))
+		for _, key := range d.added {
+			fmt.Fprintf(w, "  %s\n", key)
+		}
+	}
+
+	if len(d.removed) > 0 {
+		fmt.Fprintf(w, "Removed (%d):\n", len(d.removed))
+		for _, key := range d.removed {
+			fmt.Fprintf(w, "  %s\n", key)
+		}
+	}
+
+	if len(d.changed) > 0 {
+		fmt.Fprintf(w, "Changed (%d):\n", len(d.changed))
+		for _, item := range d.changed {
+			fmt.Fprintf(w, "  %s\n", item.key)
+			for _, field := range item.fields {
+				fmt.Fprintf(w, "    %s\n", field)
+			}
+		}
+	}
+}
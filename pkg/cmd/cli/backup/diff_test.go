@@ -0,0 +1,193 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func unstructuredOrDie(t *testing.T, data string) *unstructured.Unstructured {
+	t.Helper()
+
+	obj := new(unstructured.Unstructured)
+	require.NoError(t, obj.UnmarshalJSON([]byte(data)))
+	return obj
+}
+
+func TestDiffItems(t *testing.T) {
+	base := map[itemKey]*unstructured.Unstructured{
+		{resource: "configmaps", namespace: "ns-1", name: "unchanged"}: unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"unchanged","uid":"1"},"data":{"a":"b"}}`),
+		{resource: "configmaps", namespace: "ns-1", name: "changed"}:   unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"changed","labels":{"app.kubernetes.io/name":"web"}},"data":{"a":"b","c":"d"}}`),
+		{resource: "configmaps", namespace: "ns-1", name: "removed"}:   unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"removed"}}`),
+		{resource: "persistentvolumes", name: "pv-1"}:                  unstructuredOrDie(t, `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"},"spec":{"accessModes":["ReadWriteOnce"]},"status":{"phase":"Bound"}}`),
+	}
+	target := map[itemKey]*unstructured.Unstructured{
+		// only fields that restores ignore are different
+		{resource: "configmaps", namespace: "ns-1", name: "unchanged"}: unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"unchanged","uid":"2","resourceVersion":"5"},"data":{"a":"b"}}`),
+		{resource: "configmaps", namespace: "ns-1", name: "changed"}:   unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"changed","labels":{"app.kubernetes.io/name":"api"}},"data":{"a":"x","e":"f"}}`),
+		{resource: "configmaps", namespace: "ns-2", name: "added"}:     unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-2","name":"added"}}`),
+		{resource: "persistentvolumes", name: "pv-1"}:                  unstructuredOrDie(t, `{"apiVersion":"v1","kind":"PersistentVolume","metadata":{"name":"pv-1"},"spec":{"accessModes":["ReadWriteMany"]},"status":{"phase":"Released"}}`),
+	}
+
+	var buf bytes.Buffer
+	printItemsDiff(&buf, diffItems(base, target))
+
+	want := `Added (1):
+  configmaps ns-2/added
+Removed (1):
+  configmaps ns-1/removed
+Changed (2):
+  configmaps ns-1/changed
+    ~ data.a: "b" -> "x"
+    - data.c: "d"
+    + data.e: "f"
+    ~ metadata.labels["app.kubernetes.io/name"]: "web" -> "api"
+  persistentvolumes pv-1
+    ~ spec.accessModes[0]: "ReadWriteOnce" -> "ReadWriteMany"
+`
+	assert.Equal(t, want, buf.String())
+}
+
+func TestDiffItemsNoDifferences(t *testing.T) {
+	items := func() map[itemKey]*unstructured.Unstructured {
+		return map[itemKey]*unstructured.Unstructured{
+			{resource: "configmaps", namespace: "ns-1", name: "cm-1"}: unstructuredOrDie(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"namespace":"ns-1","name":"cm-1"}}`),
+		}
+	}
+
+	var buf bytes.Buffer
+	printItemsDiff(&buf, diffItems(items(), items()))
+
+	assert.Equal(t, "No differences found.\n", buf.String())
+}
+
+func TestLoadLiveItems(t *testing.T) {
+	tests := []struct {
+		name   string
+		backup *velerov1api.Backup
+		base   map[itemKey]*unstructured.Unstructured
+		want   []itemKey
+	}{
+		{
+			name:   "items in the backup's namespaces and the resources and namespaces of the backed-up items are listed",
+			backup: builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1").Result(),
+			base: map[itemKey]*unstructured.Unstructured{
+				{resource: "pods", namespace: "ns-1", name: "pod-1"}:                        nil,
+				{resource: "persistentvolumes", name: "pv-2"}:                               nil,
+				{resource: "deployments.apps", namespace: "ns-1", name: "no-longer-exists"}: nil,
+			},
+			want: []itemKey{
+				{resource: "pods", namespace: "ns-1", name: "pod-1"},
+				{resource: "pods", namespace: "ns-1", name: "pod-2"},
+				{resource: "configmaps", namespace: "ns-1", name: "cm-1"},
+				{resource: "persistentvolumes", name: "pv-2"},
+			},
+		},
+		{
+			name: "the backup's label selector and excluded resources are applied",
+			backup: builder.ForBackup("velero", "backup-1").
+				LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}).
+				ExcludedResources("configmaps").
+				Result(),
+			want: []itemKey{
+				{resource: "pods", namespace: "ns-1", name: "pod-1"},
+				{resource: "pods", namespace: "ns-2", name: "pod-3"},
+			},
+		},
+		{
+			name:   "cluster-scoped resources are not listed when the backup excludes them",
+			backup: builder.ForBackup("velero", "backup-1").IncludeClusterResources(false).Result(),
+			want: []itemKey{
+				{resource: "pods", namespace: "ns-1", name: "pod-1"},
+				{resource: "pods", namespace: "ns-1", name: "pod-2"},
+				{resource: "pods", namespace: "ns-2", name: "pod-3"},
+				{resource: "configmaps", namespace: "ns-1", name: "cm-1"},
+			},
+		},
+		{
+			name:   "only the backed-up items of cluster-scoped resources the backup excludes are listed",
+			backup: builder.ForBackup("velero", "backup-1").IncludeClusterResources(false).Result(),
+			base: map[itemKey]*unstructured.Unstructured{
+				{resource: "persistentvolumes", name: "pv-1"}: nil,
+			},
+			want: []itemKey{
+				{resource: "pods", namespace: "ns-1", name: "pod-1"},
+				{resource: "pods", namespace: "ns-1", name: "pod-2"},
+				{resource: "pods", namespace: "ns-2", name: "pod-3"},
+				{resource: "configmaps", namespace: "ns-1", name: "cm-1"},
+				{resource: "persistentvolumes", name: "pv-1"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiServer := test.NewAPIServer(t)
+			apiServer.DiscoveryClient.WithAPIResource(test.Pods()).WithAPIResource(test.PVs()).WithAPIResource(test.ConfigMaps())
+
+			for _, item := range []*unstructured.Unstructured{
+				toUnstructuredOrDie(t, builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "web")).Result()),
+				toUnstructuredOrDie(t, builder.ForPod("ns-1", "pod-2").Result()),
+				toUnstructuredOrDie(t, builder.ForPod("ns-2", "pod-3").ObjectMeta(builder.WithLabels("app", "web")).Result()),
+			} {
+				_, err := apiServer.DynamicClient.Resource(test.Pods().GVR()).Namespace(item.GetNamespace()).Create(item, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+			cm := toUnstructuredOrDie(t, builder.ForConfigMap("ns-1", "cm-1").Result())
+			_, err := apiServer.DynamicClient.Resource(test.ConfigMaps().GVR()).Namespace("ns-1").Create(cm, metav1.CreateOptions{})
+			require.NoError(t, err)
+			for _, name := range []string{"pv-1", "pv-2"} {
+				pv := toUnstructuredOrDie(t, builder.ForPersistentVolume(name).Result())
+				_, err = apiServer.DynamicClient.Resource(test.PVs().GVR()).Create(pv, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, test.NewLogger())
+			require.NoError(t, err)
+
+			items, err := loadLiveItems(discoveryHelper, client.NewDynamicFactory(apiServer.DynamicClient), test.NewLogger(), tc.backup, tc.base)
+			require.NoError(t, err)
+
+			var keys []itemKey
+			for key := range items {
+				keys = append(keys, key)
+			}
+			assert.ElementsMatch(t, tc.want, keys)
+		})
+	}
+}
+
+func toUnstructuredOrDie(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: res}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -58,6 +59,7 @@ type DownloadOptions struct {
 	Timeout               time.Duration
 	InsecureSkipTLSVerify bool
 	writeOptions          int
+	backup                *v1.Backup
 }
 
 func NewDownloadOptions() *DownloadOptions {
@@ -67,7 +69,7 @@ func NewDownloadOptions() *DownloadOptions {
 }
 
 func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
-	flags.StringVarP(&o.Output, "output", "o", o.Output, "path to output file. Defaults to <NAME>-data.tar.gz in the current directory")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "path to output file. Defaults to <NAME>-data.tar.gz in the current directory, or <NAME>-data.tar if the backup is not compressed")
 	flags.BoolVar(&o.Force, "force", o.Force, "forces the download and will overwrite file if it exists already")
 	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "maximum time to wait to process download request")
 	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
@@ -77,9 +79,20 @@ func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Fac
 	veleroClient, err := f.Client()
 	cmd.CheckError(err)
 
-	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(o.Name, metav1.GetOptions{}); err != nil {
+	backup, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
 		return err
 	}
+	o.backup = backup
+
+	if !c.Flags().Changed("output") {
+		switch backup.Status.Compression {
+		case v1.BackupCompressionNone:
+			o.Output = strings.TrimSuffix(o.Output, ".gz")
+		case v1.BackupCompressionZstd:
+			o.Output = strings.TrimSuffix(o.Output, ".gz") + ".zst"
+		}
+	}
 
 	return nil
 }
@@ -107,13 +120,19 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	veleroClient, err := f.Client()
 	cmd.CheckError(err)
 
+	kubeClient, err := f.KubeClient()
+	cmd.CheckError(err)
+
+	encryptionKey, err := downloadrequest.BackupEncryptionKey(kubeClient.CoreV1(), o.backup)
+	cmd.CheckError(err)
+
 	backupDest, err := os.OpenFile(o.Output, o.writeOptions, 0600)
 	if err != nil {
 		return err
 	}
 	defer backupDest.Close()
 
-	err = downloadrequest.Stream(veleroClient.VeleroV1(), f.Namespace(), o.Name, v1.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify)
+	err = downloadrequest.Stream(veleroClient.VeleroV1(), f.Namespace(), o.Name, v1.DownloadTargetKindBackupContents, encryptionKey, backupDest, o.Timeout, o.InsecureSkipTLSVerify)
 	if err != nil {
 		os.Remove(o.Output)
 		cmd.CheckError(err)
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+)
+
+// NewGetItemCommand creates and returns a new cobra command for printing
+// a single item from a backup.
+func NewGetItemCommand(f client.Factory) *cobra.Command {
+	o := NewGetItemOptions()
+
+	c := &cobra.Command{
+		Use:   "get-item NAME --resource RESOURCE --name ITEM_NAME",
+		Short: "Print a single item from a backup",
+		Long: `Print a single item from a backup, as it was when the backup was taken. The backup's
+tarball is streamed from object storage until the item is found, without being saved to
+disk. Use 'velero backup tree' to list the items in a backup.`,
+		Example: `	# print the "app-config" ConfigMap in namespace "web" from backup "backup-1"
+	velero backup get-item backup-1 --resource configmaps --item-namespace web --name app-config
+
+	# print the "app" Deployment in namespace "web" from backup "backup-1" as YAML
+	velero backup get-item backup-1 --resource deployments.apps --item-namespace web --name app -o yaml
+
+	# print the cluster-scoped "fast" StorageClass from backup "backup-1"
+	velero backup get-item backup-1 --resource storageclasses.storage.k8s.io --name fast`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type GetItemOptions struct {
+	BackupName            string
+	Resource              string
+	ItemNamespace         string
+	ItemName              string
+	Output                string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+}
+
+func NewGetItemOptions() *GetItemOptions {
+	return &GetItemOptions{
+		Output:  "json",
+		Timeout: time.Minute,
+	}
+}
+
+func (o *GetItemOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Resource, "resource", o.Resource, "the item's resource, formatted as resource.group as in the backup, such as deployments.apps or configmaps. Required.")
+	flags.StringVar(&o.ItemNamespace, "item-namespace", o.ItemNamespace, "the item's namespace. Leave empty for cluster-scoped items.")
+	flags.StringVar(&o.ItemName, "name", o.ItemName, "the item's name. Required.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "output format. Valid values are 'json' and 'yaml'.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "maximum time to wait to process download request")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+}
+
+func (o *GetItemOptions) Complete(args []string) error {
+	o.BackupName = args[0]
+	return nil
+}
+
+func (o *GetItemOptions) Validate() error {
+	if o.Resource == "" {
+		return errors.New("--resource is required")
+	}
+	if o.ItemName == "" {
+		return errors.New("--name is required")
+	}
+	if o.Output != "json" && o.Output != "yaml" {
+		return errors.Errorf("invalid output format %q - valid values are 'json' and 'yaml'", o.Output)
+	}
+	return nil
+}
+
+func (o *GetItemOptions) Run(f client.Factory) error {
+	contents, backup, err := streamBackupContents(f, o.BackupName, o.Timeout, o.InsecureSkipTLSVerify)
+	if err != nil {
+		return err
+	}
+	// stop the download once the item has been found.
+	defer contents.Close()
+
+	item, err := readBackupItem(contents, backup.Status.Compression, o.Resource, o.ItemNamespace, o.ItemName)
+	if err != nil {
+		return err
+	}
+
+	return encode.EncodeTo(item, o.Output, os.Stdout)
+}
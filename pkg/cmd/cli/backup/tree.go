@@ -0,0 +1,99 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// NewTreeCommand creates and returns a new cobra command for listing
+// the items in a backup as a tree.
+func NewTreeCommand(f client.Factory) *cobra.Command {
+	o := NewTreeOptions()
+
+	c := &cobra.Command{
+		Use:   "tree NAME",
+		Short: "List the items in a backup as a tree",
+		Long: `List the items in a backup as a tree of resource, namespace, and item name. The backup's
+tarball is streamed from object storage and extracted to a temp directory, which is
+removed once the tree has been printed. Use 'velero backup get-item' to print one of the items.`,
+		Example: `	# list the items in backup "backup-1"
+	velero backup tree backup-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type TreeOptions struct {
+	BackupName            string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+}
+
+func NewTreeOptions() *TreeOptions {
+	return &TreeOptions{
+		Timeout: time.Minute,
+	}
+}
+
+func (o *TreeOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "maximum time to wait to process download request")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+}
+
+func (o *TreeOptions) Complete(args []string) error {
+	o.BackupName = args[0]
+	return nil
+}
+
+func (o *TreeOptions) Run(f client.Factory) error {
+	log := logrus.New()
+	log.Level = logrus.WarnLevel
+	fs := filesystem.NewFileSystem()
+
+	dir, err := downloadAndExtractBackup(f, fs, log, o.BackupName, o.Timeout, o.InsecureSkipTLSVerify)
+	if err != nil {
+		return err
+	}
+	defer fs.RemoveAll(dir)
+
+	resources, err := archive.NewParser(log, fs).Parse(dir)
+	if err != nil {
+		return errors.Wrap(err, "error parsing backup contents")
+	}
+
+	printResourceTree(os.Stdout, resources)
+	return nil
+}
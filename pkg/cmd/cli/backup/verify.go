@@ -0,0 +1,217 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	v1 "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+)
+
+// NewVerifyCommand creates and returns a new cobra command for verifying a backup's
+// data in object storage.
+func NewVerifyCommand(f client.Factory) *cobra.Command {
+	o := NewVerifyOptions()
+
+	c := &cobra.Command{
+		Use:   "verify NAME",
+		Short: "Verify a backup's data in object storage",
+		Long: `Request that the Velero server verify a backup's data in object storage. The server downloads
+every file of the backup and checks it against the checksum recorded when the backup was
+created, parses the backup's tarball, and checks that each of the backup's restic snapshots
+still exists in its repository. The result is recorded in the backup's status.`,
+		Example: `	# verify a backup named "backup-1"
+	velero backup verify backup-1
+
+	# verify a backup named "backup-1" and wait for the result
+	velero backup verify backup-1 --wait`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Run(f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type VerifyOptions struct {
+	Name string
+	Wait bool
+
+	client veleroclient.Interface
+}
+
+func NewVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{}
+}
+
+func (o *VerifyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "wait for the verification to complete")
+}
+
+func (o *VerifyOptions) Complete(f client.Factory, args []string) error {
+	o.Name = args[0]
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.client = client
+
+	return nil
+}
+
+func (o *VerifyOptions) Run(f client.Factory) error {
+	backup, err := o.client.VeleroV1().Backups(f.Namespace()).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+		return errors.Errorf("backup %q is %s, only Completed or PartiallyFailed backups can be verified", backup.Name, backup.Status.Phase)
+	}
+
+	var updates chan *velerov1api.Backup
+	if o.Wait {
+		stop := make(chan struct{})
+		defer close(stop)
+
+		updates = make(chan *velerov1api.Backup)
+
+		backupInformer := v1.NewBackupInformer(o.client, f.Namespace(), 0, nil)
+
+		backupInformer.AddEventHandler(
+			cache.FilteringResourceEventHandler{
+				FilterFunc: func(obj interface{}) bool {
+					backup, ok := obj.(*velerov1api.Backup)
+					if !ok {
+						return false
+					}
+					return backup.Name == o.Name
+				},
+				Handler: cache.ResourceEventHandlerFuncs{
+					UpdateFunc: func(_, obj interface{}) {
+						backup, ok := obj.(*velerov1api.Backup)
+						if !ok {
+							return
+						}
+						updates <- backup
+					},
+					DeleteFunc: func(obj interface{}) {
+						backup, ok := obj.(*velerov1api.Backup)
+						if !ok {
+							return
+						}
+						updates <- backup
+					},
+				},
+			},
+		)
+		go backupInformer.Run(stop)
+	}
+
+	if err := patchVerifyRequested(o.client, backup); err != nil {
+		return err
+	}
+
+	fmt.Printf("Verification of backup %q requested successfully.\n", backup.Name)
+	if o.Wait {
+		fmt.Println("Waiting for verification to complete. You may safely press ctrl-c to stop waiting - your verification will continue in the background.")
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				fmt.Print(".")
+			case backup, ok := <-updates:
+				if !ok {
+					fmt.Println("\nError waiting: unable to watch backups.")
+					return nil
+				}
+
+				if _, requested := backup.Annotations[velerov1api.VerifyRequestedAnnotation]; requested || backup.Status.Verification == nil {
+					continue
+				}
+
+				verification := backup.Status.Verification
+				if verification.Phase != velerov1api.BackupVerificationPhasePassed && verification.Phase != velerov1api.BackupVerificationPhaseFailed {
+					continue
+				}
+
+				fmt.Printf("\nVerification completed with status: %s.\n", verification.Phase)
+				for _, err := range verification.Errors {
+					fmt.Printf("\t%s\n", err)
+				}
+				return nil
+			}
+		}
+	}
+
+	// Not waiting
+
+	fmt.Printf("Run `velero backup describe %s` for the result.\n", backup.Name)
+
+	return nil
+}
+
+// patchVerifyRequested adds the verify-requested annotation, set to the current time,
+// to the backup.
+func patchVerifyRequested(client veleroclient.Interface, backup *velerov1api.Backup) error {
+	original, err := json.Marshal(backup)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	updated := backup.DeepCopy()
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+	updated.Annotations[velerov1api.VerifyRequestedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+	updatedBytes, err := json.Marshal(updated)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	patchBytes, err := jsonpatch.CreateMergePatch(original, updatedBytes)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := client.VeleroV1().Backups(backup.Namespace).Patch(backup.Name, types.MergePatchType, patchBytes); err != nil {
+		return errors.Wrapf(err, "error patching backup %q", backup.Name)
+	}
+	return nil
+}
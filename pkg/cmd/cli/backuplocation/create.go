@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
@@ -63,11 +64,14 @@ type CreateOptions struct {
 	Config           flag.Map
 	Labels           flag.Map
 	AccessMode       *flag.Enum
+	DefaultBackup    bool
+	Credential       flag.Map
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Config: flag.NewMap(),
+		Config:     flag.NewMap(),
+		Credential: flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
@@ -83,6 +87,8 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "how often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync")
 	flags.Var(&o.Config, "config", "configuration key-value pairs")
 	flags.Var(&o.Labels, "labels", "labels to apply to the backup storage location")
+	flags.Var(&o.Credential, "credential", "the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+	flags.BoolVar(&o.DefaultBackup, "default", o.DefaultBackup, "sets this new location to be the new default backup location. Any other location currently marked as the default is unset. Optional.")
 	flags.Var(
 		o.AccessMode,
 		"access-mode",
@@ -107,6 +113,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--backup-sync-period must be non-negative")
 	}
 
+	if len(o.Credential.Data()) > 1 {
+		return errors.New("--credential can only contain 1 key/value pair")
+	}
+
 	return nil
 }
 
@@ -139,9 +149,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			Config:           o.Config.Data(),
 			AccessMode:       velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
 			BackupSyncPeriod: backupSyncPeriod,
+			Default:          o.DefaultBackup,
 		},
 	}
 
+	for secretName, secretKey := range o.Credential.Data() {
+		backupStorageLocation.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
+	}
+
 	if printed, err := output.PrintWithFormat(c, backupStorageLocation); printed || err != nil {
 		return err
 	}
@@ -155,6 +170,14 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return errors.WithStack(err)
 	}
 
+	// only unset the other defaults once this location exists, so that a failed
+	// create doesn't leave no location marked as the default.
+	if o.DefaultBackup {
+		if err := unsetDefaultLocations(client, backupStorageLocation.Namespace, backupStorageLocation.Name); err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("Backup storage location %q configured successfully.\n", backupStorageLocation.Name)
 	return nil
 }
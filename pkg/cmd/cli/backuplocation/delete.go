@@ -0,0 +1,162 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+// NewDeleteCommand creates and returns a new cobra command for deleting backup storage locations.
+func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewDeleteOptions("backup-location")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Delete backup storage locations",
+		Long: `Delete backup storage locations.
+
+Backup API objects and restic repositories that reference a deleted location are also deleted from
+the cluster. The contents of the location in object storage are not deleted.`,
+		Example: `	# delete a backup storage location named "backup-location-1"
+	velero backup-location delete backup-location-1
+
+	# delete a backup storage location named "backup-location-1" without prompting for confirmation
+	velero backup-location delete backup-location-1 --confirm
+
+	# delete backup storage locations named "backup-location-1" and "backup-location-2"
+	velero backup-location delete backup-location-1 backup-location-2
+
+	# delete all backup storage locations labelled with foo=bar
+	velero backup-location delete --selector foo=bar
+
+	# delete all backup storage locations
+	velero backup-location delete --all`,
+
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate(c, f, args))
+			cmd.CheckError(Run(o))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+// Run performs the deletion of backup storage locations.
+func Run(o *cli.DeleteOptions) error {
+	if !o.Confirm && !cli.GetConfirmation() {
+		return nil
+	}
+
+	var (
+		locations []*velerov1api.BackupStorageLocation
+		errs      []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			location, err := o.Client.VeleroV1().BackupStorageLocations(o.Namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			locations = append(locations, location)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := o.Client.VeleroV1().BackupStorageLocations(o.Namespace).List(metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for i := range res.Items {
+			locations = append(locations, &res.Items[i])
+		}
+	}
+	if len(locations) == 0 {
+		fmt.Println("No backup storage locations found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	for _, location := range locations {
+		if err := o.Client.VeleroV1().BackupStorageLocations(location.Namespace).Delete(location.Name, nil); err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+		fmt.Printf("Backup storage location %q deleted successfully.\n", location.Name)
+
+		errs = append(errs, deleteLocationReferences(o, location)...)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
+
+// deleteLocationReferences deletes the backup and restic repository API objects
+// that reference the specified location.
+func deleteLocationReferences(o *cli.DeleteOptions, location *velerov1api.BackupStorageLocation) []error {
+	var errs []error
+
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", velerov1api.StorageLocationLabel, label.GetValidName(location.Name)),
+	}
+
+	backups, err := o.Client.VeleroV1().Backups(location.Namespace).List(listOptions)
+	if err != nil {
+		errs = append(errs, errors.Wrapf(err, "error listing backups for backup storage location %q", location.Name))
+	} else {
+		for _, backup := range backups.Items {
+			if err := o.Client.VeleroV1().Backups(backup.Namespace).Delete(backup.Name, nil); err != nil {
+				errs = append(errs, errors.Wrapf(err, "error deleting backup %q", backup.Name))
+				continue
+			}
+			fmt.Printf("Backup %q associated with backup storage location %q deleted successfully.\n", backup.Name, location.Name)
+		}
+	}
+
+	repos, err := o.Client.VeleroV1().ResticRepositories(location.Namespace).List(listOptions)
+	if err != nil {
+		errs = append(errs, errors.Wrapf(err, "error listing restic repositories for backup storage location %q", location.Name))
+	} else {
+		for _, repo := range repos.Items {
+			if err := o.Client.VeleroV1().ResticRepositories(repo.Namespace).Delete(repo.Name, nil); err != nil {
+				errs = append(errs, errors.Wrapf(err, "error deleting restic repository %q", repo.Name))
+				continue
+			}
+			fmt.Printf("Restic repository %q associated with backup storage location %q deleted successfully.\n", repo.Name, location.Name)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backuplocation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+func NewSetCommand(f client.Factory, use string) *cobra.Command {
+	o := NewSetOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Set specific features for a backup storage location",
+		Example: `	# make the backup storage location named "backup-location-1" the default location
+	velero backup-location set backup-location-1 --default
+
+	# update the region configured for the backup storage location named "backup-location-1"
+	velero backup-location set backup-location-1 --config region=us-west-2
+
+	# use the "cloud" key of the secret named "bsl-credentials" as the credential for the backup storage location named "backup-location-1"
+	velero backup-location set backup-location-1 --credential bsl-credentials=cloud`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+type SetOptions struct {
+	Name          string
+	DefaultBackup bool
+	Config        flag.Map
+	Credential    flag.Map
+}
+
+func NewSetOptions() *SetOptions {
+	return &SetOptions{
+		Config:     flag.NewMap(),
+		Credential: flag.NewMap(),
+	}
+}
+
+func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.DefaultBackup, "default", o.DefaultBackup, "sets this new location to be the new default backup location. Any other location currently marked as the default is unset.")
+	flags.Var(&o.Config, "config", "configuration key-value pairs to add to or update in the location's existing configuration")
+	flags.Var(&o.Credential, "credential", "the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
+}
+
+func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if !c.Flags().Changed("default") && !c.Flags().Changed("config") && !c.Flags().Changed("credential") {
+		return errors.New("at least one of --default, --config or --credential must be specified")
+	}
+
+	if len(o.Credential.Data()) > 1 {
+		return errors.New("--credential can only contain 1 key/value pair")
+	}
+
+	return nil
+}
+
+func (o *SetOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	location, err := client.VeleroV1().BackupStorageLocations(f.Namespace()).Get(o.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if c.Flags().Changed("default") {
+		location.Spec.Default = o.DefaultBackup
+	}
+
+	if config := o.Config.Data(); len(config) > 0 {
+		if location.Spec.Config == nil {
+			location.Spec.Config = make(map[string]string)
+		}
+		for k, v := range config {
+			location.Spec.Config[k] = v
+		}
+	}
+
+	for secretName, secretKey := range o.Credential.Data() {
+		location.Spec.Credential = builder.ForSecretKeySelector(secretName, secretKey).Result()
+	}
+
+	if _, err := client.VeleroV1().BackupStorageLocations(location.Namespace).Update(location); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// only unset the other defaults once this location has been updated, so that
+	// a failed update doesn't leave no location marked as the default.
+	if c.Flags().Changed("default") && o.DefaultBackup {
+		if err := unsetDefaultLocations(client, location.Namespace, location.Name); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Backup storage location %q configured successfully.\n", location.Name)
+	return nil
+}
+
+// unsetDefaultLocations unsets the default flag on all backup storage locations
+// other than the named one, since there can only be one default location.
+func unsetDefaultLocations(client clientset.Interface, namespace, name string) error {
+	locations, err := client.VeleroV1().BackupStorageLocations(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := range locations.Items {
+		location := &locations.Items[i]
+		if location.Name == name || !location.Spec.Default {
+			continue
+		}
+
+		location.Spec.Default = false
+		if _, err := client.VeleroV1().BackupStorageLocations(location.Namespace).Update(location); err != nil {
+			return errors.Wrapf(err, "error unsetting backup storage location %q as the default", location.Name)
+		}
+		fmt.Printf("Backup storage location %q is no longer the default.\n", location.Name)
+	}
+
+	return nil
+}
@@ -21,8 +21,10 @@ import (
 
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backuplocation"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/restore"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/schedule"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/snapshotlocation"
 )
 
 func NewCommand(f client.Factory) *cobra.Command {
@@ -41,10 +43,18 @@ func NewCommand(f client.Factory) *cobra.Command {
 	scheduleCommand := schedule.NewDeleteCommand(f, "schedule")
 	scheduleCommand.Aliases = []string{"schedules"}
 
+	backupLocationCommand := backuplocation.NewDeleteCommand(f, "backup-location")
+	backupLocationCommand.Aliases = []string{"backup-locations"}
+
+	snapshotLocationCommand := snapshotlocation.NewDeleteCommand(f, "snapshot-location")
+	snapshotLocationCommand.Aliases = []string{"snapshot-locations"}
+
 	c.AddCommand(
 		backupCommand,
 		restoreCommand,
 		scheduleCommand,
+		backupLocationCommand,
+		snapshotLocationCommand,
 	)
 
 	return c
@@ -68,6 +68,7 @@ type InstallOptions struct {
 	Plugins                           flag.StringArray
 	NoDefaultBackupLocation           bool
 	CRDsOnly                          bool
+	DefaultVolumesToRestic            bool
 }
 
 // BindFlags adds command line values to the options struct.
@@ -98,6 +99,7 @@ func (o *InstallOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&o.Wait, "wait", o.Wait, "wait for Velero deployment to be ready. Optional.")
 	flags.DurationVar(&o.DefaultResticMaintenanceFrequency, "default-restic-prune-frequency", o.DefaultResticMaintenanceFrequency, "how often 'restic prune' is run for restic repositories by default. Optional.")
 	flags.Var(&o.Plugins, "plugins", "Plugin container images to install into the Velero Deployment")
+	flags.BoolVar(&o.DefaultVolumesToRestic, "default-volumes-to-restic", o.DefaultVolumesToRestic, "bool flag to configure Velero server to use restic by default to backup all pod volumes on all backups. Optional.")
 	flags.BoolVar(&o.CRDsOnly, "crds-only", o.CRDsOnly, "only generate CustomResourceDefinition resources. Useful for updating CRDs for an existing Velero install.")
 }
 
@@ -166,6 +168,7 @@ func (o *InstallOptions) AsVeleroOptions() (*install.VeleroOptions, error) {
 		DefaultResticMaintenanceFrequency: o.DefaultResticMaintenanceFrequency,
 		Plugins:                           o.Plugins,
 		NoDefaultBackupLocation:           o.NoDefaultBackupLocation,
+		DefaultVolumesToRestic:            o.DefaultVolumesToRestic,
 	}, nil
 }
 
@@ -364,6 +367,10 @@ func (o *InstallOptions) Validate(c *cobra.Command, args []string, f client.Fact
 		return errors.New("Cannot use both --secret-file and --no-secret")
 	}
 
+	if o.DefaultVolumesToRestic && !o.UseRestic {
+		return errors.New("--use-restic is required when using --default-volumes-to-restic")
+	}
+
 	if o.DefaultResticMaintenanceFrequency < 0 {
 		return errors.New("--default-restic-prune-frequency must be non-negative")
 	}
@@ -38,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/signals"
 	"github.com/vmware-tanzu/velero/pkg/controller"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/restic"
@@ -86,6 +87,7 @@ type resticServer struct {
 	ctx                   context.Context
 	cancelFunc            context.CancelFunc
 	fileSystem            filesystem.Interface
+	credentialFileStore   credentials.FileStore
 }
 
 func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*resticServer, error) {
@@ -128,6 +130,16 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*restic
 		},
 	)
 
+	credentialFileStore, err := credentials.NewNamespacedFileStore(
+		kubeClient.CoreV1(),
+		factory.Namespace(),
+		credentials.DefaultStoreDirectory,
+		filesystem.NewFileSystem(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	s := &resticServer{
@@ -141,6 +153,7 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory) (*restic
 		ctx:                   ctx,
 		cancelFunc:            cancelFunc,
 		fileSystem:            filesystem.NewFileSystem(),
+		credentialFileStore:   credentialFileStore,
 	}
 
 	if err := s.validatePodVolumesHostPath(); err != nil {
@@ -167,6 +180,7 @@ func (s *resticServer) run() {
 		s.kubeInformerFactory.Core().V1().PersistentVolumes(),
 		s.veleroInformerFactory.Velero().V1().BackupStorageLocations(),
 		os.Getenv("NODE_NAME"),
+		s.credentialFileStore,
 	)
 	wg.Add(1)
 	go func() {
@@ -184,6 +198,7 @@ func (s *resticServer) run() {
 		s.kubeInformerFactory.Core().V1().PersistentVolumes(),
 		s.veleroInformerFactory.Velero().V1().BackupStorageLocations(),
 		os.Getenv("NODE_NAME"),
+		s.credentialFileStore,
 	)
 	wg.Add(1)
 	go func() {
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+// NewCancelCommand creates and returns a new cobra command for canceling a restore
+// that hasn't finished.
+func NewCancelCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "cancel NAME",
+		Short: "Cancel a restore that's in progress",
+		Long: `Request that the Velero server stop a restore that's New or InProgress. Items that
+haven't been restored yet are skipped, pending restic pod volume restores are stopped, and
+the restore ends in the Canceled phase. The restore's log is still uploaded to object storage.`,
+		Example: `	# cancel a restore named "restore-1"
+	velero restore cancel restore-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(Cancel(f, args[0]))
+		},
+	}
+
+	return c
+}
+
+// Cancel sets the cancel field on the spec of the restore identified by name.
+func Cancel(f client.Factory, name string) error {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	restore, err := veleroClient.VeleroV1().Restores(f.Namespace()).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch restore.Status.Phase {
+	case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress:
+		// hasn't finished, so it can be canceled
+	default:
+		return errors.Errorf("restore %q is %s, only New or InProgress restores can be canceled", restore.Name, restore.Status.Phase)
+	}
+
+	patch := []byte(`{"spec":{"cancel":true}}`)
+	if _, err := veleroClient.VeleroV1().Restores(restore.Namespace).Patch(restore.Name, types.MergePatchType, patch); err != nil {
+		return errors.Wrapf(err, "error patching restore %q", restore.Name)
+	}
+
+	fmt.Printf("Cancellation of restore %q requested successfully.\n", restore.Name)
+	fmt.Printf("Run `velero restore describe %s` to check that it was canceled.\n", restore.Name)
+	return nil
+}
@@ -19,11 +19,13 @@ package restore
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -57,6 +59,9 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 
   # create a restore for only persistentvolumeclaims and persistentvolumes within a backup
   velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # see what a restore from backup "backup-1" would do, without changing anything in the cluster
+  velero restore create --from-backup backup-1 --dry-run --wait
   `,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
@@ -88,6 +93,12 @@ type CreateOptions struct {
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	AllowPartiallyFailed    flag.OptionalBool
+	ExistingResourcePolicy  *flag.Enum
+	ResourceModifier        string
+	DryRun                  bool
+	ItemWorkers             int
+	WaitForReady            bool
+	ReadyTimeout            time.Duration
 
 	client veleroclient.Interface
 }
@@ -99,6 +110,11 @@ func NewCreateOptions() *CreateOptions {
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(",").WithKeyValueDelimiter(":"),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
+		ExistingResourcePolicy: flag.NewEnum(
+			"",
+			string(api.PolicyTypeNone),
+			string(api.PolicyTypeUpdate),
+		),
 	}
 }
 
@@ -123,6 +139,21 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "if using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = "true"
 
+	flags.Var(
+		o.ExistingResourcePolicy,
+		"existing-resource-policy",
+		fmt.Sprintf("restore behavior for resources that already exist in the cluster. Valid values are %s", strings.Join(o.ExistingResourcePolicy.AllowedValues(), ",")),
+	)
+
+	flags.StringVar(&o.ResourceModifier, "resource-modifier-configmap", "", "name of a ConfigMap in the Velero server's namespace containing rules for modifying restored resources")
+
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "only report what the restore would do, without creating or modifying anything in the cluster. Use 'velero restore describe --details' to see the plan.")
+
+	flags.IntVar(&o.ItemWorkers, "item-workers", o.ItemWorkers, "how many items to restore concurrently. If unset, the server's default is used.")
+
+	flags.BoolVar(&o.WaitForReady, "wait-for-ready", o.WaitForReady, "once all items are restored, wait for the restored deployments, statefulsets, daemonsets, persistent volume claims and pods to become ready. Items that aren't ready are reported as warnings.")
+	flags.DurationVar(&o.ReadyTimeout, "ready-timeout", o.ReadyTimeout, "how long to wait for restored items to become ready when using --wait-for-ready. If unset, defaults to 10m.")
+
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "wait for the operation to complete")
 }
 
@@ -160,6 +191,18 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return err
 	}
 
+	if o.ItemWorkers < 0 {
+		return errors.New("--item-workers must be non-negative")
+	}
+
+	if o.ReadyTimeout < 0 {
+		return errors.New("--ready-timeout must be non-negative")
+	}
+
+	if o.ReadyTimeout > 0 && !o.WaitForReady {
+		return errors.New("--ready-timeout can only be used with --wait-for-ready")
+	}
+
 	if o.client == nil {
 		// This should never happen
 		return errors.New("Velero client is not set; unable to proceed")
@@ -261,9 +304,21 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			LabelSelector:           o.Selector.LabelSelector,
 			RestorePVs:              o.RestoreVolumes.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy.String()),
+			DryRun:                  o.DryRun,
+			ItemWorkers:             o.ItemWorkers,
+			WaitForReady:            o.WaitForReady,
+			ReadyTimeout:            metav1.Duration{Duration: o.ReadyTimeout},
 		},
 	}
 
+	if o.ResourceModifier != "" {
+		restore.Spec.ResourceModifier = &corev1api.TypedLocalObjectReference{
+			Kind: "ConfigMap",
+			Name: o.ResourceModifier,
+		}
+	}
+
 	if printed, err := output.PrintWithFormat(c, restore); printed || err != nil {
 		return err
 	}
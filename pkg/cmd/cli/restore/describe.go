@@ -26,6 +26,7 @@ import (
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 )
@@ -65,7 +66,17 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 					fmt.Fprintf(os.Stderr, "error getting PodVolumeRestores for restore %s: %v\n", restore.Name, err)
 				}
 
-				s := output.DescribeRestore(&restore, podvolumeRestoreList.Items, details, veleroClient, insecureSkipTLSVerify)
+				var encryptionKey []byte
+				if restore.Status.Warnings > 0 || restore.Status.Errors > 0 || restore.Spec.DryRun {
+					kubeClient, err := f.KubeClient()
+					cmd.CheckError(err)
+
+					if encryptionKey, err = downloadrequest.RestoreEncryptionKey(veleroClient.VeleroV1(), kubeClient.CoreV1(), &restore); err != nil {
+						fmt.Fprintf(os.Stderr, "error getting encryption key for restore %s: %v\n", restore.Name, err)
+					}
+				}
+
+				s := output.DescribeRestore(&restore, podvolumeRestoreList.Items, details, veleroClient, encryptionKey, insecureSkipTLSVerify)
 				if first {
 					first = false
 					fmt.Print(s)
@@ -29,6 +29,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/retention"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -62,6 +63,9 @@ example: "@every 2h30m".`,
 
 	# Create a weekly backup, each living for 90 days (2160 hours)
 	velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+	# Create a daily backup, keeping 7 daily, 4 weekly and 12 monthly backups
+	velero create schedule NAME --schedule="0 1 * * *" --ttl 8760h0m0s --keep-daily 7 --keep-weekly 4 --keep-monthly 12
 	`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
@@ -81,6 +85,8 @@ example: "@every 2h30m".`,
 type CreateOptions struct {
 	BackupOptions *backup.CreateOptions
 	Schedule      string
+	Paused        bool
+	Retention     api.RetentionPolicy
 
 	labelSelector *metav1.LabelSelector
 }
@@ -94,6 +100,13 @@ func NewCreateOptions() *CreateOptions {
 func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "a cron expression specifying a recurring schedule for this backup to run")
+	flags.BoolVar(&o.Paused, "paused", o.Paused, "specifies whether the newly created schedule is paused or not")
+	flags.IntVar(&o.Retention.KeepLast, "keep-last", o.Retention.KeepLast, "number of most recent successful backups to keep. Successful backups that no retention flag keeps are deleted.")
+	flags.IntVar(&o.Retention.KeepHourly, "keep-hourly", o.Retention.KeepHourly, "number of hours to keep the newest successful backup of")
+	flags.IntVar(&o.Retention.KeepDaily, "keep-daily", o.Retention.KeepDaily, "number of days to keep the newest successful backup of")
+	flags.IntVar(&o.Retention.KeepWeekly, "keep-weekly", o.Retention.KeepWeekly, "number of weeks to keep the newest successful backup of")
+	flags.IntVar(&o.Retention.KeepMonthly, "keep-monthly", o.Retention.KeepMonthly, "number of months to keep the newest successful backup of")
+	flags.IntVar(&o.Retention.KeepYearly, "keep-yearly", o.Retention.KeepYearly, "number of years to keep the newest successful backup of")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -101,6 +114,22 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	for _, keep := range []struct {
+		flag string
+		val  int
+	}{
+		{"--keep-last", o.Retention.KeepLast},
+		{"--keep-hourly", o.Retention.KeepHourly},
+		{"--keep-daily", o.Retention.KeepDaily},
+		{"--keep-weekly", o.Retention.KeepWeekly},
+		{"--keep-monthly", o.Retention.KeepMonthly},
+		{"--keep-yearly", o.Retention.KeepYearly},
+	} {
+		if keep.val < 0 {
+			return errors.Errorf("%s must be non-negative", keep.flag)
+		}
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -132,11 +161,18 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
 				StorageLocation:         o.BackupOptions.StorageLocation,
 				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
+				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
+				ItemWorkers:             o.BackupOptions.ItemWorkers,
 			},
 			Schedule: o.Schedule,
+			Paused:   o.Paused,
 		},
 	}
 
+	if retention.Enabled(&o.Retention) {
+		schedule.Spec.Retention = &o.Retention
+	}
+
 	if printed, err := output.PrintWithFormat(c, schedule); printed || err != nil {
 		return err
 	}
@@ -26,6 +26,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/retention"
 )
 
 func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
@@ -53,7 +55,21 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 			first := true
 			for _, schedule := range schedules.Items {
-				s := output.DescribeSchedule(&schedule)
+				// the schedule's backups are only needed to show what its
+				// retention policy would prune.
+				var backups []*v1.Backup
+				if retention.Enabled(schedule.Spec.Retention) {
+					backupList, err := veleroClient.VeleroV1().Backups(f.Namespace()).List(metav1.ListOptions{
+						LabelSelector: fmt.Sprintf("%s=%s", v1.ScheduleNameLabel, label.GetValidName(schedule.Name)),
+					})
+					cmd.CheckError(err)
+
+					for i := range backupList.Items {
+						backups = append(backups, &backupList.Items[i])
+					}
+				}
+
+				s := output.DescribeSchedule(&schedule, backups)
 				if first {
 					first = false
 					fmt.Print(s)
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewPauseCommand creates and returns a new cobra command for pausing schedules.
+func NewPauseCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("schedule")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Pause schedules",
+		Example: `	# pause a schedule named "schedule-1"
+	velero schedule pause schedule-1
+
+	# pause schedules named "schedule-1" and "schedule-2"
+	velero schedule pause schedule-1 schedule-2
+
+	# pause all schedules labelled with foo=bar
+	velero schedule pause --selector foo=bar
+
+	# pause all schedules
+	velero schedule pause --all`,
+
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(o, true))
+		},
+	}
+
+	o.BindFlags(c.Flags(), "Pause")
+	return c
+}
+
+// runPause sets the paused flag of the selected schedules to the specified value.
+func runPause(o *cli.SelectOptions, paused bool) error {
+	var (
+		schedules []*velerov1api.Schedule
+		errs      []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			schedule, err := o.Client.VeleroV1().Schedules(o.Namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			schedules = append(schedules, schedule)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := o.Client.VeleroV1().Schedules(o.Namespace).List(metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for i := range res.Items {
+			schedules = append(schedules, &res.Items[i])
+		}
+	}
+	if len(schedules) == 0 {
+		fmt.Println("No schedules found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	msg := "paused"
+	if !paused {
+		msg = "unpaused"
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Spec.Paused == paused {
+			fmt.Printf("Schedule %q is already %s, skip\n", schedule.Name, msg)
+			continue
+		}
+
+		if err := patchSchedulePaused(o, schedule, paused); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		fmt.Printf("Schedule %q %s successfully\n", schedule.Name, msg)
+	}
+	return kubeerrs.NewAggregate(errs)
+}
+
+func patchSchedulePaused(o *cli.SelectOptions, schedule *velerov1api.Schedule, paused bool) error {
+	original, err := json.Marshal(schedule)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	updated := schedule.DeepCopy()
+	updated.Spec.Paused = paused
+
+	updatedBytes, err := json.Marshal(updated)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	patchBytes, err := jsonpatch.CreateMergePatch(original, updatedBytes)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := o.Client.VeleroV1().Schedules(schedule.Namespace).Patch(schedule.Name, types.MergePatchType, patchBytes); err != nil {
+		return errors.Wrapf(err, "error patching schedule %q", schedule.Name)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewUnpauseCommand creates and returns a new cobra command for unpausing schedules.
+func NewUnpauseCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewSelectOptions("schedule")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Unpause schedules",
+		Example: `	# unpause a schedule named "schedule-1"
+	velero schedule unpause schedule-1
+
+	# unpause schedules named "schedule-1" and "schedule-2"
+	velero schedule unpause schedule-1 schedule-2
+
+	# unpause all schedules labelled with foo=bar
+	velero schedule unpause --selector foo=bar
+
+	# unpause all schedules
+	velero schedule unpause --all`,
+
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(runPause(o, false))
+		},
+	}
+
+	o.BindFlags(c.Flags(), "Unpause")
+	return c
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+// SelectOptions contains parameters used for selecting a set of resources
+// by name, by label selector, or all of them.
+type SelectOptions struct {
+	Names            []string
+	All              bool
+	Selector         flag.LabelSelector
+	Client           clientset.Interface
+	Namespace        string
+	singularTypeName string
+}
+
+// NewSelectOptions returns a SelectOptions for the given type name.
+func NewSelectOptions(singularTypeName string) *SelectOptions {
+	return &SelectOptions{singularTypeName: singularTypeName}
+}
+
+// Complete fills in the correct values for all the options.
+func (o *SelectOptions) Complete(f client.Factory, args []string) error {
+	o.Namespace = f.Namespace()
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.Client = client
+	o.Names = args
+	return nil
+}
+
+// Validate validates the fields of the SelectOptions struct.
+func (o *SelectOptions) Validate() error {
+	if o.Client == nil {
+		return errors.New("Velero client is not set; unable to proceed")
+	}
+	var (
+		hasNames    = len(o.Names) > 0
+		hasAll      = o.All
+		hasSelector = o.Selector.LabelSelector != nil
+	)
+	if !xor(hasNames, hasAll, hasSelector) {
+		return errors.New("you must specify exactly one of: specific " + o.singularTypeName + " name(s), the --all flag, or the --selector flag")
+	}
+
+	return nil
+}
+
+// BindFlags binds options for this command to flags. The verb is used in the
+// flags' help text, e.g. "Pause all schedules".
+func (o *SelectOptions) BindFlags(flags *pflag.FlagSet, verb string) {
+	flags.BoolVar(&o.All, "all", o.All, verb+" all "+o.singularTypeName+"s")
+	flags.VarP(&o.Selector, "selector", "l", verb+" all "+o.singularTypeName+"s matching this label selector")
+}
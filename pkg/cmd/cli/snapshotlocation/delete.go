@@ -0,0 +1,117 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotlocation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewDeleteCommand creates and returns a new cobra command for deleting volume snapshot locations.
+func NewDeleteCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewDeleteOptions("snapshot-location")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Delete volume snapshot locations",
+		Example: `	# delete a volume snapshot location named "snapshot-location-1"
+	velero snapshot-location delete snapshot-location-1
+
+	# delete a volume snapshot location named "snapshot-location-1" without prompting for confirmation
+	velero snapshot-location delete snapshot-location-1 --confirm
+
+	# delete volume snapshot locations named "snapshot-location-1" and "snapshot-location-2"
+	velero snapshot-location delete snapshot-location-1 snapshot-location-2
+
+	# delete all volume snapshot locations labelled with foo=bar
+	velero snapshot-location delete --selector foo=bar
+
+	# delete all volume snapshot locations
+	velero snapshot-location delete --all`,
+
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate(c, f, args))
+			cmd.CheckError(Run(o))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+	return c
+}
+
+// Run performs the deletion of volume snapshot locations.
+func Run(o *cli.DeleteOptions) error {
+	if !o.Confirm && !cli.GetConfirmation() {
+		return nil
+	}
+
+	var (
+		locations []*api.VolumeSnapshotLocation
+		errs      []error
+	)
+	switch {
+	case len(o.Names) > 0:
+		for _, name := range o.Names {
+			location, err := o.Client.VeleroV1().VolumeSnapshotLocations(o.Namespace).Get(name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			locations = append(locations, location)
+		}
+	default:
+		selector := labels.Everything().String()
+		if o.Selector.LabelSelector != nil {
+			selector = o.Selector.String()
+		}
+		res, err := o.Client.VeleroV1().VolumeSnapshotLocations(o.Namespace).List(metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for i := range res.Items {
+			locations = append(locations, &res.Items[i])
+		}
+	}
+	if len(locations) == 0 {
+		fmt.Println("No volume snapshot locations found")
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	for _, location := range locations {
+		if err := o.Client.VeleroV1().VolumeSnapshotLocations(location.Namespace).Delete(location.Name, nil); err != nil {
+			errs = append(errs, errors.WithStack(err))
+			continue
+		}
+		fmt.Printf("Volume snapshot location %q deleted successfully.\n", location.Name)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
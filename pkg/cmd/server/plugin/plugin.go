@@ -38,6 +38,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterBackupItemAction("velero.io/pv", newPVBackupItemAction).
 				RegisterBackupItemAction("velero.io/pod", newPodBackupItemAction).
 				RegisterBackupItemAction("velero.io/service-account", newServiceAccountBackupItemAction(f)).
+				RegisterBackupItemAction("velero.io/csi-pvc", newCSIPVCBackupItemAction(f)).
 				RegisterRestoreItemAction("velero.io/job", newJobRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/pod", newPodRestoreItemAction).
 				RegisterRestoreItemAction("velero.io/restic", newResticRestoreItemAction(f)).
@@ -49,6 +50,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 				RegisterRestoreItemAction("velero.io/role-bindings", newRoleBindingItemAction).
 				RegisterRestoreItemAction("velero.io/cluster-role-bindings", newClusterRoleBindingItemAction).
 				RegisterRestoreItemAction("velero.io/crd-preserve-fields", newCRDV1PreserveUnknownFieldsItemAction).
+				RegisterRestoreItemAction("velero.io/csi-pvc", newCSIPVCRestoreItemAction).
+				RegisterRestoreItemAction("velero.io/csi-volumesnapshot", newCSIVolumeSnapshotRestoreItemAction).
+				RegisterRestoreItemAction("velero.io/csi-volumesnapshotcontent", newCSIVolumeSnapshotContentRestoreItemAction).
 				Serve()
 		},
 	}
@@ -91,6 +95,22 @@ func newServiceAccountBackupItemAction(f client.Factory) veleroplugin.HandlerIni
 	}
 }
 
+func newCSIPVCBackupItemAction(f client.Factory) veleroplugin.HandlerInitializer {
+	return func(logger logrus.FieldLogger) (interface{}, error) {
+		client, err := f.KubeClient()
+		if err != nil {
+			return nil, err
+		}
+
+		dynamicClient, err := f.DynamicClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return backup.NewCSIPVCAction(logger, client.CoreV1(), client.CoreV1(), dynamicClient), nil
+	}
+}
+
 func newJobRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewJobAction(logger), nil
 }
@@ -157,3 +177,15 @@ func newRoleBindingItemAction(logger logrus.FieldLogger) (interface{}, error) {
 func newClusterRoleBindingItemAction(logger logrus.FieldLogger) (interface{}, error) {
 	return restore.NewClusterRoleBindingAction(logger), nil
 }
+
+func newCSIPVCRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return restore.NewCSIPVCAction(logger), nil
+}
+
+func newCSIVolumeSnapshotRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return restore.NewCSIVolumeSnapshotAction(logger), nil
+}
+
+func newCSIVolumeSnapshotContentRestoreItemAction(logger logrus.FieldLogger) (interface{}, error) {
+	return restore.NewCSIVolumeSnapshotContentAction(logger), nil
+}
@@ -27,6 +27,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
@@ -42,8 +43,11 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -51,7 +55,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/signals"
 	"github.com/vmware-tanzu/velero/pkg/controller"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerodiscovery "github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/encryption"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
@@ -61,6 +67,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -72,6 +79,13 @@ const (
 	defaultPodVolumeOperationTimeout  = 60 * time.Minute
 	defaultResourceTerminatingTimeout = 10 * time.Minute
 
+	// the lease the server replicas elect a leader with, in the server's
+	// namespace, and its default timings
+	leaderElectionLeaseName            = "velero"
+	defaultLeaderElectionLeaseDuration = 15 * time.Second
+	defaultLeaderElectionRenewDeadline = 10 * time.Second
+	defaultLeaderElectionRetryPeriod   = 2 * time.Second
+
 	// server's client default qps and burst
 	defaultClientQPS   float32 = 20.0
 	defaultClientBurst int     = 30
@@ -79,19 +93,25 @@ const (
 	defaultProfilerAddress = "localhost:6060"
 
 	// keys used to map out available controllers with disable-controllers flag
-	BackupControllerKey              = "backup"
-	BackupSyncControllerKey          = "backup-sync"
-	ScheduleControllerKey            = "schedule"
-	GcControllerKey                  = "gc"
-	BackupDeletionControllerKey      = "backup-deletion"
-	RestoreControllerKey             = "restore"
-	DownloadRequestControllerKey     = "download-request"
-	ResticRepoControllerKey          = "restic-repo"
-	ServerStatusRequestControllerKey = "server-status-request"
+	BackupControllerKey                = "backup"
+	BackupSyncControllerKey            = "backup-sync"
+	ScheduleControllerKey              = "schedule"
+	GcControllerKey                    = "gc"
+	ScheduleRetentionControllerKey     = "schedule-retention"
+	BackupDeletionControllerKey        = "backup-deletion"
+	BackupVerificationControllerKey    = "backup-verification"
+	RestoreControllerKey               = "restore"
+	DownloadRequestControllerKey       = "download-request"
+	ResticRepoControllerKey            = "restic-repo"
+	ServerStatusRequestControllerKey   = "server-status-request"
+	BackupStorageLocationControllerKey = "backup-storage-location"
 
 	defaultControllerWorkers = 1
 	// the default TTL for a backup
 	defaultBackupTTL = 30 * 24 * time.Hour
+	// the default number of items in each resource that a backup backs up, or a
+	// restore restores, concurrently
+	defaultItemWorkers = 1
 )
 
 // list of available controllers for input validation
@@ -100,11 +120,14 @@ var disableControllerList = []string{
 	BackupSyncControllerKey,
 	ScheduleControllerKey,
 	GcControllerKey,
+	ScheduleRetentionControllerKey,
 	BackupDeletionControllerKey,
+	BackupVerificationControllerKey,
 	RestoreControllerKey,
 	DownloadRequestControllerKey,
 	ResticRepoControllerKey,
 	ServerStatusRequestControllerKey,
+	BackupStorageLocationControllerKey,
 }
 
 type serverConfig struct {
@@ -120,6 +143,16 @@ type serverConfig struct {
 	profilerAddress                                                         string
 	formatFlag                                                              *logging.FormatFlag
 	defaultResticMaintenanceFrequency                                       time.Duration
+	defaultVolumesToRestic                                                  bool
+	defaultItemWorkers                                                      int
+	defaultRestoreItemWorkers                                               int
+	storeValidationFrequency                                                time.Duration
+	leaderElection                                                          bool
+	leaderElectionLeaseDuration                                             time.Duration
+	leaderElectionRenewDeadline                                             time.Duration
+	leaderElectionRetryPeriod                                               time.Duration
+	backupCompression                                                       string
+	encryptionKeyID                                                         string
 }
 
 type controllerRunInfo struct {
@@ -146,6 +179,14 @@ func NewCommand(f client.Factory) *cobra.Command {
 			resourceTerminatingTimeout:        defaultResourceTerminatingTimeout,
 			formatFlag:                        logging.NewFormatFlag(),
 			defaultResticMaintenanceFrequency: restic.DefaultMaintenanceFrequency,
+			backupCompression:                 string(api.BackupCompressionGzip),
+			defaultItemWorkers:                defaultItemWorkers,
+			defaultRestoreItemWorkers:         defaultItemWorkers,
+			storeValidationFrequency:          controller.DefaultStoreValidationFrequency,
+			leaderElection:                    true,
+			leaderElectionLeaseDuration:       defaultLeaderElectionLeaseDuration,
+			leaderElectionRenewDeadline:       defaultLeaderElectionRenewDeadline,
+			leaderElectionRetryPeriod:         defaultLeaderElectionRetryPeriod,
 		}
 	)
 
@@ -181,6 +222,10 @@ func NewCommand(f client.Factory) *cobra.Command {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
 
+			if features.IsEnabled(api.CSIFeatureFlag) && !c.Flags().Changed("restore-resource-priorities") {
+				config.restoreResourcePriorities = addCSIRestorePriorities(config.restoreResourcePriorities)
+			}
+
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
 			s, err := newServer(f, config, logger)
@@ -194,12 +239,17 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(config.formatFlag, "log-format", fmt.Sprintf("the format for log output. Valid values are %s.", strings.Join(config.formatFlag.AllowedValues(), ", ")))
 	command.Flags().StringVar(&config.pluginDir, "plugin-dir", config.pluginDir, "directory containing Velero plugins")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "the address to expose prometheus metrics")
+	command.Flags().BoolVar(&config.leaderElection, "leader-elect", config.leaderElection, "elect a leader among the server's replicas before running any controllers, so that only one replica processes backups, restores and schedules at a time")
+	command.Flags().DurationVar(&config.leaderElectionLeaseDuration, "leader-elect-lease-duration", config.leaderElectionLeaseDuration, "how long replicas that aren't the leader wait, after the leader last renewed its lease, before trying to become the leader")
+	command.Flags().DurationVar(&config.leaderElectionRenewDeadline, "leader-elect-renew-deadline", config.leaderElectionRenewDeadline, "how long the leader keeps trying to renew its lease before it stops running controllers and exits. Must be less than --leader-elect-lease-duration.")
+	command.Flags().DurationVar(&config.leaderElectionRetryPeriod, "leader-elect-retry-period", config.leaderElectionRetryPeriod, "how long replicas wait between attempts to become the leader, or to renew the leader's lease")
+	command.Flags().DurationVar(&config.storeValidationFrequency, "store-validation-frequency", config.storeValidationFrequency, "how often to check that each backup storage location is available")
 	command.Flags().DurationVar(&config.backupSyncPeriod, "backup-sync-period", config.backupSyncPeriod, "how often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. This is the default sync period if none is explicitly specified for a backup storage location.")
 	command.Flags().DurationVar(&config.podVolumeOperationTimeout, "restic-timeout", config.podVolumeOperationTimeout, "how long backups/restores of pod volumes should be allowed to run before timing out")
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("list of controllers to disable on startup. Valid values are %s", strings.Join(disableControllerList, ",")))
 	command.Flags().StringSliceVar(&config.restoreResourcePriorities, "restore-resource-priorities", config.restoreResourcePriorities, "desired order of resource restores; any resource not in the list will be restored alphabetically after the prioritized resources")
-	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "name of the default backup storage location")
+	command.Flags().StringVar(&config.defaultBackupLocation, "default-backup-storage-location", config.defaultBackupLocation, "name of the default backup storage location. Only used if no backup storage location has its spec.default field set. DEPRECATED: this flag will be removed in v2.0. Use 'velero backup-location set --default' instead.")
 	command.Flags().Var(&volumeSnapshotLocations, "default-volume-snapshot-locations", "list of unique volume providers and default volume snapshot location (provider1:location-01,provider2:location-02,...)")
 	command.Flags().Float32Var(&config.clientQPS, "client-qps", config.clientQPS, "maximum number of requests per second by the server to the Kubernetes API once the burst limit has been reached")
 	command.Flags().IntVar(&config.clientBurst, "client-burst", config.clientBurst, "maximum number of requests by the server to the Kubernetes API in a short period of time")
@@ -207,6 +257,11 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "how long to wait on persistent volumes and namespaces to terminate during a restore before timing out")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "how long to wait by default before backups can be garbage collected")
 	command.Flags().DurationVar(&config.defaultResticMaintenanceFrequency, "default-restic-prune-frequency", config.defaultResticMaintenanceFrequency, "how often 'restic prune' is run for restic repositories by default")
+	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "backup all volumes with restic by default")
+	command.Flags().IntVar(&config.defaultItemWorkers, "default-item-workers", config.defaultItemWorkers, "how many items in each resource a backup backs up concurrently by default")
+	command.Flags().IntVar(&config.defaultRestoreItemWorkers, "default-restore-item-workers", config.defaultRestoreItemWorkers, "how many items a restore restores concurrently by default")
+	command.Flags().StringVar(&config.backupCompression, "backup-compression", config.backupCompression, fmt.Sprintf("how to compress backup tarballs. Valid values are %s, %s, %s.", api.BackupCompressionGzip, api.BackupCompressionZstd, api.BackupCompressionNone))
+	command.Flags().StringVar(&config.encryptionKeyID, "encryption-key-id", config.encryptionKeyID, fmt.Sprintf("ID of the key in the %s secret to encrypt backups' data in object storage with. If empty, backups are not encrypted.", encryption.KeysSecretName))
 
 	return command
 }
@@ -229,6 +284,12 @@ type server struct {
 	resticManager         restic.RepositoryManager
 	metrics               *metrics.ServerMetrics
 	config                serverConfig
+	credentialFileStore   credentials.FileStore
+
+	// instanceID uniquely identifies this run of the server. It's recorded on
+	// the backups and restores it starts processing, so that ones left
+	// InProgress by a previous run can be recognized.
+	instanceID string
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -242,6 +303,10 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 	}
 	f.SetClientBurst(config.clientBurst)
 
+	if err := archive.ValidateCompression(api.BackupCompression(config.backupCompression)); err != nil {
+		return nil, errors.Wrap(err, "invalid backup-compression")
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
@@ -269,6 +334,21 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	credentialFileStore, err := credentials.NewNamespacedFileStore(
+		kubeClient.CoreV1(),
+		f.Namespace(),
+		credentials.DefaultStoreDirectory,
+		filesystem.NewFileSystem(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID, err := uuid.NewV4()
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating server instance ID")
+	}
+
 	s := &server{
 		namespace:             f.Namespace(),
 		metricsAddress:        config.metricsAddress,
@@ -284,6 +364,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		logLevel:              logger.Level,
 		pluginRegistry:        pluginRegistry,
 		config:                config,
+		credentialFileStore:   credentialFileStore,
+		instanceID:            instanceID.String(),
 	}
 
 	return s, nil
@@ -315,19 +397,98 @@ func (s *server) run() error {
 		return err
 	}
 
-	if _, err := s.veleroClient.VeleroV1().BackupStorageLocations(s.namespace).Get(s.config.defaultBackupLocation, metav1.GetOptions{}); err != nil {
-		s.logger.WithError(errors.WithStack(err)).
-			Warnf("A backup storage location named %s has been specified for the server to use by default, but no corresponding backup storage location exists. Backups with a location not matching the default will need to explicitly specify an existing location", s.config.defaultBackupLocation)
-	}
+	s.checkDefaultBackupLocation()
 
 	if err := s.initRestic(); err != nil {
 		return err
 	}
 
-	if err := s.runControllers(s.config.defaultVolumeSnapshotLocations); err != nil {
+	if !s.config.leaderElection {
+		return s.runControllers(s.ctx, s.config.defaultVolumeSnapshotLocations)
+	}
+
+	return s.runWithLeaderElection()
+}
+
+// runWithLeaderElection runs the controllers only while this replica holds the
+// leader lease, so that only one of the server's replicas processes backups,
+// restores and schedules at a time. It returns an error if the lease is lost,
+// so that the replica exits and restarts as a candidate.
+func (s *server) runWithLeaderElection() error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return errors.Wrap(err, "error getting hostname for leader election identity")
+	}
+	identity := hostname + "_" + s.instanceID
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: s.namespace,
+			Name:      leaderElectionLeaseName,
+		},
+		Client: s.kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+
+	// electorCtx is cancelled if the controllers stop on their own, so
+	// that the elector stops renewing the lease and releases it.
+	electorCtx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	leading := make(chan struct{})
+	runErr := make(chan error, 1)
+
+	log := s.logger.WithFields(logrus.Fields{
+		"lease":    s.namespace + "/" + leaderElectionLeaseName,
+		"identity": identity,
+	})
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   s.config.leaderElectionLeaseDuration,
+		RenewDeadline:   s.config.leaderElectionRenewDeadline,
+		RetryPeriod:     s.config.leaderElectionRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            leaderElectionLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Info("Acquired leader lease")
+				close(leading)
+				runErr <- s.runControllers(ctx, s.config.defaultVolumeSnapshotLocations)
+				cancel()
+			},
+			OnStoppedLeading: func() {
+				log.Info("Stopped leading")
+			},
+			OnNewLeader: func(leader string) {
+				log.WithField("leader", leader).Info("Observed a new leader")
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "error configuring leader election")
+	}
+
+	log.Info("Attempting to acquire leader lease")
+	elector.Run(electorCtx)
+
+	select {
+	case <-leading:
+	default:
+		// the server was shut down before this replica became the leader
+		return nil
+	}
+
+	// wait for the controllers to stop
+	if err := <-runErr; err != nil {
 		return err
 	}
 
+	if s.ctx.Err() == nil {
+		return errors.New("leader election lost")
+	}
 	return nil
 }
 
@@ -407,6 +568,27 @@ func (s *server) veleroResourcesExist() error {
 	return nil
 }
 
+// checkDefaultBackupLocation logs a warning if no backup storage location is marked as the
+// default and the location named by the --default-backup-storage-location flag doesn't exist.
+func (s *server) checkDefaultBackupLocation() {
+	locations, err := s.veleroClient.VeleroV1().BackupStorageLocations(s.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		s.logger.WithError(errors.WithStack(err)).Warn("Error listing backup storage locations")
+		return
+	}
+
+	for _, location := range locations.Items {
+		if location.Spec.Default {
+			return
+		}
+	}
+
+	if _, err := s.veleroClient.VeleroV1().BackupStorageLocations(s.namespace).Get(s.config.defaultBackupLocation, metav1.GetOptions{}); err != nil {
+		s.logger.WithError(errors.WithStack(err)).
+			Warnf("A backup storage location named %s has been specified for the server to use by default, but no corresponding backup storage location exists. Backups with a location not matching the default will need to explicitly specify an existing location", s.config.defaultBackupLocation)
+	}
+}
+
 // validateBackupStorageLocations checks to ensure all backup storage locations exist
 // and have a compatible layout, and returns an error if not.
 func (s *server) validateBackupStorageLocations() error {
@@ -422,7 +604,7 @@ func (s *server) validateBackupStorageLocations() error {
 
 	var invalid []string
 	for _, location := range locations.Items {
-		backupStore, err := persistence.NewObjectBackupStore(&location, pluginManager, s.logger)
+		backupStore, err := persistence.NewObjectBackupStore(&location, pluginManager, s.credentialFileStore, s.logger)
 		if err != nil {
 			invalid = append(invalid, errors.Wrapf(err, "error getting backup store for location %q", location.Name).Error())
 			continue
@@ -472,6 +654,37 @@ var defaultRestorePriorities = []string{
 	"replicasets.apps",
 }
 
+// csiRestorePriorities are the CSI snapshot resources that are added to the default
+// restore priorities when the CSI feature flag is enabled. They must be restored
+// before PVCs so that the PVCs can be provisioned from the snapshots.
+var csiRestorePriorities = []string{
+	"volumesnapshotclasses.snapshot.storage.k8s.io",
+	"volumesnapshotcontents.snapshot.storage.k8s.io",
+	"volumesnapshots.snapshot.storage.k8s.io",
+}
+
+// addCSIRestorePriorities returns a copy of the provided restore priorities with the
+// CSI snapshot resources inserted before persistent volumes, or appended if persistent
+// volumes are not prioritized.
+func addCSIRestorePriorities(priorities []string) []string {
+	res := make([]string, 0, len(priorities)+len(csiRestorePriorities))
+
+	added := false
+	for _, resource := range priorities {
+		if resource == "persistentvolumes" && !added {
+			res = append(res, csiRestorePriorities...)
+			added = true
+		}
+		res = append(res, resource)
+	}
+
+	if !added {
+		res = append(res, csiRestorePriorities...)
+	}
+
+	return res
+}
+
 func (s *server) initRestic() error {
 	// warn if restic daemonset does not exist
 	if _, err := s.kubeClient.AppsV1().DaemonSets(s.namespace).Get(restic.DaemonSet, metav1.GetOptions{}); apierrors.IsNotFound(err) {
@@ -512,6 +725,7 @@ func (s *server) initRestic() error {
 		s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 		s.kubeClient.CoreV1(),
 		s.kubeClient.CoreV1(),
+		s.credentialFileStore,
 		s.logger,
 	)
 	if err != nil {
@@ -522,10 +736,9 @@ func (s *server) initRestic() error {
 	return nil
 }
 
-func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string) error {
+func (s *server) runControllers(ctx context.Context, defaultVolumeSnapshotLocations map[string]string) error {
 	s.logger.Info("Starting controllers")
 
-	ctx := s.ctx
 	var wg sync.WaitGroup
 
 	go func() {
@@ -557,6 +770,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.namespace,
 			s.config.defaultBackupLocation,
 			newPluginManager,
+			s.credentialFileStore,
 			s.logger,
 		)
 
@@ -566,15 +780,35 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	backupStorageLocationControllerRunInfo := func() controllerRunInfo {
+		backupStorageLocationController := controller.NewBackupStorageLocationController(
+			s.namespace,
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.config.storeValidationFrequency,
+			newPluginManager,
+			s.credentialFileStore,
+			s.metrics,
+			s.logger,
+		)
+
+		return controllerRunInfo{
+			controller: backupStorageLocationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	backupTracker := controller.NewBackupTracker()
 
 	backupControllerRunInfo := func() controllerRunInfo {
 		backupper, err := backup.NewKubernetesBackupper(
+			s.veleroClient.VeleroV1(),
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
+			s.credentialFileStore,
 		)
 		cmd.CheckError(err)
 
@@ -585,14 +819,21 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			s.logLevel,
 			newPluginManager,
+			s.credentialFileStore,
 			backupTracker,
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 			s.config.defaultBackupLocation,
 			s.config.defaultBackupTTL,
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations(),
 			defaultVolumeSnapshotLocations,
+			s.config.defaultVolumesToRestic,
+			s.config.defaultItemWorkers,
+			api.BackupCompression(s.config.backupCompression),
+			s.config.encryptionKeyID,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			s.veleroClient.VeleroV1(),
+			s.instanceID,
 		)
 
 		return controllerRunInfo{
@@ -632,6 +873,22 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	scheduleRetentionControllerRunInfo := func() controllerRunInfo {
+		scheduleRetentionController := controller.NewScheduleRetentionController(
+			s.logger,
+			s.sharedInformerFactory.Velero().V1().Schedules(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.sharedInformerFactory.Velero().V1().DeleteBackupRequests(),
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+		)
+
+		return controllerRunInfo{
+			controller: scheduleRetentionController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	deletionControllerRunInfo := func() controllerRunInfo {
 		deletionController := controller.NewBackupDeletionController(
 			s.logger,
@@ -645,7 +902,10 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().PodVolumeBackups(),
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations(),
+			s.dynamicClient,
+			s.discoveryHelper,
 			newPluginManager,
+			s.credentialFileStore,
 			s.metrics,
 		)
 
@@ -655,16 +915,38 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	verificationControllerRunInfo := func() controllerRunInfo {
+		verificationController := controller.NewBackupVerificationController(
+			s.logger,
+			s.veleroClient.VeleroV1(),
+			s.sharedInformerFactory.Velero().V1().Backups(),
+			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
+			s.resticManager,
+			newPluginManager,
+			s.credentialFileStore,
+			s.instanceID,
+		)
+
+		return controllerRunInfo{
+			controller: verificationController,
+			numWorkers: defaultControllerWorkers,
+		}
+	}
+
 	restoreControllerRunInfo := func() controllerRunInfo {
 
 		restorer, err := restore.NewKubernetesRestorer(
+			s.veleroClient.VeleroV1(),
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
 			s.config.restoreResourcePriorities,
 			s.kubeClient.CoreV1().Namespaces(),
+			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			s.resticManager,
 			s.config.podVolumeOperationTimeout,
 			s.config.resourceTerminatingTimeout,
+			s.config.defaultRestoreItemWorkers,
+			s.credentialFileStore,
 			s.logger,
 		)
 		cmd.CheckError(err)
@@ -674,6 +956,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().Restores(),
 			s.veleroClient.VeleroV1(),
 			s.veleroClient.VeleroV1(),
+			s.veleroClient.VeleroV1(),
+			s.kubeClient.CoreV1(),
+			s.kubeClient,
 			restorer,
 			s.sharedInformerFactory.Velero().V1().Backups(),
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
@@ -681,9 +966,11 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.logger,
 			s.logLevel,
 			newPluginManager,
+			s.credentialFileStore,
 			s.config.defaultBackupLocation,
 			s.metrics,
 			s.config.formatFlag.Parse(),
+			s.instanceID,
 		)
 
 		return controllerRunInfo{
@@ -716,6 +1003,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.sharedInformerFactory.Velero().V1().BackupStorageLocations(),
 			s.sharedInformerFactory.Velero().V1().Backups(),
 			newPluginManager,
+			s.credentialFileStore,
 			s.logger,
 		)
 
@@ -740,23 +1028,27 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	enabledControllers := map[string]func() controllerRunInfo{
-		BackupSyncControllerKey:          backupSyncControllerRunInfo,
-		BackupControllerKey:              backupControllerRunInfo,
-		ScheduleControllerKey:            scheduleControllerRunInfo,
-		GcControllerKey:                  gcControllerRunInfo,
-		BackupDeletionControllerKey:      deletionControllerRunInfo,
-		RestoreControllerKey:             restoreControllerRunInfo,
-		ResticRepoControllerKey:          resticRepoControllerRunInfo,
-		DownloadRequestControllerKey:     downloadrequestControllerRunInfo,
-		ServerStatusRequestControllerKey: serverStatusRequestControllerRunInfo,
+		BackupSyncControllerKey:            backupSyncControllerRunInfo,
+		BackupControllerKey:                backupControllerRunInfo,
+		ScheduleControllerKey:              scheduleControllerRunInfo,
+		GcControllerKey:                    gcControllerRunInfo,
+		ScheduleRetentionControllerKey:     scheduleRetentionControllerRunInfo,
+		BackupDeletionControllerKey:        deletionControllerRunInfo,
+		BackupVerificationControllerKey:    verificationControllerRunInfo,
+		RestoreControllerKey:               restoreControllerRunInfo,
+		ResticRepoControllerKey:            resticRepoControllerRunInfo,
+		DownloadRequestControllerKey:       downloadrequestControllerRunInfo,
+		ServerStatusRequestControllerKey:   serverStatusRequestControllerRunInfo,
+		BackupStorageLocationControllerKey: backupStorageLocationControllerRunInfo,
 	}
 
 	if s.config.restoreOnly {
-		s.logger.Info("Restore only mode - not starting the backup, schedule, delete-backup, or GC controllers")
+		s.logger.Info("Restore only mode - not starting the backup, schedule, schedule-retention, delete-backup, or GC controllers")
 		s.config.disabledControllers = append(s.config.disabledControllers,
 			BackupControllerKey,
 			ScheduleControllerKey,
 			GcControllerKey,
+			ScheduleRetentionControllerKey,
 			BackupDeletionControllerKey,
 		)
 	}
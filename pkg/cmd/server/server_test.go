@@ -69,3 +69,41 @@ func TestVeleroResourcesExist(t *testing.T) {
 	veleroAPIResourceList.APIResources = veleroAPIResourceList.APIResources[:3]
 	assert.Error(t, server.veleroResourcesExist())
 }
+
+func TestAddCSIRestorePriorities(t *testing.T) {
+	tests := []struct {
+		name       string
+		priorities []string
+		want       []string
+	}{
+		{
+			name:       "CSI resources are added before persistent volumes",
+			priorities: []string{"namespaces", "storageclasses", "persistentvolumes", "persistentvolumeclaims"},
+			want: []string{
+				"namespaces",
+				"storageclasses",
+				"volumesnapshotclasses.snapshot.storage.k8s.io",
+				"volumesnapshotcontents.snapshot.storage.k8s.io",
+				"volumesnapshots.snapshot.storage.k8s.io",
+				"persistentvolumes",
+				"persistentvolumeclaims",
+			},
+		},
+		{
+			name:       "CSI resources are appended when persistent volumes are not prioritized",
+			priorities: []string{"namespaces"},
+			want: []string{
+				"namespaces",
+				"volumesnapshotclasses.snapshot.storage.k8s.io",
+				"volumesnapshotcontents.snapshot.storage.k8s.io",
+				"volumesnapshots.snapshot.storage.k8s.io",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, addCSIRestorePriorities(tc.priorities))
+		})
+	}
+}
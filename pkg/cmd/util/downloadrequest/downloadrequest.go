@@ -30,8 +30,10 @@ import (
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/encryption"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 )
 
@@ -39,7 +41,10 @@ import (
 // not found
 var ErrNotFound = errors.New("file not found")
 
-func Stream(client velerov1client.DownloadRequestsGetter, namespace, name string, kind v1.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool) error {
+// Stream downloads the given target and writes it to w. If encryptionKey is non-nil, the
+// target is decrypted with it before being written. All targets except backup contents are
+// also decompressed.
+func Stream(client velerov1client.DownloadRequestsGetter, namespace, name string, kind v1.DownloadTargetKind, encryptionKey []byte, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool) error {
 	req := &v1.DownloadRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
@@ -140,10 +145,16 @@ Loop:
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
+	var reader io.Reader = resp.Body
+	if encryptionKey != nil {
+		if reader, err = encryption.NewReader(reader, encryptionKey); err != nil {
+			return err
+		}
+	}
+
 	if kind != v1.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
@@ -154,3 +165,24 @@ Loop:
 	_, err = io.Copy(w, reader)
 	return err
 }
+
+// BackupEncryptionKey returns the key that the given backup's files in object storage
+// are encrypted with, or nil if they're not encrypted.
+func BackupEncryptionKey(secretsGetter corev1client.SecretsGetter, backup *v1.Backup) ([]byte, error) {
+	if backup.Status.EncryptionKeyID == "" {
+		return nil, nil
+	}
+
+	return encryption.GetKey(secretsGetter, backup.Namespace, backup.Status.EncryptionKeyID)
+}
+
+// RestoreEncryptionKey returns the key that the given restore's files in object storage
+// are encrypted with, which is its backup's key, or nil if they're not encrypted.
+func RestoreEncryptionKey(backupsGetter velerov1client.BackupsGetter, secretsGetter corev1client.SecretsGetter, restore *v1.Restore) ([]byte, error) {
+	backup, err := backupsGetter.Backups(restore.Namespace).Get(restore.Spec.BackupName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting backup %s", restore.Spec.BackupName)
+	}
+
+	return BackupEncryptionKey(secretsGetter, backup)
+}
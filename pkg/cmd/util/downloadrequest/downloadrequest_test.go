@@ -21,6 +21,7 @@ import (
 	"compress/gzip"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -34,6 +35,7 @@ import (
 	core "k8s.io/client-go/testing"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/encryption"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 )
 
@@ -41,6 +43,7 @@ func TestStream(t *testing.T) {
 	tests := []struct {
 		name          string
 		kind          v1.DownloadTargetKind
+		encryptionKey []byte
 		timeout       time.Duration
 		createError   error
 		watchError    error
@@ -93,6 +96,14 @@ func TestStream(t *testing.T) {
 			statusCode:    http.StatusOK,
 			body:          "download body",
 		},
+		{
+			name:          "encrypted download is decrypted",
+			kind:          v1.DownloadTargetKindBackupLog,
+			encryptionKey: bytes.Repeat([]byte("k"), 32),
+			updateWithURL: true,
+			statusCode:    http.StatusOK,
+			body:          "download body",
+		},
 		{
 			name:          "http error",
 			kind:          v1.DownloadTargetKindBackupLog,
@@ -137,9 +148,16 @@ func TestStream(t *testing.T) {
 				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 					w.WriteHeader(test.statusCode)
 					if test.statusCode == http.StatusOK {
-						gzipWriter := gzip.NewWriter(w)
+						var body io.WriteCloser = nopWriteCloser{w}
+						if test.encryptionKey != nil {
+							var err error
+							body, err = encryption.NewWriter(w, test.encryptionKey)
+							require.NoError(t, err)
+						}
+						gzipWriter := gzip.NewWriter(body)
 						fmt.Fprintf(gzipWriter, test.body)
 						gzipWriter.Close()
+						body.Close()
 						return
 					}
 					fmt.Fprintf(w, test.body)
@@ -151,7 +169,7 @@ func TestStream(t *testing.T) {
 			output := new(bytes.Buffer)
 			errCh := make(chan error)
 			go func() {
-				err := Stream(client.VeleroV1(), "namespace", "name", test.kind, output, timeout, false)
+				err := Stream(client.VeleroV1(), "namespace", "name", test.kind, test.encryptionKey, output, timeout, false)
 				errCh <- err
 			}()
 
@@ -223,3 +241,9 @@ func newDownloadRequest(name string) *downloadRequest {
 		},
 	}
 }
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
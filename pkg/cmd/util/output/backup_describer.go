@@ -24,9 +24,11 @@ import (
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -38,6 +40,7 @@ func DescribeBackup(
 	podVolumeBackups []velerov1api.PodVolumeBackup,
 	details bool,
 	veleroClient clientset.Interface,
+	encryptionKey []byte,
 	insecureSkipTLSVerify bool,
 ) string {
 	return Describe(func(d *Describer) {
@@ -50,13 +53,22 @@ func DescribeBackup(
 		}
 
 		logsNote := ""
-		if backup.Status.Phase == velerov1api.BackupPhaseFailed || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseFailed, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseCanceled:
 			logsNote = fmt.Sprintf(" (run `velero backup logs %s` for more information)", backup.Name)
+		case velerov1api.BackupPhaseInProgress:
+			if backup.Spec.Cancel {
+				logsNote = " (cancellation requested)"
+			}
 		}
 
 		d.Printf("Phase:\t%s%s\n", phase, logsNote)
 
 		status := backup.Status
+		if status.FailureReason != "" {
+			d.Printf("Failure reason:\t%s\n", status.FailureReason)
+		}
+
 		if len(status.ValidationErrors) > 0 {
 			d.Println()
 			d.Printf("Validation errors:")
@@ -75,7 +87,12 @@ func DescribeBackup(
 		DescribeBackupSpec(d, backup.Spec)
 
 		d.Println()
-		DescribeBackupStatus(d, backup, details, veleroClient, insecureSkipTLSVerify)
+		DescribeBackupStatus(d, backup, details, veleroClient, encryptionKey, insecureSkipTLSVerify)
+
+		if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+			d.Println()
+			describeCSIVolumeSnapshots(d, backup, details, veleroClient, encryptionKey, insecureSkipTLSVerify)
+		}
 
 		if len(deleteRequests) > 0 {
 			d.Println()
@@ -136,6 +153,14 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 
 	d.Println()
 	d.Printf("Snapshot PVs:\t%s\n", BoolPointerString(spec.SnapshotVolumes, "false", "true", "auto"))
+	d.Printf("Default Volumes to Restic:\t%s\n", BoolPointerString(spec.DefaultVolumesToRestic, "false", "true", "auto"))
+
+	d.Println()
+	s = "<server default>"
+	if spec.ItemWorkers > 0 {
+		s = fmt.Sprintf("%d", spec.ItemWorkers)
+	}
+	d.Printf("Item Workers:\t%s\n", s)
 
 	d.Println()
 	d.Printf("TTL:\t%s\n", spec.TTL.Duration)
@@ -212,10 +237,16 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 }
 
 // DescribeBackupStatus describes a backup status in human-readable format.
-func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool) {
+func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool, veleroClient clientset.Interface, encryptionKey []byte, insecureSkipTLSVerify bool) {
 	status := backup.Status
 
 	d.Printf("Backup Format Version:\t%d\n", status.Version)
+	if status.Compression != "" {
+		d.Printf("Compression:\t%s\n", status.Compression)
+	}
+	if status.EncryptionKeyID != "" {
+		d.Printf("Encryption Key ID:\t%s\n", status.EncryptionKeyID)
+	}
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
@@ -230,6 +261,21 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 		d.Printf("Completed:\t%s\n", status.CompletionTimestamp.Time)
 	}
 
+	if status.Phase == velerov1api.BackupPhaseInProgress {
+		d.Println()
+		if status.Progress == nil {
+			d.Printf("Estimated total items to be backed up:\t<n/a>\n")
+			d.Printf("Items backed up so far:\t<n/a>\n")
+		} else {
+			d.Printf("Estimated total items to be backed up:\t%d\n", status.Progress.TotalItems)
+			d.Printf("Items backed up so far:\t%d\n", status.Progress.ItemsBackedUp)
+		}
+	} else if status.Progress != nil {
+		d.Println()
+		d.Printf("Total items to be backed up:\t%d\n", status.Progress.TotalItems)
+		d.Printf("Items backed up:\t%d\n", status.Progress.ItemsBackedUp)
+	}
+
 	d.Println()
 	// Expiration can't be 0, it is always set to a 30-day default. It can be nil
 	// if the controller hasn't processed this Backup yet, in which case this will
@@ -237,8 +283,13 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 	d.Printf("Expiration:\t%s\n", status.Expiration)
 	d.Println()
 
+	if status.Verification != nil {
+		describeBackupVerification(d, status.Verification)
+		d.Println()
+	}
+
 	if details {
-		describeBackupResourceList(d, backup, veleroClient, insecureSkipTLSVerify)
+		describeBackupResourceList(d, backup, veleroClient, encryptionKey, insecureSkipTLSVerify)
 		d.Println()
 	}
 
@@ -249,7 +300,7 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 		}
 
 		buf := new(bytes.Buffer)
-		if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeSnapshots, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
+		if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupVolumeSnapshots, encryptionKey, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
 			d.Printf("Persistent Volumes:\t<error getting volume snapshot info: %v>\n", err)
 			return
 		}
@@ -270,9 +321,25 @@ func DescribeBackupStatus(d *Describer, backup *velerov1api.Backup, details bool
 	d.Printf("Persistent Volumes: <none included>\n")
 }
 
-func describeBackupResourceList(d *Describer, backup *velerov1api.Backup, veleroClient clientset.Interface, insecureSkipTLSVerify bool) {
+func describeBackupVerification(d *Describer, verification *velerov1api.BackupVerification) {
+	d.Printf("Verification:\t%s\n", verification.Phase)
+	if verification.StartTimestamp != nil {
+		d.Printf("\tStarted:\t%s\n", verification.StartTimestamp.Time)
+	}
+	if verification.CompletionTimestamp != nil {
+		d.Printf("\tCompleted:\t%s\n", verification.CompletionTimestamp.Time)
+	}
+	if len(verification.Errors) > 0 {
+		d.Printf("\tErrors:\n")
+		for _, err := range verification.Errors {
+			d.Printf("\t\t%s\n", err)
+		}
+	}
+}
+
+func describeBackupResourceList(d *Describer, backup *velerov1api.Backup, veleroClient clientset.Interface, encryptionKey []byte, insecureSkipTLSVerify bool) {
 	buf := new(bytes.Buffer)
-	if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, encryptionKey, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
 		if err == downloadrequest.ErrNotFound {
 			// the backup resource list could be missing if (other reasons may exist as well):
 			//	- the backup was taken prior to v1.1; or
@@ -306,6 +373,44 @@ func describeBackupResourceList(d *Describer, backup *velerov1api.Backup, velero
 	}
 }
 
+func describeCSIVolumeSnapshots(d *Describer, backup *velerov1api.Backup, details bool, veleroClient clientset.Interface, encryptionKey []byte, insecureSkipTLSVerify bool) {
+	if !details {
+		d.Printf("CSI Volume Snapshots:\t<specify --details for more information>\n")
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), backup.Namespace, backup.Name, velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots, encryptionKey, buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Printf("CSI Volume Snapshots:\t<none included>\n")
+		} else {
+			d.Printf("CSI Volume Snapshots:\t<error getting CSI volume snapshot info: %v>\n", err)
+		}
+		return
+	}
+
+	var snapshots []unstructured.Unstructured
+	if err := json.NewDecoder(buf).Decode(&snapshots); err != nil {
+		d.Printf("CSI Volume Snapshots:\t<error reading CSI volume snapshot info: %v>\n", err)
+		return
+	}
+
+	if len(snapshots) == 0 {
+		d.Printf("CSI Volume Snapshots:\t<none included>\n")
+		return
+	}
+
+	d.Printf("CSI Volume Snapshots:\n")
+	for _, vs := range snapshots {
+		pvcName, _, _ := unstructured.NestedString(vs.Object, "spec", "source", "persistentVolumeClaimName")
+		contentName, _, _ := unstructured.NestedString(vs.Object, "status", "boundVolumeSnapshotContentName")
+
+		d.Printf("\t%s/%s:\n", vs.GetNamespace(), vs.GetName())
+		d.Printf("\t\tPersistent Volume Claim:\t%s\n", pvcName)
+		d.Printf("\t\tVolume Snapshot Content:\t%s\n", contentName)
+	}
+}
+
 func describeSnapshot(d *Describer, pvName, snapshotID, volumeType, volumeAZ string, iops *int64) {
 	d.Printf("\t%s:\n", pvName)
 	d.Printf("\t\tSnapshot ID:\t%s\n", snapshotID)
@@ -98,6 +98,9 @@ func printBackup(backup *velerov1api.Backup) []metav1.TableRow {
 	if backup.DeletionTimestamp != nil && !backup.DeletionTimestamp.Time.IsZero() {
 		status = "Deleting"
 	}
+	if status == string(velerov1api.BackupPhaseInProgress) && backup.Status.Progress != nil {
+		status = fmt.Sprintf("%s (%d/%d items)", status, backup.Status.Progress.ItemsBackedUp, backup.Status.Progress.TotalItems)
+	}
 	if status == string(velerov1api.BackupPhasePartiallyFailed) {
 		if backup.Status.Errors == 1 {
 			status = fmt.Sprintf("%s (1 error)", status)
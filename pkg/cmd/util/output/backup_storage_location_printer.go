@@ -30,7 +30,10 @@ var (
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Provider"},
 		{Name: "Bucket/Prefix"},
+		{Name: "Phase"},
+		{Name: "Last Validated"},
 		{Name: "Access Mode"},
+		{Name: "Default"},
 	}
 )
 
@@ -53,16 +56,34 @@ func printBackupStorageLocation(location *v1.BackupStorageLocation) []metav1.Tab
 		bucketAndPrefix += "/" + location.Spec.ObjectStorage.Prefix
 	}
 
+	phase := string(location.Status.Phase)
+	if phase == "" {
+		phase = "Unknown"
+	}
+
+	lastValidated := "<never>"
+	if location.Status.LastValidationTime != nil {
+		lastValidated = location.Status.LastValidationTime.Time.String()
+	}
+
 	accessMode := location.Spec.AccessMode
 	if accessMode == "" {
 		accessMode = v1.BackupStorageLocationAccessModeReadWrite
 	}
 
+	isDefault := ""
+	if location.Spec.Default {
+		isDefault = "true"
+	}
+
 	row.Cells = append(row.Cells,
 		location.Name,
 		location.Spec.Provider,
 		bucketAndPrefix,
+		phase,
+		lastValidated,
 		accessMode,
+		isDefault,
 	)
 
 	return []metav1.TableRow{row}
@@ -31,7 +31,7 @@ import (
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 )
 
-func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestore, details bool, veleroClient clientset.Interface, insecureSkipTLSVerify bool) string {
+func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestore, details bool, veleroClient clientset.Interface, encryptionKey []byte, insecureSkipTLSVerify bool) string {
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(restore.ObjectMeta)
 
@@ -42,12 +42,21 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 		}
 
 		resultsNote := ""
-		if phase == v1.RestorePhaseFailed || phase == v1.RestorePhasePartiallyFailed {
+		switch phase {
+		case v1.RestorePhaseFailed, v1.RestorePhasePartiallyFailed, v1.RestorePhaseCanceled:
 			resultsNote = fmt.Sprintf(" (run 'velero restore logs %s' for more information)", restore.Name)
+		case v1.RestorePhaseInProgress:
+			if restore.Spec.Cancel {
+				resultsNote = " (cancellation requested)"
+			}
 		}
 
 		d.Printf("Phase:\t%s%s\n", restore.Status.Phase, resultsNote)
 
+		if restore.Status.FailureReason != "" {
+			d.Printf("Failure reason:\t%s\n", restore.Status.FailureReason)
+		}
+
 		if len(restore.Status.ValidationErrors) > 0 {
 			d.Println()
 			d.Printf("Validation errors:")
@@ -56,7 +65,19 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 			}
 		}
 
-		describeRestoreResults(d, restore, veleroClient, insecureSkipTLSVerify)
+		d.Println()
+		describeRestoreStatusTimesAndProgress(d, restore.Status)
+
+		if restore.Spec.WaitForReady && restore.Status.CompletionTimestamp != nil {
+			d.Printf("Unready items:\t%d\n", restore.Status.UnreadyItems)
+		}
+
+		describeRestoreResults(d, restore, veleroClient, encryptionKey, insecureSkipTLSVerify)
+
+		if restore.Spec.DryRun {
+			d.Println()
+			describeRestorePlan(d, restore, details, veleroClient, encryptionKey, insecureSkipTLSVerify)
+		}
 
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
@@ -107,6 +128,35 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 		d.Println()
 		d.Printf("Restore PVs:\t%s\n", BoolPointerString(restore.Spec.RestorePVs, "false", "true", "auto"))
 
+		existingResourcePolicy := string(restore.Spec.ExistingResourcePolicy)
+		if existingResourcePolicy == "" {
+			existingResourcePolicy = string(v1.PolicyTypeNone)
+		}
+		d.Printf("Existing Resource Policy:\t%s\n", existingResourcePolicy)
+
+		if restore.Spec.ResourceModifier != nil {
+			d.Printf("Resource Modifier:\t%s/%s\n", restore.Spec.ResourceModifier.Kind, restore.Spec.ResourceModifier.Name)
+		}
+
+		if restore.Spec.DryRun {
+			d.Printf("Dry Run:\ttrue\n")
+		}
+
+		if restore.Spec.ItemWorkers > 0 {
+			d.Printf("Item Workers:\t%d\n", restore.Spec.ItemWorkers)
+		}
+
+		if restore.Spec.WaitForReady {
+			readyTimeout := "<default>"
+			if restore.Spec.ReadyTimeout.Duration > 0 {
+				readyTimeout = restore.Spec.ReadyTimeout.Duration.String()
+			}
+			d.Printf("Wait For Ready:\ttrue (timeout %s)\n", readyTimeout)
+		}
+
+		d.Println()
+		describeRestoreHooks(d, restore.Spec.Hooks)
+
 		if len(podVolumeRestores) > 0 {
 			d.Println()
 			describePodVolumeRestores(d, podVolumeRestores, details)
@@ -114,7 +164,76 @@ func DescribeRestore(restore *v1.Restore, podVolumeRestores []v1.PodVolumeRestor
 	})
 }
 
-func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clientset.Interface, insecureSkipTLSVerify bool) {
+// describeRestoreHooks describes a restore's hooks in human-readable format.
+func describeRestoreHooks(d *Describer, hooks v1.RestoreHooks) {
+	if len(hooks.Resources) == 0 {
+		d.Printf("Hooks:\t<none>\n")
+		return
+	}
+
+	d.Printf("Hooks:\n")
+	d.Printf("\tResources:\n")
+	for _, hookSpec := range hooks.Resources {
+		d.Printf("\t\t%s:\n", hookSpec.Name)
+		d.Printf("\t\t\tNamespaces:\n")
+		var s string
+		if len(hookSpec.IncludedNamespaces) == 0 {
+			s = "*"
+		} else {
+			s = strings.Join(hookSpec.IncludedNamespaces, ", ")
+		}
+		d.Printf("\t\t\t\tIncluded:\t%s\n", s)
+		if len(hookSpec.ExcludedNamespaces) == 0 {
+			s = "<none>"
+		} else {
+			s = strings.Join(hookSpec.ExcludedNamespaces, ", ")
+		}
+		d.Printf("\t\t\t\tExcluded:\t%s\n", s)
+
+		d.Println()
+		d.Printf("\t\t\tResources:\n")
+		if len(hookSpec.IncludedResources) == 0 {
+			s = "*"
+		} else {
+			s = strings.Join(hookSpec.IncludedResources, ", ")
+		}
+		d.Printf("\t\t\t\tIncluded:\t%s\n", s)
+		if len(hookSpec.ExcludedResources) == 0 {
+			s = "<none>"
+		} else {
+			s = strings.Join(hookSpec.ExcludedResources, ", ")
+		}
+		d.Printf("\t\t\t\tExcluded:\t%s\n", s)
+
+		d.Println()
+		s = "<none>"
+		if hookSpec.LabelSelector != nil {
+			s = metav1.FormatLabelSelector(hookSpec.LabelSelector)
+		}
+		d.Printf("\t\t\tLabel selector:\t%s\n", s)
+
+		for _, hook := range hookSpec.PostHooks {
+			if hook.Init != nil {
+				d.Println()
+				d.Printf("\t\t\tInit Hook:\n")
+				for _, container := range hook.Init.InitContainers {
+					d.Printf("\t\t\t\t%s:\t%s %s\n", container.Name, container.Image, strings.Join(container.Command, " "))
+				}
+			}
+			if hook.Exec != nil {
+				d.Println()
+				d.Printf("\t\t\tExec Hook:\n")
+				d.Printf("\t\t\t\tContainer:\t%s\n", hook.Exec.Container)
+				d.Printf("\t\t\t\tCommand:\t%s\n", strings.Join(hook.Exec.Command, " "))
+				d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
+				d.Printf("\t\t\t\tExec Timeout:\t%s\n", hook.Exec.ExecTimeout.Duration)
+				d.Printf("\t\t\t\tWait Timeout:\t%s\n", hook.Exec.WaitTimeout.Duration)
+			}
+		}
+	}
+}
+
+func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clientset.Interface, encryptionKey []byte, insecureSkipTLSVerify bool) {
 	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
 		return
 	}
@@ -122,7 +241,7 @@ func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clie
 	var buf bytes.Buffer
 	var resultMap map[string]pkgrestore.Result
 
-	if err := downloadrequest.Stream(veleroClient.VeleroV1(), restore.Namespace, restore.Name, v1.DownloadTargetKindRestoreResults, &buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), restore.Namespace, restore.Name, v1.DownloadTargetKindRestoreResults, encryptionKey, &buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
 		d.Printf("Warnings:\t<error getting warnings: %v>\n\nErrors:\t<error getting errors: %v>\n", err, err)
 		return
 	}
@@ -142,6 +261,57 @@ func describeRestoreResults(d *Describer, restore *v1.Restore, veleroClient clie
 	}
 }
 
+// describeRestorePlan describes a dry-run restore's plan in human-readable format.
+func describeRestorePlan(d *Describer, restore *v1.Restore, details bool, veleroClient clientset.Interface, encryptionKey []byte, insecureSkipTLSVerify bool) {
+	if restore.Status.Phase != v1.RestorePhaseCompleted && restore.Status.Phase != v1.RestorePhasePartiallyFailed {
+		d.Printf("Dry Run Plan:\t<not available until the restore has finished>\n")
+		return
+	}
+
+	var buf bytes.Buffer
+	var plan pkgrestore.Plan
+
+	if err := downloadrequest.Stream(veleroClient.VeleroV1(), restore.Namespace, restore.Name, v1.DownloadTargetKindRestorePlan, encryptionKey, &buf, downloadRequestTimeout, insecureSkipTLSVerify); err != nil {
+		d.Printf("Dry Run Plan:\t<error getting plan: %v>\n", err)
+		return
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&plan); err != nil {
+		d.Printf("Dry Run Plan:\t<error decoding plan: %v>\n", err)
+		return
+	}
+
+	if !details {
+		d.Printf("Dry Run Plan (specify --details for more information):\n")
+		counts := make(map[pkgrestore.PlanAction]int)
+		for _, item := range plan.Items {
+			counts[item.Action]++
+		}
+		for _, action := range []pkgrestore.PlanAction{
+			pkgrestore.PlanActionCreate,
+			pkgrestore.PlanActionExistsIdentical,
+			pkgrestore.PlanActionExistsDifferent,
+			pkgrestore.PlanActionSkippedByAction,
+		} {
+			d.Printf("\t%s:\t%d\n", action, counts[action])
+		}
+		return
+	}
+
+	d.Printf("Dry Run Plan:\n")
+	if len(plan.Items) == 0 {
+		d.Printf("\t<none>\n")
+		return
+	}
+	for _, item := range plan.Items {
+		name := item.Name
+		if item.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+		d.Printf("\t%s %s:\t%s\n", item.Resource, name, item.Action)
+	}
+}
+
 func describeRestoreResult(d *Describer, name string, result pkgrestore.Result) {
 	d.Printf("%s:\n", name)
 	d.DescribeSlice(1, "Velero", result.Velero)
@@ -219,3 +389,29 @@ func groupRestoresByPhase(restores []v1.PodVolumeRestore) map[string][]v1.PodVol
 
 	return restoresByPhase
 }
+
+func describeRestoreStatusTimesAndProgress(d *Describer, status v1.RestoreStatus) {
+	if status.StartTimestamp == nil || status.StartTimestamp.Time.IsZero() {
+		d.Printf("Started:\t%s\n", "<n/a>")
+	} else {
+		d.Printf("Started:\t%s\n", status.StartTimestamp.Time)
+	}
+	if status.CompletionTimestamp == nil || status.CompletionTimestamp.Time.IsZero() {
+		d.Printf("Completed:\t%s\n", "<n/a>")
+	} else {
+		d.Printf("Completed:\t%s\n", status.CompletionTimestamp.Time)
+	}
+
+	if status.Progress == nil {
+		return
+	}
+
+	d.Println()
+	if status.Phase == v1.RestorePhaseInProgress {
+		d.Printf("Estimated total items to be restored:\t%d\n", status.Progress.TotalItems)
+		d.Printf("Items restored so far:\t%d\n", status.Progress.ItemsRestored)
+	} else {
+		d.Printf("Total items to be restored:\t%d\n", status.Progress.TotalItems)
+		d.Printf("Items restored:\t%d\n", status.Progress.ItemsRestored)
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package output
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -30,6 +32,8 @@ var (
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Backup"},
 		{Name: "Status"},
+		{Name: "Started"},
+		{Name: "Completed"},
 		{Name: "Warnings"},
 		{Name: "Errors"},
 		{Name: "Created"},
@@ -51,15 +55,20 @@ func printRestore(restore *v1.Restore) []metav1.TableRow {
 		Object: runtime.RawExtension{Object: restore},
 	}
 
-	status := restore.Status.Phase
+	status := string(restore.Status.Phase)
 	if status == "" {
-		status = v1.RestorePhaseNew
+		status = string(v1.RestorePhaseNew)
+	}
+	if status == string(v1.RestorePhaseInProgress) && restore.Status.Progress != nil {
+		status = fmt.Sprintf("%s (%d/%d items)", status, restore.Status.Progress.ItemsRestored, restore.Status.Progress.TotalItems)
 	}
 
 	row.Cells = append(row.Cells,
 		restore.Name,
 		restore.Spec.BackupName,
 		status,
+		restore.Status.StartTimestamp,
+		restore.Status.CompletionTimestamp,
 		restore.Status.Warnings,
 		restore.Status.Errors,
 		restore.CreationTimestamp.Time,
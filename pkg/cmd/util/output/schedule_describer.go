@@ -18,11 +18,16 @@ package output
 
 import (
 	"fmt"
+	"strings"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/retention"
 )
 
-func DescribeSchedule(schedule *v1.Schedule) string {
+// DescribeSchedule describes a schedule. If the schedule has a retention
+// policy, the schedule's backups are used to show which of them the policy
+// keeps and which it prunes.
+func DescribeSchedule(schedule *v1.Schedule, backups []*v1.Backup) string {
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(schedule.ObjectMeta)
 
@@ -47,12 +52,21 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 		d.Println()
 		DescribeScheduleStatus(d, schedule.Status)
+
+		if retention.Enabled(schedule.Spec.Retention) {
+			d.Println()
+			DescribeScheduleRetention(d, retention.Evaluate(schedule.Spec.Retention, backups))
+		}
 	})
 }
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
+	d.Printf("Paused:\t%t\n", spec.Paused)
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
 
+	d.Println()
+	describeRetentionPolicy(d, spec.Retention)
+
 	d.Println()
 	d.Println("Backup Template:")
 	d.Prefix = "\t"
@@ -67,3 +81,59 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
 }
+
+func describeRetentionPolicy(d *Describer, policy *v1.RetentionPolicy) {
+	if !retention.Enabled(policy) {
+		d.Printf("Retention Policy:\t<none>\n")
+		return
+	}
+
+	d.Println("Retention Policy:")
+	for _, rule := range []struct {
+		name  string
+		count int
+	}{
+		{"Keep Last", policy.KeepLast},
+		{"Keep Hourly", policy.KeepHourly},
+		{"Keep Daily", policy.KeepDaily},
+		{"Keep Weekly", policy.KeepWeekly},
+		{"Keep Monthly", policy.KeepMonthly},
+		{"Keep Yearly", policy.KeepYearly},
+	} {
+		if rule.count > 0 {
+			d.Printf("\t%s:\t%d\n", rule.name, rule.count)
+		}
+	}
+}
+
+// DescribeScheduleRetention describes which of a schedule's backups its
+// retention policy keeps, and which it prunes.
+func DescribeScheduleRetention(d *Describer, decisions []retention.Decision) {
+	var kept, pruned []retention.Decision
+	for _, decision := range decisions {
+		if decision.Keep() {
+			kept = append(kept, decision)
+		} else {
+			pruned = append(pruned, decision)
+		}
+	}
+
+	if len(pruned) == 0 {
+		d.Printf("Backups To Prune:\t<none>\n")
+	} else {
+		d.Println("Backups To Prune:")
+		for _, decision := range pruned {
+			d.Printf("\t%s\n", decision.Backup.Name)
+		}
+	}
+
+	d.Println()
+	if len(kept) == 0 {
+		d.Printf("Backups To Keep:\t<none>\n")
+	} else {
+		d.Println("Backups To Keep:")
+		for _, decision := range kept {
+			d.Printf("\t%s:\t%s\n", decision.Backup.Name, strings.Join(decision.Reasons, ", "))
+		}
+	}
+}
@@ -19,11 +19,13 @@ package controller
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -39,6 +41,9 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	"github.com/vmware-tanzu/velero/pkg/encryption"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -46,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -68,9 +74,21 @@ type backupController struct {
 	defaultBackupTTL         time.Duration
 	snapshotLocationLister   listers.VolumeSnapshotLocationLister
 	defaultSnapshotLocations map[string]string
+	defaultVolumesToRestic   bool
+	defaultItemWorkers       int
+	backupCompression        velerov1api.BackupCompression
+	encryptionKeyID          string
 	metrics                  *metrics.ServerMetrics
-	newBackupStore           func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	newBackupStore           func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore      credentials.FileStore
 	formatFlag               logging.Format
+	podVolumeBackupClient    velerov1client.PodVolumeBackupsGetter
+	serverInstanceID         string
+
+	// cancelFuncs holds the functions that cancel the backups being run by
+	// this controller, keyed by namespace/name.
+	cancelLock  sync.Mutex
+	cancelFuncs map[string]context.CancelFunc
 }
 
 func NewBackupController(
@@ -80,14 +98,21 @@ func NewBackupController(
 	logger logrus.FieldLogger,
 	backupLogLevel logrus.Level,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	backupTracker BackupTracker,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	defaultBackupLocation string,
 	defaultBackupTTL time.Duration,
 	volumeSnapshotLocationInformer informers.VolumeSnapshotLocationInformer,
 	defaultSnapshotLocations map[string]string,
+	defaultVolumesToRestic bool,
+	defaultItemWorkers int,
+	backupCompression velerov1api.BackupCompression,
+	encryptionKeyID string,
 	metrics *metrics.ServerMetrics,
 	formatFlag logging.Format,
+	podVolumeBackupClient velerov1client.PodVolumeBackupsGetter,
+	serverInstanceID string,
 ) Interface {
 	c := &backupController{
 		genericController:        newGenericController("backup", logger),
@@ -97,14 +122,21 @@ func NewBackupController(
 		clock:                    &clock.RealClock{},
 		backupLogLevel:           backupLogLevel,
 		newPluginManager:         newPluginManager,
+		credentialFileStore:      credentialFileStore,
 		backupTracker:            backupTracker,
 		backupLocationLister:     backupLocationInformer.Lister(),
 		defaultBackupLocation:    defaultBackupLocation,
 		defaultBackupTTL:         defaultBackupTTL,
 		snapshotLocationLister:   volumeSnapshotLocationInformer.Lister(),
 		defaultSnapshotLocations: defaultSnapshotLocations,
+		defaultVolumesToRestic:   defaultVolumesToRestic,
+		defaultItemWorkers:       defaultItemWorkers,
+		backupCompression:        backupCompression,
+		encryptionKeyID:          encryptionKeyID,
 		metrics:                  metrics,
 		formatFlag:               formatFlag,
+		podVolumeBackupClient:    podVolumeBackupClient,
+		serverInstanceID:         serverInstanceID,
 
 		newBackupStore: persistence.NewObjectBackupStore,
 	}
@@ -123,9 +155,12 @@ func NewBackupController(
 			AddFunc: func(obj interface{}) {
 				backup := obj.(*velerov1api.Backup)
 
-				switch backup.Status.Phase {
-				case "", velerov1api.BackupPhaseNew:
-					// only process new backups
+				switch {
+				case backup.Status.Phase == "", backup.Status.Phase == velerov1api.BackupPhaseNew:
+					// process new backups
+				case c.isOrphaned(backup):
+					// process backups left InProgress by a previous server instance
+					// so they can be marked as failed
 				default:
 					c.logger.WithFields(logrus.Fields{
 						"backup": kubeutil.NamespaceAndName(backup),
@@ -141,12 +176,67 @@ func NewBackupController(
 				}
 				c.queue.Add(key)
 			},
+			UpdateFunc: func(_, obj interface{}) {
+				backup := obj.(*velerov1api.Backup)
+				if !backup.Spec.Cancel {
+					return
+				}
+
+				key, err := cache.MetaNamespaceKeyFunc(backup)
+				if err != nil {
+					c.logger.WithError(err).WithField("backup", backup).Error("Error creating queue key, backup can't be canceled")
+					return
+				}
+
+				if backup.Status.Phase == "" || backup.Status.Phase == velerov1api.BackupPhaseNew {
+					// the backup hasn't started yet, so process it now to
+					// mark it as canceled.
+					c.queue.Add(key)
+					return
+				}
+
+				c.cancelBackup(key)
+			},
 		},
 	)
 
 	return c
 }
 
+// trackCancelFunc records the function that cancels the running backup
+// identified by key.
+func (c *backupController) trackCancelFunc(key string, cancel context.CancelFunc) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	if c.cancelFuncs == nil {
+		c.cancelFuncs = make(map[string]context.CancelFunc)
+	}
+	c.cancelFuncs[key] = cancel
+}
+
+// untrackCancelFunc removes the cancel function for the backup identified
+// by key once it's no longer running.
+func (c *backupController) untrackCancelFunc(key string) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	delete(c.cancelFuncs, key)
+}
+
+// cancelBackup cancels the backup identified by key if this controller is
+// running it.
+func (c *backupController) cancelBackup(key string) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	if cancel, ok := c.cancelFuncs[key]; ok {
+		c.logger.WithField("backup", key).Info("Canceling backup")
+		cancel()
+		delete(c.cancelFuncs, key)
+	}
+}
+
 func (c *backupController) resync() {
 	// recompute backup_total metric
 	backups, err := c.lister.List(labels.Everything())
@@ -216,21 +306,36 @@ func (c *backupController) processBackup(key string) error {
 	// informer sees the update. In the latter case, after the informer has seen the update to
 	// InProgress, we still need this check so we can return nil to indicate we've finished processing
 	// this key (even though it was a no-op).
-	switch original.Status.Phase {
-	case "", velerov1api.BackupPhaseNew:
-		// only process new backups
+	switch {
+	case original.Status.Phase == "", original.Status.Phase == velerov1api.BackupPhaseNew:
+		// process new backups
+	case c.isOrphaned(original):
+		return c.failOrphanedBackup(original, log)
 	default:
 		return nil
 	}
 
+	if original.Spec.Cancel {
+		return c.cancelNewBackup(original, log)
+	}
+
 	log.Debug("Preparing backup request")
 	request := c.prepareBackupRequest(original)
 
+	// register the backup's cancel func before it's marked as InProgress,
+	// so that it can be canceled as soon as the update is observed.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	request.Context = ctx
+	c.trackCancelFunc(key, cancel)
+	defer c.untrackCancelFunc(key)
+
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
 		request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		metav1.SetMetaDataAnnotation(&request.ObjectMeta, velerov1api.ServerInstanceIDAnnotation, c.serverInstanceID)
 	}
 
 	// update status
@@ -246,6 +351,11 @@ func (c *backupController) processBackup(key string) error {
 		return nil
 	}
 
+	// the backup may have been canceled before the cancel func was registered.
+	if updatedBackup.Spec.Cancel {
+		cancel()
+	}
+
 	c.backupTracker.Add(request.Namespace, request.Name)
 	defer c.backupTracker.Delete(request.Namespace, request.Name)
 
@@ -266,6 +376,10 @@ func (c *backupController) processBackup(key string) error {
 		request.Status.Phase = velerov1api.BackupPhaseFailed
 	}
 
+	if request.Status.Phase == velerov1api.BackupPhaseCanceled {
+		c.failPendingPodVolumeBackups(request.Backup, "the backup was canceled", log)
+	}
+
 	switch request.Status.Phase {
 	case velerov1api.BackupPhaseCompleted:
 		c.metrics.RegisterBackupSuccess(backupScheduleName)
@@ -283,6 +397,119 @@ func (c *backupController) processBackup(key string) error {
 	return nil
 }
 
+// isOrphaned returns true if the backup is InProgress but wasn't started by
+// this instance of the server, meaning the server that was processing it
+// exited before it finished.
+func (c *backupController) isOrphaned(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseInProgress &&
+		backup.Annotations[velerov1api.ServerInstanceIDAnnotation] != c.serverInstanceID
+}
+
+// failOrphanedBackup marks a backup that was left InProgress by a previous
+// server instance as Failed. Any data the previous instance uploaded to object
+// storage is deleted, since it's incomplete, and the backup's restic pod volume
+// backups that are still pending are marked as failed so that they're not
+// left waiting forever.
+func (c *backupController) failOrphanedBackup(original *velerov1api.Backup, log logrus.FieldLogger) error {
+	previousInstance := original.Annotations[velerov1api.ServerInstanceIDAnnotation]
+	if previousInstance == "" {
+		previousInstance = "unknown"
+	}
+	log = log.WithField("previousServerInstance", previousInstance)
+	log.Warn("Backup was left in progress by a previous server instance, marking it as failed")
+
+	c.deleteOrphanedBackupData(original, log)
+	c.failPendingPodVolumeBackups(original, "the backup was marked as failed because the Velero server processing it exited", log)
+
+	backup := original.DeepCopy()
+	backup.Status.Phase = velerov1api.BackupPhaseFailed
+	backup.Status.FailureReason = fmt.Sprintf("the Velero server instance processing the backup (%s) exited before the backup completed", previousInstance)
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	metav1.SetMetaDataAnnotation(&backup.ObjectMeta, velerov1api.ServerInstanceIDAnnotation, c.serverInstanceID)
+
+	if _, err := patchBackup(original, backup, c.client); err != nil {
+		return errors.Wrap(err, "error marking orphaned backup as failed")
+	}
+
+	c.metrics.RegisterBackupFailed(backup.Labels[velerov1api.ScheduleNameLabel])
+
+	return nil
+}
+
+// deleteOrphanedBackupData deletes the partial contents of an orphaned backup
+// from its storage location. Errors are logged rather than returned, since the
+// backup should be marked as failed regardless.
+func (c *backupController) deleteOrphanedBackupData(backup *velerov1api.Backup, log logrus.FieldLogger) {
+	location, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(backup.Spec.StorageLocation)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error getting backup storage location, unable to delete partial backup data")
+		return
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Warn("Backup storage location is read-only, unable to delete partial backup data")
+		return
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(location, pluginManager, c.credentialFileStore, log)
+	if err != nil {
+		log.WithError(err).Error("Error getting backup store, unable to delete partial backup data")
+		return
+	}
+
+	if err := backupStore.DeleteBackup(backup.Name); err != nil {
+		log.WithError(err).Error("Error deleting partial backup data")
+	}
+}
+
+// failPendingPodVolumeBackups marks the backup's pod volume backups that are
+// still New or InProgress as Failed, with the given message. Errors are logged
+// rather than returned.
+func (c *backupController) failPendingPodVolumeBackups(backup *velerov1api.Backup, message string, log logrus.FieldLogger) {
+	podVolumeBackups, err := c.podVolumeBackupClient.PodVolumeBackups(backup.Namespace).List(restic.NewPodVolumeBackupListOptions(backup.Name))
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing pod volume backups")
+		return
+	}
+
+	for i := range podVolumeBackups.Items {
+		pvb := &podVolumeBackups.Items[i]
+
+		switch pvb.Status.Phase {
+		case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseInProgress:
+			// still pending, so nothing else will ever complete it
+		default:
+			continue
+		}
+
+		pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseFailed
+		pvb.Status.Message = message
+		pvb.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if _, err := c.podVolumeBackupClient.PodVolumeBackups(pvb.Namespace).Update(pvb); err != nil {
+			log.WithError(errors.WithStack(err)).WithField("podVolumeBackup", pvb.Name).Error("Error marking pod volume backup as failed")
+		}
+	}
+}
+
+// cancelNewBackup marks a backup that was canceled before it started as
+// Canceled.
+func (c *backupController) cancelNewBackup(original *velerov1api.Backup, log logrus.FieldLogger) error {
+	log.Info("Backup was canceled before it started")
+
+	backup := original.DeepCopy()
+	backup.Status.Phase = velerov1api.BackupPhaseCanceled
+	backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	if _, err := patchBackup(original, backup, c.client); err != nil {
+		return errors.Wrapf(err, "error updating Backup status to %s", backup.Status.Phase)
+	}
+
+	return nil
+}
+
 func patchBackup(original, updated *velerov1api.Backup, client velerov1client.BackupsGetter) (*velerov1api.Backup, error) {
 	origBytes, err := json.Marshal(original)
 	if err != nil {
@@ -315,6 +542,11 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 	// set backup version
 	request.Status.Version = pkgbackup.BackupVersion
 
+	// record how the backup's data is compressed and encrypted so that it
+	// can be read back even if the server's settings change
+	request.Status.Compression = c.backupCompression
+	request.Status.EncryptionKeyID = c.encryptionKeyID
+
 	if request.Spec.TTL.Duration == 0 {
 		// set default backup TTL
 		request.Spec.TTL.Duration = c.defaultBackupTTL
@@ -325,7 +557,21 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 
 	// default storage location if not specified
 	if request.Spec.StorageLocation == "" {
-		request.Spec.StorageLocation = c.defaultBackupLocation
+		locations, err := c.backupLocationLister.BackupStorageLocations(request.Namespace).List(labels.Everything())
+		if err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error listing backup storage locations: %v", err))
+		}
+		request.Spec.StorageLocation = defaultBackupLocationName(locations, c.defaultBackupLocation)
+	}
+
+	// default whether to use restic for all pod volumes if not specified
+	if request.Spec.DefaultVolumesToRestic == nil {
+		request.Spec.DefaultVolumesToRestic = &c.defaultVolumesToRestic
+	}
+
+	// default the number of item workers if not specified
+	if request.Spec.ItemWorkers == 0 {
+		request.Spec.ItemWorkers = c.defaultItemWorkers
 	}
 
 	// add the storage location as a label for easy filtering later.
@@ -358,6 +604,19 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
+
+		if request.StorageLocation.Status.Phase == velerov1api.BackupStorageLocationPhaseUnavailable {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("backup can't be created because backup storage location %s is unavailable: %s", request.StorageLocation.Name, request.StorageLocation.Status.Message))
+		}
+	}
+
+	// make sure the encryption key exists before doing any work, rather
+	// than failing once the backup's data is ready to be uploaded
+	if request.Status.EncryptionKeyID != "" {
+		if _, err := encryption.GetKeyFromFileStore(c.credentialFileStore, request.Status.EncryptionKeyID); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting backup encryption key: %v", err))
+		}
 	}
 
 	// validate and get the backup's VolumeSnapshotLocations, and store the
@@ -377,11 +636,11 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
-// - each location name in .spec.volumeSnapshotLocations exists as a location
-// - exactly 1 location per provider
-// - a given provider's default location name is added to .spec.volumeSnapshotLocations if one
-//   is not explicitly specified for the provider (if there's only one location for the provider,
-//   it will automatically be used)
+//   - each location name in .spec.volumeSnapshotLocations exists as a location
+//   - exactly 1 location per provider
+//   - a given provider's default location name is added to .spec.volumeSnapshotLocations if one
+//     is not explicitly specified for the provider (if there's only one location for the provider,
+//     it will automatically be used)
 func (c *backupController) validateAndGetSnapshotLocations(backup *velerov1api.Backup) (map[string]*velerov1api.VolumeSnapshotLocation, []string) {
 	errors := []string{}
 	providerLocations := make(map[string]*velerov1api.VolumeSnapshotLocation)
@@ -509,7 +768,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	}
 
 	backupLog.Info("Setting up backup store")
-	backupStore, err := c.newBackupStore(backup.StorageLocation, pluginManager, backupLog)
+	backupStore, err := c.newBackupStore(backup.StorageLocation, pluginManager, c.credentialFileStore, backupLog)
 	if err != nil {
 		return err
 	}
@@ -528,6 +787,7 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	if err := c.backupper.Backup(backupLog, backup, backupFile, actions, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
+	canceled := backup.Context != nil && backup.Context.Err() != nil
 
 	// Mark completion timestamp before serializing and uploading.
 	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
@@ -554,6 +814,8 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	// artifacts to object storage so that the JSON representation of the
 	// backup in object storage has the terminal phase set.
 	switch {
+	case canceled:
+		backup.Status.Phase = velerov1api.BackupPhaseCanceled
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
@@ -594,11 +856,6 @@ func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, bac
 
 func persistBackup(backup *pkgbackup.Request, backupContents, backupLog *os.File, backupStore persistence.BackupStore, log logrus.FieldLogger) []error {
 	errs := []error{}
-	backupJSON := new(bytes.Buffer)
-
-	if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
-		errs = append(errs, errors.Wrap(err, "error encoding backup"))
-	}
 
 	volumeSnapshots := new(bytes.Buffer)
 	gzw := gzip.NewWriter(volumeSnapshots)
@@ -630,23 +887,58 @@ func persistBackup(backup *pkgbackup.Request, backupContents, backupLog *os.File
 		errs = append(errs, errors.Wrap(err, "error closing gzip writer"))
 	}
 
+	var csiSnapshots, csiSnapshotContents io.Reader
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		buf, encodeErrs := encodeToJSONGzip(backup.CSISnapshots, "CSI volume snapshots")
+		errs = append(errs, encodeErrs...)
+		csiSnapshots = buf
+
+		buf, encodeErrs = encodeToJSONGzip(backup.CSISnapshotContents, "CSI volume snapshot contents")
+		errs = append(errs, encodeErrs...)
+		csiSnapshotContents = buf
+	}
+
 	if len(errs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
-		backupJSON = nil
 		backupContents = nil
 		volumeSnapshots = nil
 		backupResourceList = nil
+		csiSnapshots = nil
+		csiSnapshotContents = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
-		Name:               backup.Name,
-		Metadata:           backupJSON,
-		Contents:           backupContents,
-		Log:                backupLog,
-		PodVolumeBackups:   podVolumeBackups,
-		VolumeSnapshots:    volumeSnapshots,
-		BackupResourceList: backupResourceList,
+		Name:                      backup.Name,
+		EncryptionKeyID:           backup.Status.EncryptionKeyID,
+		Contents:                  backupContents,
+		Log:                       backupLog,
+		PodVolumeBackups:          podVolumeBackups,
+		VolumeSnapshots:           volumeSnapshots,
+		BackupResourceList:        backupResourceList,
+		CSIVolumeSnapshots:        csiSnapshots,
+		CSIVolumeSnapshotContents: csiSnapshotContents,
+	}
+
+	// the backup's metadata is encoded last, since it records the checksums
+	// of the other files. If there's no metadata, only the log is uploaded.
+	if len(errs) == 0 {
+		checksums, err := persistence.ComputeChecksums(&backupInfo)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "error computing checksums"))
+		} else {
+			backup.Status.Checksums = checksums
+		}
 	}
+
+	if len(errs) == 0 {
+		backupJSON := new(bytes.Buffer)
+		if err := encode.EncodeTo(backup.Backup, "json", backupJSON); err != nil {
+			errs = append(errs, errors.Wrap(err, "error encoding backup"))
+		} else {
+			backupInfo.Metadata = backupJSON
+		}
+	}
+
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		errs = append(errs, err)
 	}
@@ -654,6 +946,23 @@ func persistBackup(backup *pkgbackup.Request, backupContents, backupLog *os.File
 	return errs
 }
 
+// encodeToJSONGzip encodes the provided data to gzipped JSON, returning
+// a slice of any errors that were encountered.
+func encodeToJSONGzip(data interface{}, desc string) (*bytes.Buffer, []error) {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+
+	var errs []error
+	if err := json.NewEncoder(gzw).Encode(data); err != nil {
+		errs = append(errs, errors.Wrapf(err, "error encoding %s", desc))
+	}
+	if err := gzw.Close(); err != nil {
+		errs = append(errs, errors.Wrap(err, "error closing gzip writer"))
+	}
+
+	return buf, errs
+}
+
 func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	if err := file.Close(); err != nil {
 		log.WithError(err).WithField("file", file.Name()).Error("error closing file")
@@ -36,6 +36,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -44,6 +45,8 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
 
@@ -80,9 +83,12 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 			backup: defaultBackup().Phase(velerov1api.BackupPhaseFailedValidation).Result(),
 		},
 		{
-			name:   "InProgress backup is not processed",
-			key:    "velero/backup-1",
-			backup: defaultBackup().Phase(velerov1api.BackupPhaseInProgress).Result(),
+			name: "InProgress backup started by this server instance is not processed",
+			key:  "velero/backup-1",
+			backup: defaultBackup().
+				ObjectMeta(builder.WithAnnotations(velerov1api.ServerInstanceIDAnnotation, "server-1")).
+				Phase(velerov1api.BackupPhaseInProgress).
+				Result(),
 		},
 		{
 			name:   "Completed backup is not processed",
@@ -108,6 +114,7 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 				genericController: newGenericController("backup-test", logger),
 				lister:            sharedInformers.Velero().V1().Backups().Lister(),
 				formatFlag:        formatFlag,
+				serverInstanceID:  "server-1",
 			}
 
 			if test.backup != nil {
@@ -125,14 +132,213 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 	}
 }
 
+func TestProcessBackupOrphaned(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Bucket("store-1").Result()
+
+	tests := []struct {
+		name                  string
+		backup                *velerov1api.Backup
+		expectedFailureReason string
+	}{
+		{
+			name: "backup started by a previous server instance is marked as failed",
+			backup: defaultBackup().
+				ObjectMeta(builder.WithAnnotations(velerov1api.ServerInstanceIDAnnotation, "server-0")).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseInProgress).
+				Result(),
+			expectedFailureReason: "the Velero server instance processing the backup (server-0) exited before the backup completed",
+		},
+		{
+			name:                  "backup without a server instance ID is marked as failed",
+			backup:                defaultBackup().StorageLocation("loc-1").Phase(velerov1api.BackupPhaseInProgress).Result(),
+			expectedFailureReason: "the Velero server instance processing the backup (unknown) exited before the backup completed",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				pvbs = []*velerov1api.PodVolumeBackup{
+					builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-new").
+						ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+						Phase(velerov1api.PodVolumeBackupPhaseNew).
+						Result(),
+					builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-in-progress").
+						ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+						Phase(velerov1api.PodVolumeBackupPhaseInProgress).
+						Result(),
+					builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-completed").
+						ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+						Phase(velerov1api.PodVolumeBackupPhaseCompleted).
+						Result(),
+					builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-other-backup").
+						ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-2")).
+						Phase(velerov1api.PodVolumeBackupPhaseInProgress).
+						Result(),
+				}
+				clientset       = fake.NewSimpleClientset(test.backup, pvbs[0], pvbs[1], pvbs[2], pvbs[3])
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = velerotest.NewLogger()
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+			)
+
+			c := &backupController{
+				genericController:     newGenericController("backup-test", logger),
+				client:                clientset.VeleroV1(),
+				podVolumeBackupClient: clientset.VeleroV1(),
+				lister:                sharedInformers.Velero().V1().Backups().Lister(),
+				backupLocationLister:  sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				metrics:               metrics.NewServerMetrics(),
+				clock:                 clock.NewFakeClock(now),
+				newPluginManager:      func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				newBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
+					return backupStore, nil
+				},
+				serverInstanceID: "server-1",
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore.On("DeleteBackup", "backup-1").Return(nil)
+
+			require.NoError(t, c.processBackup("velero/backup-1"))
+
+			res, err := clientset.VeleroV1().Backups(velerov1api.DefaultNamespace).Get("backup-1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, velerov1api.BackupPhaseFailed, res.Status.Phase)
+			assert.Equal(t, test.expectedFailureReason, res.Status.FailureReason)
+			assert.Equal(t, "server-1", res.Annotations[velerov1api.ServerInstanceIDAnnotation])
+			require.NotNil(t, res.Status.CompletionTimestamp)
+			assert.True(t, now.Equal(res.Status.CompletionTimestamp.Time))
+
+			backupStore.AssertExpectations(t)
+
+			expectedPhases := map[string]velerov1api.PodVolumeBackupPhase{
+				"pvb-new":          velerov1api.PodVolumeBackupPhaseFailed,
+				"pvb-in-progress":  velerov1api.PodVolumeBackupPhaseFailed,
+				"pvb-completed":    velerov1api.PodVolumeBackupPhaseCompleted,
+				"pvb-other-backup": velerov1api.PodVolumeBackupPhaseInProgress,
+			}
+			for name, phase := range expectedPhases {
+				pvb, err := clientset.VeleroV1().PodVolumeBackups(velerov1api.DefaultNamespace).Get(name, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, phase, pvb.Status.Phase, name)
+			}
+		})
+	}
+}
+
+func TestProcessBackupCanceledBeforeStarting(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	var (
+		backup          = defaultBackup().Phase(velerov1api.BackupPhaseNew).Cancel(true).Result()
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+	)
+
+	c := &backupController{
+		genericController: newGenericController("backup-test", velerotest.NewLogger()),
+		client:            clientset.VeleroV1(),
+		lister:            sharedInformers.Velero().V1().Backups().Lister(),
+		clock:             clock.NewFakeClock(now),
+	}
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+
+	// a backupper isn't set up, so this would panic if the backup were run.
+	require.NoError(t, c.processBackup("velero/backup-1"))
+
+	res, err := clientset.VeleroV1().Backups(velerov1api.DefaultNamespace).Get("backup-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCanceled, res.Status.Phase)
+	require.NotNil(t, res.Status.CompletionTimestamp)
+	assert.True(t, now.Equal(res.Status.CompletionTimestamp.Time))
+}
+
+func TestProcessBackupCanceledWhileRunning(t *testing.T) {
+	var (
+		location = builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Bucket("store-1").Result()
+		backup   = defaultBackup().StorageLocation("loc-1").Result()
+		pvb      = builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+				ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+				Phase(velerov1api.PodVolumeBackupPhaseInProgress).
+				Result()
+		clientset       = fake.NewSimpleClientset(backup, pvb)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = velerotest.NewLogger()
+		pluginManager   = new(pluginmocks.Manager)
+		backupStore     = new(persistencemocks.BackupStore)
+		backupper       = new(fakeBackupper)
+	)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		client:                 clientset.VeleroV1(),
+		podVolumeBackupClient:  clientset.VeleroV1(),
+		lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+		backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  location.Name,
+		backupTracker:          NewBackupTracker(),
+		metrics:                metrics.NewServerMetrics(),
+		clock:                  clock.NewFakeClock(time.Now()),
+		newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		newBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
+			return backupStore, nil
+		},
+		backupper:  backupper,
+		formatFlag: logging.FormatText,
+	}
+
+	pluginManager.On("GetBackupItemActions").Return(nil, nil)
+	pluginManager.On("CleanupClients").Return(nil)
+	backupStore.On("BackupExists", "store-1", "backup-1").Return(false, nil)
+	backupStore.On("PutBackup", mock.Anything).Return(nil)
+
+	// cancel the backup while it's running, the same way the informer's
+	// update handler does when the backup's cancel flag is set.
+	backupper.On("Backup", mock.Anything, mock.Anything, mock.Anything, []velero.BackupItemAction(nil), pluginManager).
+		Run(func(mock.Arguments) { c.cancelBackup("velero/backup-1") }).
+		Return(nil)
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+
+	require.NoError(t, c.processBackup("velero/backup-1"))
+
+	res, err := clientset.VeleroV1().Backups(velerov1api.DefaultNamespace).Get("backup-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCanceled, res.Status.Phase)
+
+	// the backup's log and partial contents are still uploaded.
+	backupStore.AssertCalled(t, "PutBackup", mock.Anything)
+
+	resPVB, err := clientset.VeleroV1().PodVolumeBackups(velerov1api.DefaultNamespace).Get("pvb-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.PodVolumeBackupPhaseFailed, resPVB.Status.Phase)
+	assert.Equal(t, "the backup was canceled", resPVB.Status.Message)
+
+	assert.Empty(t, c.cancelFuncs)
+}
+
 func TestProcessBackupValidationFailures(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Result()
 
 	tests := []struct {
-		name           string
-		backup         *velerov1api.Backup
-		backupLocation *velerov1api.BackupStorageLocation
-		expectedErrs   []string
+		name            string
+		backup          *velerov1api.Backup
+		backupLocation  *velerov1api.BackupStorageLocation
+		encryptionKeyID string
+		expectedErrs    []string
 	}{
 		{
 			name:           "invalid included/excluded resources fails validation",
@@ -157,6 +363,19 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:           "backup for unavailable backup location fails validation",
+			backup:         defaultBackup().StorageLocation("unavailable").Result(),
+			backupLocation: builder.ForBackupStorageLocation("velero", "unavailable").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Message("error writing probe object: access denied").Result(),
+			expectedErrs:   []string{"backup can't be created because backup storage location unavailable is unavailable: error writing probe object: access denied"},
+		},
+		{
+			name:            "missing encryption key fails validation",
+			backup:          defaultBackup().StorageLocation(defaultBackupLocation.Name).Result(),
+			backupLocation:  defaultBackupLocation,
+			encryptionKeyID: "key-1",
+			expectedErrs:    []string{`error getting backup encryption key: error getting encryption key "key-1": secret not found`},
+		},
 	}
 
 	for _, test := range tests {
@@ -175,6 +394,8 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  defaultBackupLocation.Name,
+				encryptionKeyID:        test.encryptionKeyID,
+				credentialFileStore:    velerotest.NewFakeCredentialsFileStore("", errors.New("secret not found")),
 				clock:                  &clock.RealClock{},
 				formatFlag:             formatFlag,
 			}
@@ -254,6 +475,84 @@ func TestBackupLocationLabel(t *testing.T) {
 	}
 }
 
+func TestDefaultBackupLocation(t *testing.T) {
+	tests := []struct {
+		name                   string
+		backup                 *velerov1api.Backup
+		backupLocations        []*velerov1api.BackupStorageLocation
+		expectedBackupLocation string
+	}{
+		{
+			name:   "server's default location is used if no location is marked as the default",
+			backup: defaultBackup().Result(),
+			backupLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+			},
+			expectedBackupLocation: "default",
+		},
+		{
+			name:   "location marked as the default takes precedence over the server's default location",
+			backup: defaultBackup().Result(),
+			backupLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Result(),
+			},
+			expectedBackupLocation: "loc-1",
+		},
+		{
+			name:   "first location by name is used if multiple locations are marked as the default",
+			backup: defaultBackup().Result(),
+			backupLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-2").Default(true).Result(),
+				builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Result(),
+			},
+			expectedBackupLocation: "loc-1",
+		},
+		{
+			name:   "location specified on the backup is not changed",
+			backup: defaultBackup().StorageLocation("loc-2").Result(),
+			backupLocations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").Default(true).Result(),
+				builder.ForBackupStorageLocation("velero", "loc-2").Result(),
+			},
+			expectedBackupLocation: "loc-2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+			)
+
+			for _, location := range test.backupLocations {
+				require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+			}
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  "default",
+				clock:                  &clock.RealClock{},
+				formatFlag:             formatFlag,
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			assert.NotNil(t, res)
+			assert.Equal(t, test.expectedBackupLocation, res.Spec.StorageLocation)
+			assert.Empty(t, res.Status.ValidationErrors)
+		})
+	}
+}
+
 func TestDefaultBackupTTL(t *testing.T) {
 	var (
 		defaultBackupTTL = metav1.Duration{Duration: 24 * 30 * time.Hour}
@@ -310,6 +609,34 @@ func TestDefaultBackupTTL(t *testing.T) {
 	}
 }
 
+func TestBackupCompressionAndEncryption(t *testing.T) {
+	backup := defaultBackup().Result()
+
+	formatFlag := logging.FormatText
+	var (
+		clientset       = fake.NewSimpleClientset(backup)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+	)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		backupCompression:      velerov1api.BackupCompressionNone,
+		encryptionKeyID:        "key-1",
+		credentialFileStore:    velerotest.NewFakeCredentialsFileStore("", errors.New("secret not found")),
+		clock:                  clock.NewFakeClock(time.Now()),
+		formatFlag:             formatFlag,
+	}
+
+	res := c.prepareBackupRequest(backup)
+	require.NotNil(t, res)
+	assert.Equal(t, velerov1api.BackupCompressionNone, res.Status.Compression)
+	assert.Equal(t, "key-1", res.Status.EncryptionKeyID)
+	assert.Contains(t, res.Status.ValidationErrors, `error getting backup encryption key: error getting encryption key "key-1": secret not found`)
+}
+
 func TestProcessBackupCompletions(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Bucket("store-1").Result()
 
@@ -318,13 +645,21 @@ func TestProcessBackupCompletions(t *testing.T) {
 	now = now.Local()
 	timestamp := metav1.NewTime(now)
 
+	// the fake backupper writes an empty tarball and no snapshots or pod volume backups
+	checksums := map[string]string{
+		"backup-1.tar.gz":                   "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		"backup-1-podvolumebackups.json.gz": "702a706bbd305fa88f1175b2a6140ec21d7e0faa0faa1c685df10329195cc15b",
+		"backup-1-volumesnapshots.json.gz":  "702a706bbd305fa88f1175b2a6140ec21d7e0faa0faa1c685df10329195cc15b",
+	}
+
 	tests := []struct {
-		name                string
-		backup              *velerov1api.Backup
-		backupLocation      *velerov1api.BackupStorageLocation
-		expectedResult      *velerov1api.Backup
-		backupExists        bool
-		existenceCheckError error
+		name                   string
+		backup                 *velerov1api.Backup
+		backupLocation         *velerov1api.BackupStorageLocation
+		expectedResult         *velerov1api.Backup
+		backupExists           bool
+		existenceCheckError    error
+		defaultVolumesToRestic bool
 	}{
 		// Completed
 		{
@@ -342,13 +677,18 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
+					Checksums:           checksums,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -370,13 +710,18 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "alt-loc",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: "alt-loc",
+					StorageLocation:        "alt-loc",
+					DefaultVolumesToRestic: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
+					Checksums:           checksums,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -401,13 +746,18 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "read-write",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: "read-write",
+					StorageLocation:        "read-write",
+					DefaultVolumesToRestic: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
+					Checksums:           checksums,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -429,14 +779,19 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					TTL:             metav1.Duration{Duration: 10 * time.Minute},
-					StorageLocation: defaultBackupLocation.Name,
+					TTL:                    metav1.Duration{Duration: 10 * time.Minute},
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
+					Checksums:           checksums,
 					Expiration:          &metav1.Time{now.Add(10 * time.Minute)},
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
@@ -459,13 +814,120 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseCompleted,
+					Version:             2,
+					Checksums:           checksums,
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+				},
+			},
+		},
+
+		{
+			name:                   "backup with no default volumes to restic setting gets the server default",
+			backup:                 defaultBackup().Result(),
+			backupLocation:         defaultBackupLocation,
+			defaultVolumesToRestic: true,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.True(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseCompleted,
+					Version:             2,
+					Checksums:           checksums,
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+				},
+			},
+		},
+		{
+			name:                   "backup with default volumes to restic set to false keeps it",
+			backup:                 defaultBackup().DefaultVolumesToRestic(false).Result(),
+			backupLocation:         defaultBackupLocation,
+			defaultVolumesToRestic: true,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
+				},
+				Spec: velerov1api.BackupSpec{
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
+				},
+				Status: velerov1api.BackupStatus{
+					Phase:               velerov1api.BackupPhaseCompleted,
+					Version:             2,
+					Checksums:           checksums,
+					StartTimestamp:      &timestamp,
+					CompletionTimestamp: &timestamp,
+					Expiration:          &timestamp,
+				},
+			},
+		},
+		{
+			name:           "backup with default volumes to restic set to true keeps it",
+			backup:         defaultBackup().DefaultVolumesToRestic(true).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedResult: &velerov1api.Backup{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Backup",
+					APIVersion: "velero.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1api.DefaultNamespace,
+					Name:      "backup-1",
+					Labels: map[string]string{
+						"velero.io/storage-location": "loc-1",
+					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.True(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseCompleted,
-					Version:             1,
+					Version:             2,
+					Checksums:           checksums,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -490,13 +952,17 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseFailed,
-					Version:             1,
+					Version:             2,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -519,13 +985,17 @@ func TestProcessBackupCompletions(t *testing.T) {
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
 					},
+					Annotations: map[string]string{
+						"velero.io/server-instance-id": "server-1",
+					},
 				},
 				Spec: velerov1api.BackupSpec{
-					StorageLocation: defaultBackupLocation.Name,
+					StorageLocation:        defaultBackupLocation.Name,
+					DefaultVolumesToRestic: boolptr.False(),
 				},
 				Status: velerov1api.BackupStatus{
 					Phase:               velerov1api.BackupPhaseFailed,
-					Version:             1,
+					Version:             2,
 					StartTimestamp:      &timestamp,
 					CompletionTimestamp: &timestamp,
 					Expiration:          &timestamp,
@@ -553,15 +1023,17 @@ func TestProcessBackupCompletions(t *testing.T) {
 				backupLocationLister:   sharedInformers.Velero().V1().BackupStorageLocations().Lister(),
 				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
 				defaultBackupLocation:  defaultBackupLocation.Name,
+				defaultVolumesToRestic: test.defaultVolumesToRestic,
 				backupTracker:          NewBackupTracker(),
 				metrics:                metrics.NewServerMetrics(),
 				clock:                  clock.NewFakeClock(now),
 				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
-				newBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+				newBackupStore: func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
 					return backupStore, nil
 				},
-				backupper:  backupper,
-				formatFlag: formatFlag,
+				backupper:        backupper,
+				formatFlag:       formatFlag,
+				serverInstanceID: "server-1",
 			}
 
 			pluginManager.On("GetBackupItemActions").Return(nil, nil)
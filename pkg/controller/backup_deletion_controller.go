@@ -31,10 +31,15 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	"github.com/vmware-tanzu/velero/pkg/csi"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -44,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -62,10 +68,14 @@ type backupDeletionController struct {
 	podvolumeBackupLister     listers.PodVolumeBackupLister
 	backupLocationLister      listers.BackupStorageLocationLister
 	snapshotLocationLister    listers.VolumeSnapshotLocationLister
+	dynamicClient             dynamic.Interface
+	discoveryHelper           discovery.Helper
 	processRequestFunc        func(*v1.DeleteBackupRequest) error
 	clock                     clock.Clock
 	newPluginManager          func(logrus.FieldLogger) clientmgmt.Manager
-	newBackupStore            func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	newBackupStore            func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore       credentials.FileStore
+	fileSystem                filesystem.Interface
 	metrics                   *metrics.ServerMetrics
 }
 
@@ -82,7 +92,10 @@ func NewBackupDeletionController(
 	podvolumeBackupInformer informers.PodVolumeBackupInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	snapshotLocationInformer informers.VolumeSnapshotLocationInformer,
+	dynamicClient dynamic.Interface,
+	discoveryHelper discovery.Helper,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	metrics *metrics.ServerMetrics,
 ) Interface {
 	c := &backupDeletionController{
@@ -97,11 +110,15 @@ func NewBackupDeletionController(
 		podvolumeBackupLister:     podvolumeBackupInformer.Lister(),
 		backupLocationLister:      backupLocationInformer.Lister(),
 		snapshotLocationLister:    snapshotLocationInformer.Lister(),
+		dynamicClient:             dynamicClient,
+		discoveryHelper:           discoveryHelper,
 		metrics:                   metrics,
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
-		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newPluginManager:    newPluginManager,
+		credentialFileStore: credentialFileStore,
+		newBackupStore:      persistence.NewObjectBackupStore,
+		fileSystem:          filesystem.NewFileSystem(),
 
 		clock: &clock.RealClock{},
 	}
@@ -274,7 +291,7 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 	pluginManager := c.newPluginManager(log)
 	defer pluginManager.CleanupClients()
 
-	backupStore, err := c.newBackupStore(location, pluginManager, log)
+	backupStore, err := c.newBackupStore(location, pluginManager, c.credentialFileStore, log)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
@@ -292,7 +309,7 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 
 				volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
 				if !ok {
-					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager); err != nil {
+					if volumeSnapshotter, err = volumeSnapshotterForSnapshotLocation(backup.Namespace, snapshot.Spec.Location, c.snapshotLocationLister, pluginManager, c.credentialFileStore); err != nil {
 						errs = append(errs, err.Error())
 						continue
 					}
@@ -306,6 +323,24 @@ func (c *backupDeletionController) processRequest(req *v1.DeleteBackupRequest) e
 		}
 	}
 
+	if backupStore != nil {
+		if actions, err := pluginManager.GetDeleteItemActions(); err != nil {
+			errs = append(errs, errors.Wrap(err, "error getting delete item actions").Error())
+		} else if len(actions) > 0 {
+			log.Info("Invoking delete item actions")
+			for _, err := range c.invokeDeleteItemActions(backup, backupStore, actions, log) {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
+	if features.IsEnabled(v1.CSIFeatureFlag) {
+		log.Info("Removing CSI volume snapshots")
+		for _, err := range csi.DeleteSnapshotsForBackup(c.dynamicClient, backup.Name, log) {
+			errs = append(errs, err.Error())
+		}
+	}
+
 	log.Info("Removing restic snapshots")
 	if deleteErrs := c.deleteResticSnapshots(backup); len(deleteErrs) > 0 {
 		for _, err := range deleteErrs {
@@ -385,6 +420,7 @@ func volumeSnapshotterForSnapshotLocation(
 	namespace, snapshotLocationName string,
 	snapshotLocationLister listers.VolumeSnapshotLocationLister,
 	pluginManager clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 ) (velero.VolumeSnapshotter, error) {
 	snapshotLocation, err := snapshotLocationLister.VolumeSnapshotLocations(namespace).Get(snapshotLocationName)
 	if err != nil {
@@ -396,7 +432,12 @@ func volumeSnapshotterForSnapshotLocation(
 		return nil, errors.Wrapf(err, "error getting volume snapshotter for provider %s", snapshotLocation.Spec.Provider)
 	}
 
-	if err = volumeSnapshotter.Init(snapshotLocation.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentialsFile(snapshotLocation.Spec.Config, snapshotLocation.Spec.Credential, credentialFileStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting credentials for volume snapshot location %s", snapshotLocationName)
+	}
+
+	if err = volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.Wrapf(err, "error initializing volume snapshotter for volume snapshot location %s", snapshotLocationName)
 	}
 
@@ -452,6 +493,25 @@ func (c *backupDeletionController) deleteResticSnapshots(backup *v1.Backup) []er
 
 const deleteBackupRequestMaxAge = 24 * time.Hour
 
+// invokeDeleteItemActions downloads the backup's contents and runs the provided delete item actions
+// against the items in it.
+func (c *backupDeletionController) invokeDeleteItemActions(backup *v1.Backup, backupStore persistence.BackupStore, actions []velero.DeleteItemAction, log logrus.FieldLogger) []error {
+	contents, err := backupStore.GetBackupContents(backup.Name)
+	if err != nil {
+		return []error{errors.Wrap(err, "error downloading backup contents")}
+	}
+	defer contents.Close()
+
+	return pkgbackup.InvokeDeleteActions(&pkgbackup.DeleteItemActionContext{
+		Backup:          backup,
+		BackupReader:    contents,
+		Actions:         actions,
+		Filesystem:      c.fileSystem,
+		DiscoveryHelper: c.discoveryHelper,
+		Log:             log,
+	})
+}
+
 func (c *backupDeletionController) deleteExpiredRequests() {
 	c.logger.Info("Checking for expired DeleteBackupRequests")
 	defer c.logger.Info("Done checking for expired DeleteBackupRequests")
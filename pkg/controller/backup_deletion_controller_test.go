@@ -35,6 +35,7 @@ import (
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
@@ -62,7 +63,10 @@ func TestBackupDeletionControllerProcessQueueItem(t *testing.T) {
 		sharedInformers.Velero().V1().PodVolumeBackups(),
 		sharedInformers.Velero().V1().BackupStorageLocations(),
 		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		nil, // dynamicClient
+		nil, // discoveryHelper
 		nil, // new plugin manager func
+		nil, // credentialFileStore
 		metrics.NewServerMetrics(),
 	).(*backupDeletionController)
 
@@ -152,14 +156,17 @@ func setupBackupDeletionControllerTest(objects ...runtime.Object) *backupDeletio
 			sharedInformers.Velero().V1().PodVolumeBackups(),
 			sharedInformers.Velero().V1().BackupStorageLocations(),
 			sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+			nil, // dynamicClient
+			nil, // discoveryHelper
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			nil, // credentialFileStore
 			metrics.NewServerMetrics(),
 		).(*backupDeletionController),
 
 		req: req,
 	}
 
-	data.controller.newBackupStore = func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+	data.controller.newBackupStore = func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
 		return backupStore, nil
 	}
 
@@ -492,6 +499,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 
 		pluginManager := &pluginmocks.Manager{}
 		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(td.volumeSnapshotter, nil)
+		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
 		pluginManager.On("CleanupClients")
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
@@ -651,6 +659,7 @@ func TestBackupDeletionControllerProcessRequest(t *testing.T) {
 
 		pluginManager := &pluginmocks.Manager{}
 		pluginManager.On("GetVolumeSnapshotter", "provider-1").Return(td.volumeSnapshotter, nil)
+		pluginManager.On("GetDeleteItemActions").Return(nil, nil)
 		pluginManager.On("CleanupClients")
 		td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
 
@@ -850,7 +859,10 @@ func TestBackupDeletionControllerDeleteExpiredRequests(t *testing.T) {
 				sharedInformers.Velero().V1().PodVolumeBackups(),
 				sharedInformers.Velero().V1().BackupStorageLocations(),
 				sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+				nil, // dynamicClient
+				nil, // discoveryHelper
 				nil, // new plugin manager func
+				nil, // credentialFileStore
 				metrics.NewServerMetrics(),
 			).(*backupDeletionController)
 
@@ -0,0 +1,215 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+)
+
+// DefaultStoreValidationFrequency is how often backup storage locations are
+// validated by default.
+const DefaultStoreValidationFrequency = time.Minute
+
+// backupStorageLocationController periodically checks that each backup storage
+// location can be read from and, unless it's read-only, written to, and records
+// the result in the location's status.
+type backupStorageLocationController struct {
+	*genericController
+
+	namespace            string
+	backupLocationClient velerov1client.BackupStorageLocationsGetter
+	backupLocationLister listers.BackupStorageLocationLister
+	metrics              *metrics.ServerMetrics
+	newPluginManager     func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore       func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore  credentials.FileStore
+	clock                clock.Clock
+}
+
+// NewBackupStorageLocationController creates a new controller that validates
+// backup storage locations and sets their phase to Available or Unavailable.
+func NewBackupStorageLocationController(
+	namespace string,
+	backupLocationClient velerov1client.BackupStorageLocationsGetter,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+	validationFrequency time.Duration,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	metrics *metrics.ServerMetrics,
+	logger logrus.FieldLogger,
+) Interface {
+	if validationFrequency <= 0 {
+		validationFrequency = DefaultStoreValidationFrequency
+	}
+	logger.Infof("Backup storage location validation frequency is %v", validationFrequency)
+
+	c := &backupStorageLocationController{
+		genericController:    newGenericController("backup-storage-location", logger),
+		namespace:            namespace,
+		backupLocationClient: backupLocationClient,
+		backupLocationLister: backupLocationInformer.Lister(),
+		metrics:              metrics,
+
+		// use variables to refer to these functions so they can be
+		// replaced with fakes for testing.
+		newPluginManager:    newPluginManager,
+		credentialFileStore: credentialFileStore,
+		newBackupStore:      persistence.NewObjectBackupStore,
+
+		clock: &clock.RealClock{},
+	}
+
+	c.syncHandler = c.processLocation
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters, backupLocationInformer.Informer().HasSynced)
+	c.resyncPeriod = validationFrequency
+	c.resyncFunc = c.enqueueAllLocations
+
+	backupLocationInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				// only revalidate when the spec changes, since validating
+				// a location updates its status.
+				oldLocation := oldObj.(*velerov1api.BackupStorageLocation)
+				newLocation := newObj.(*velerov1api.BackupStorageLocation)
+				if !reflect.DeepEqual(oldLocation.Spec, newLocation.Spec) {
+					c.enqueue(newObj)
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+				if err != nil {
+					c.logger.WithError(errors.WithStack(err)).Error("Error creating queue key, item not added to queue")
+					return
+				}
+				c.queue.Add(key)
+			},
+		},
+	)
+
+	return c
+}
+
+func (c *backupStorageLocationController) enqueueAllLocations() {
+	locations, err := c.backupLocationLister.BackupStorageLocations(c.namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("Error listing backup storage locations")
+		return
+	}
+
+	for _, location := range locations {
+		c.enqueue(location)
+	}
+}
+
+func (c *backupStorageLocationController) processLocation(key string) error {
+	log := c.logger.WithField("backupLocation", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	location, err := c.backupLocationLister.BackupStorageLocations(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Backup storage location no longer exists, removing its metrics")
+		c.metrics.RemoveBackupStorageLocation(name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	phase := velerov1api.BackupStorageLocationPhaseAvailable
+	var message string
+	if err := c.validateLocation(location.DeepCopy(), log); err != nil {
+		log.WithError(err).Warn("Backup storage location is unavailable")
+		phase = velerov1api.BackupStorageLocationPhaseUnavailable
+		message = err.Error()
+	} else {
+		log.Debug("Backup storage location is available")
+	}
+
+	now := c.clock.Now()
+	c.metrics.SetBackupStorageLocationAvailability(name, phase == velerov1api.BackupStorageLocationPhaseAvailable, now)
+
+	status := map[string]interface{}{
+		"phase":              phase,
+		"lastValidationTime": now.UTC(),
+		// a null value removes the message from the status
+		"message": nil,
+	}
+	if message != "" {
+		status["message"] = message
+	}
+
+	patchBytes, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return errors.Wrap(err, "error marshaling backup storage location status patch to JSON")
+	}
+
+	if _, err := c.backupLocationClient.BackupStorageLocations(ns).Patch(name, types.MergePatchType, patchBytes); err != nil {
+		return errors.Wrap(err, "error patching backup storage location status")
+	}
+
+	return nil
+}
+
+// validateLocation checks that the location's backup store can be listed and,
+// unless the location is read-only, that objects can be written to it.
+func (c *backupStorageLocationController) validateLocation(location *velerov1api.BackupStorageLocation, log logrus.FieldLogger) error {
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(location, pluginManager, c.credentialFileStore, log)
+	if err != nil {
+		return errors.Wrap(err, "error getting backup store")
+	}
+
+	if err := backupStore.IsValid(); err != nil {
+		return errors.Wrap(err, "backup store is invalid")
+	}
+
+	if location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil
+	}
+
+	if err := backupStore.CheckWritable(); err != nil {
+		return errors.Wrap(err, "backup store is not writable")
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestProcessBackupStorageLocation(t *testing.T) {
+	tests := []struct {
+		name             string
+		location         *velerov1api.BackupStorageLocation
+		backupStoreErr   error
+		isValidErr       error
+		checkWritableErr error
+		expectedPhase    velerov1api.BackupStorageLocationPhase
+		expectedMessage  string
+	}{
+		{
+			name:          "readable and writable location is available",
+			location:      builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result(),
+			expectedPhase: velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name:          "read-only location isn't checked for writability",
+			location:      builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			expectedPhase: velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name: "message is cleared when an unavailable location becomes available",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").
+				Phase(velerov1api.BackupStorageLocationPhaseUnavailable).
+				Message("backup store is invalid: timeout").
+				Result(),
+			expectedPhase: velerov1api.BackupStorageLocationPhaseAvailable,
+		},
+		{
+			name:            "error getting backup store makes location unavailable",
+			location:        builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result(),
+			backupStoreErr:  errors.New("plugin not found"),
+			expectedPhase:   velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedMessage: "error getting backup store: plugin not found",
+		},
+		{
+			name:            "invalid backup store makes location unavailable",
+			location:        builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result(),
+			isValidErr:      errors.New("bucket not found"),
+			expectedPhase:   velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedMessage: "backup store is invalid: bucket not found",
+		},
+		{
+			name:             "unwritable backup store makes location unavailable",
+			location:         builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result(),
+			checkWritableErr: errors.New("access denied"),
+			expectedPhase:    velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedMessage:  "backup store is not writable: access denied",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset(test.location)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = &pluginmocks.Manager{}
+				backupStore     = &persistencemocks.BackupStore{}
+				now             = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+			)
+
+			c := NewBackupStorageLocationController(
+				velerov1api.DefaultNamespace,
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				time.Minute,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credentialFileStore
+				metrics.NewServerMetrics(),
+				velerotest.NewLogger(),
+			).(*backupStorageLocationController)
+
+			c.clock = clock.NewFakeClock(now)
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
+				if test.backupStoreErr != nil {
+					return nil, test.backupStoreErr
+				}
+				return backupStore, nil
+			}
+
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore.On("IsValid").Return(test.isValidErr)
+			backupStore.On("CheckWritable").Return(test.checkWritableErr)
+
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(test.location))
+
+			require.NoError(t, c.processLocation(velerov1api.DefaultNamespace+"/"+test.location.Name))
+
+			res, err := client.VeleroV1().BackupStorageLocations(velerov1api.DefaultNamespace).Get(test.location.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			assert.Equal(t, test.expectedMessage, res.Status.Message)
+			require.NotNil(t, res.Status.LastValidationTime)
+			assert.True(t, now.Equal(res.Status.LastValidationTime.Time))
+
+			if test.location.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+				backupStore.AssertNotCalled(t, "CheckWritable")
+			}
+		})
+	}
+}
+
+func TestProcessDeletedBackupStorageLocation(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+	)
+
+	c := NewBackupStorageLocationController(
+		velerov1api.DefaultNamespace,
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		time.Minute,
+		nil, // newPluginManager
+		nil, // credentialFileStore
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	).(*backupStorageLocationController)
+
+	assert.NoError(t, c.processLocation(velerov1api.DefaultNamespace+"/loc-1"))
+	assert.Empty(t, client.Actions())
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"encoding/json"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -50,7 +52,8 @@ type backupSyncController struct {
 	defaultBackupLocation       string
 	defaultBackupSyncPeriod     time.Duration
 	newPluginManager            func(logrus.FieldLogger) clientmgmt.Manager
-	newBackupStore              func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	newBackupStore              func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore         credentials.FileStore
 }
 
 func NewBackupSyncController(
@@ -64,6 +67,7 @@ func NewBackupSyncController(
 	namespace string,
 	defaultBackupLocation string,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	logger logrus.FieldLogger,
 ) Interface {
 	if syncPeriod <= 0 {
@@ -85,8 +89,9 @@ func NewBackupSyncController(
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
-		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newPluginManager:    newPluginManager,
+		credentialFileStore: credentialFileStore,
+		newBackupStore:      persistence.NewObjectBackupStore,
 	}
 
 	c.resyncFunc = c.run
@@ -120,6 +125,25 @@ func orderedBackupLocations(locations []*velerov1api.BackupStorageLocation, defa
 	return locations
 }
 
+// defaultBackupLocationName returns the name of the default backup location. A location with
+// its spec.default field set takes precedence over the server's default location name; if more
+// than one location is marked as the default, the first one by name is used.
+func defaultBackupLocationName(locations []*velerov1api.BackupStorageLocation, serverDefault string) string {
+	var defaults []string
+	for _, location := range locations {
+		if location.Spec.Default {
+			defaults = append(defaults, location.Name)
+		}
+	}
+
+	if len(defaults) == 0 {
+		return serverDefault
+	}
+
+	sort.Strings(defaults)
+	return defaults[0]
+}
+
 func (c *backupSyncController) run() {
 	c.logger.Debug("Checking for existing backup storage locations to sync into cluster")
 
@@ -129,7 +153,7 @@ func (c *backupSyncController) run() {
 		return
 	}
 	// sync the default location first, if it exists
-	locations = orderedBackupLocations(locations, c.defaultBackupLocation)
+	locations = orderedBackupLocations(locations, defaultBackupLocationName(locations, c.defaultBackupLocation))
 
 	pluginManager := c.newPluginManager(c.logger)
 	defer pluginManager.CleanupClients()
@@ -162,7 +186,7 @@ func (c *backupSyncController) run() {
 
 		log.Debug("Checking backup location for backups to sync into cluster")
 
-		backupStore, err := c.newBackupStore(location, pluginManager, log)
+		backupStore, err := c.newBackupStore(location, pluginManager, c.credentialFileStore, log)
 		if err != nil {
 			log.WithError(err).Error("Error getting backup store for this location")
 			continue
@@ -30,6 +30,7 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/label"
@@ -348,10 +349,11 @@ func TestBackupSyncControllerRun(t *testing.T) {
 				test.namespace,
 				"",
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credentialFileStore
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
-			c.newBackupStore = func(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ logrus.FieldLogger) (persistence.BackupStore, error) {
+			c.newBackupStore = func(loc *velerov1api.BackupStorageLocation, _ persistence.ObjectStoreGetter, _ credentials.FileStore, _ logrus.FieldLogger) (persistence.BackupStore, error) {
 				// this gets populated just below, prior to exercising the method under test
 				return backupStores[loc.Name], nil
 			}
@@ -572,6 +574,7 @@ func TestDeleteOrphanedBackups(t *testing.T) {
 				test.namespace,
 				"",
 				nil, // new plugin manager func
+				nil, // credentialFileStore
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -664,6 +667,7 @@ func TestStorageLabelsInDeleteOrphanedBackups(t *testing.T) {
 				test.namespace,
 				"",
 				nil, // new plugin manager func
+				nil, // credentialFileStore
 				velerotest.NewLogger(),
 			).(*backupSyncController)
 
@@ -0,0 +1,349 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+type backupVerificationController struct {
+	*genericController
+
+	backupClient         velerov1client.BackupsGetter
+	backupLister         velerov1listers.BackupLister
+	backupLocationLister velerov1listers.BackupStorageLocationLister
+	resticMgr            restic.RepositoryManager
+	newPluginManager     func(logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore       func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore  credentials.FileStore
+	fileSystem           filesystem.Interface
+	clock                clock.Clock
+	serverInstanceID     string
+
+	// verifying holds the keys of the backups that this server instance
+	// is currently verifying.
+	verifying     sets.String
+	verifyingLock sync.Mutex
+}
+
+// NewBackupVerificationController creates a new controller that verifies
+// the data in object storage of backups annotated with the
+// velero.io/verify-requested annotation.
+func NewBackupVerificationController(
+	logger logrus.FieldLogger,
+	backupClient velerov1client.BackupsGetter,
+	backupInformer velerov1informers.BackupInformer,
+	backupLocationInformer velerov1informers.BackupStorageLocationInformer,
+	resticMgr restic.RepositoryManager,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
+	serverInstanceID string,
+) Interface {
+	c := &backupVerificationController{
+		genericController:    newGenericController("backup-verification", logger),
+		backupClient:         backupClient,
+		backupLister:         backupInformer.Lister(),
+		backupLocationLister: backupLocationInformer.Lister(),
+		resticMgr:            resticMgr,
+
+		// use variables to refer to these functions so they can be
+		// replaced with fakes for testing.
+		newPluginManager:    newPluginManager,
+		credentialFileStore: credentialFileStore,
+		newBackupStore:      persistence.NewObjectBackupStore,
+		fileSystem:          filesystem.NewFileSystem(),
+
+		clock:            &clock.RealClock{},
+		serverInstanceID: serverInstanceID,
+		verifying:        sets.NewString(),
+	}
+
+	c.syncHandler = c.processBackup
+	c.cacheSyncWaiters = append(
+		c.cacheSyncWaiters,
+		backupInformer.Informer().HasSynced,
+		backupLocationInformer.Informer().HasSynced,
+	)
+
+	enqueue := func(obj interface{}) {
+		backup := obj.(*velerov1api.Backup)
+		if _, ok := backup.Annotations[velerov1api.VerifyRequestedAnnotation]; !ok {
+			return
+		}
+
+		key, err := cache.MetaNamespaceKeyFunc(backup)
+		if err != nil {
+			c.logger.WithError(err).WithField("backup", backup).Error("Error creating queue key, item not added to queue")
+			return
+		}
+		c.queue.Add(key)
+	}
+
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: enqueue,
+			UpdateFunc: func(_, obj interface{}) {
+				enqueue(obj)
+			},
+		},
+	)
+
+	return c
+}
+
+func (c *backupVerificationController) processBackup(key string) error {
+	log := c.logger.WithField("key", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	original, err := c.backupLister.Backups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find backup")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup")
+	}
+
+	if _, ok := original.Annotations[velerov1api.VerifyRequestedAnnotation]; !ok {
+		return nil
+	}
+
+	if original.Status.Verification != nil && original.Status.Verification.Phase == velerov1api.BackupVerificationPhaseInProgress {
+		// the backup is requeued by our own status updates while it's being
+		// verified, so don't start a second verification.
+		if c.isVerifying(key) {
+			log.Debug("Backup is already being verified, skipping")
+			return nil
+		}
+
+		// the lister may not have caught up with the status update that
+		// finished the backup's last verification, so check the current version.
+		current, err := c.backupClient.Backups(ns).Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find backup")
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error getting backup")
+		}
+		if _, ok := current.Annotations[velerov1api.VerifyRequestedAnnotation]; !ok || current.Status.Verification == nil || current.Status.Verification.Phase != velerov1api.BackupVerificationPhaseInProgress {
+			log.Debug("Backup verification has already finished, skipping")
+			return nil
+		}
+
+		// otherwise the verification was left in progress, either by a server
+		// that exited before it finished or because its final status update
+		// failed, so verify it again.
+		previousInstance := current.Annotations[velerov1api.VerificationServerInstanceIDAnnotation]
+		if previousInstance == "" {
+			previousInstance = "unknown"
+		}
+		log.WithField("previousServerInstance", previousInstance).Warn("Backup verification was left in progress, verifying it again")
+		original = current
+	}
+
+	c.startVerifying(key)
+	defer c.finishVerifying(key)
+
+	log.Info("Verifying backup")
+
+	backup := original.DeepCopy()
+	backup.Status.Verification = &velerov1api.BackupVerification{
+		Phase:          velerov1api.BackupVerificationPhaseInProgress,
+		StartTimestamp: &metav1.Time{Time: c.clock.Now()},
+	}
+	metav1.SetMetaDataAnnotation(&backup.ObjectMeta, velerov1api.VerificationServerInstanceIDAnnotation, c.serverInstanceID)
+	updated, err := patchBackup(original, backup, c.backupClient)
+	if err != nil {
+		return errors.Wrapf(err, "error updating verification phase to %s", velerov1api.BackupVerificationPhaseInProgress)
+	}
+
+	backup = updated.DeepCopy()
+	switch backup.Status.Phase {
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+		backup.Status.Verification.Errors = c.verifyBackup(backup, log)
+	default:
+		backup.Status.Verification.Errors = []string{fmt.Sprintf("backup phase is %s, only Completed or PartiallyFailed backups can be verified", backup.Status.Phase)}
+	}
+
+	if len(backup.Status.Verification.Errors) > 0 {
+		backup.Status.Verification.Phase = velerov1api.BackupVerificationPhaseFailed
+	} else {
+		backup.Status.Verification.Phase = velerov1api.BackupVerificationPhasePassed
+	}
+	backup.Status.Verification.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	delete(backup.Annotations, velerov1api.VerifyRequestedAnnotation)
+
+	log.WithFields(logrus.Fields{
+		"phase":  backup.Status.Verification.Phase,
+		"errors": len(backup.Status.Verification.Errors),
+	}).Info("Backup verification complete")
+
+	if _, err := patchBackup(updated, backup, c.backupClient); err != nil {
+		return errors.Wrapf(err, "error updating verification phase to %s", backup.Status.Verification.Phase)
+	}
+
+	return nil
+}
+
+func (c *backupVerificationController) isVerifying(key string) bool {
+	c.verifyingLock.Lock()
+	defer c.verifyingLock.Unlock()
+
+	return c.verifying.Has(key)
+}
+
+func (c *backupVerificationController) startVerifying(key string) {
+	c.verifyingLock.Lock()
+	defer c.verifyingLock.Unlock()
+
+	c.verifying.Insert(key)
+}
+
+func (c *backupVerificationController) finishVerifying(key string) {
+	c.verifyingLock.Lock()
+	defer c.verifyingLock.Unlock()
+
+	c.verifying.Delete(key)
+}
+
+// verifyBackup checks that every file of the backup can be read from object
+// storage and matches its recorded checksum, that the backup's tarball can be
+// parsed, and that each of its restic snapshots still exists. It returns a
+// list of the problems found.
+func (c *backupVerificationController) verifyBackup(backup *velerov1api.Backup, log logrus.FieldLogger) []string {
+	location, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(backup.Spec.StorageLocation)
+	if err != nil {
+		return []string{fmt.Sprintf("error getting backup storage location %q: %v", backup.Spec.StorageLocation, err)}
+	}
+
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := c.newBackupStore(location, pluginManager, c.credentialFileStore, log)
+	if err != nil {
+		return []string{fmt.Sprintf("error getting backup store: %v", err)}
+	}
+
+	var errs []string
+
+	if _, err := backupStore.GetBackupMetadata(backup.Name); err != nil {
+		errs = append(errs, fmt.Sprintf("error reading backup metadata: %v", err))
+	}
+
+	if _, err := backupStore.GetBackupVolumeSnapshots(backup.Name); err != nil {
+		errs = append(errs, fmt.Sprintf("error reading volume snapshots: %v", err))
+	}
+
+	podVolumeBackups, err := backupStore.GetPodVolumeBackups(backup.Name)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("error reading pod volume backups: %v", err))
+	}
+
+	if err := c.verifyBackupContents(backup, backupStore, log); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	errs = append(errs, c.verifyResticSnapshots(backup, podVolumeBackups)...)
+
+	return errs
+}
+
+// verifyBackupContents downloads the backup's tarball, extracts it and parses
+// its contents.
+func (c *backupVerificationController) verifyBackupContents(backup *velerov1api.Backup, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
+	file, err := downloadToTempFile(backup.Name, backupStore, log)
+	if err != nil {
+		return errors.Wrap(err, "error downloading backup contents")
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+
+	dir, err := archive.NewExtractor(log, c.fileSystem).UnzipAndExtractBackup(file, backup.Status.Compression)
+	if err != nil {
+		return errors.Wrap(err, "error extracting backup contents")
+	}
+	defer c.fileSystem.RemoveAll(dir)
+
+	if _, err := archive.NewParser(log, c.fileSystem).Parse(dir); err != nil {
+		return errors.Wrap(err, "error parsing backup contents")
+	}
+
+	return nil
+}
+
+// verifyResticSnapshots checks that the restic snapshot of each of the
+// pod volume backups still exists in its repository.
+func (c *backupVerificationController) verifyResticSnapshots(backup *velerov1api.Backup, podVolumeBackups []*velerov1api.PodVolumeBackup) []string {
+	var errs []string
+	for _, pvb := range podVolumeBackups {
+		if pvb.Status.SnapshotID == "" {
+			continue
+		}
+
+		if c.resticMgr == nil {
+			return append(errs, "unable to verify restic snapshots: restic is not enabled on this server")
+		}
+
+		snapshot := restic.SnapshotIdentifier{
+			VolumeNamespace:       pvb.Spec.Pod.Namespace,
+			BackupStorageLocation: backup.Spec.StorageLocation,
+			SnapshotID:            pvb.Status.SnapshotID,
+		}
+
+		ctx, cancelFunc := context.WithTimeout(context.Background(), resticTimeout)
+		exists, err := c.resticMgr.SnapshotExists(ctx, snapshot)
+		cancelFunc()
+
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Sprintf("error checking restic snapshot %s for volume %s of pod %s/%s: %v", pvb.Status.SnapshotID, pvb.Spec.Volume, pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name, err))
+		case !exists:
+			errs = append(errs, fmt.Sprintf("restic snapshot %s for volume %s of pod %s/%s not found", pvb.Status.SnapshotID, pvb.Spec.Volume, pvb.Spec.Pod.Namespace, pvb.Spec.Pod.Name))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	core "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+type fakeResticManager struct {
+	restic.RepositoryManager
+	snapshots map[string]bool
+}
+
+func (m *fakeResticManager) SnapshotExists(_ context.Context, snapshot restic.SnapshotIdentifier) (bool, error) {
+	return m.snapshots[snapshot.SnapshotID], nil
+}
+
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// backupTarball returns a gzipped tarball containing a single pod.
+func backupTarball(t *testing.T) []byte {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	data := []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"ns-1","name":"pod-1"}}`)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "resources/pods/namespaces/ns-1/pod-1.json",
+		Mode:     0644,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write(data)
+	require.NoError(t, err)
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf.Bytes()
+}
+
+func TestProcessBackupVerification(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Bucket("bucket").Result()
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").PodNamespace("ns-1").PodName("pod-1").Volume("vol-1").SnapshotID("snapshot-1").Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").PodNamespace("ns-1").PodName("pod-1").Volume("vol-2").Result(),
+	}
+
+	tests := []struct {
+		name                      string
+		backup                    *velerov1api.Backup
+		contents                  []byte
+		contentsErr               error
+		resticSnapshots           map[string]bool
+		current                   *velerov1api.Backup
+		verifying                 bool
+		expectedVerification      *velerov1api.BackupVerification
+		expectVerificationSkipped bool
+	}{
+		{
+			name:            "backup without the verify-requested annotation is not verified",
+			backup:          builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("loc-1").Phase(velerov1api.BackupPhaseCompleted).Result(),
+			resticSnapshots: map[string]bool{"snapshot-1": true},
+		},
+		{
+			name: "backup whose files and restic snapshots are all intact passes verification",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z")).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseCompleted).
+				Result(),
+			contents:        backupTarball(t),
+			resticSnapshots: map[string]bool{"snapshot-1": true},
+			expectedVerification: &velerov1api.BackupVerification{
+				Phase: velerov1api.BackupVerificationPhasePassed,
+			},
+		},
+		{
+			name: "backup with a corrupted tarball and a missing restic snapshot fails verification",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z")).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhasePartiallyFailed).
+				Result(),
+			contentsErr: errors.New("checksum mismatch"),
+			expectedVerification: &velerov1api.BackupVerification{
+				Phase: velerov1api.BackupVerificationPhaseFailed,
+				Errors: []string{
+					"error downloading backup contents: error copying Backup to temp file: checksum mismatch",
+					"restic snapshot snapshot-1 for volume vol-1 of pod ns-1/pod-1 not found",
+				},
+			},
+		},
+		{
+			name: "backup whose verification was left in progress by a previous server instance is verified again",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z",
+					velerov1api.VerificationServerInstanceIDAnnotation, "instance-0",
+				)).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseCompleted).
+				Verification(&velerov1api.BackupVerification{Phase: velerov1api.BackupVerificationPhaseInProgress}).
+				Result(),
+			contents:        backupTarball(t),
+			resticSnapshots: map[string]bool{"snapshot-1": true},
+			expectedVerification: &velerov1api.BackupVerification{
+				Phase: velerov1api.BackupVerificationPhasePassed,
+			},
+		},
+		{
+			name: "backup that's being verified by this server instance isn't verified again",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z",
+					velerov1api.VerificationServerInstanceIDAnnotation, "instance-1",
+				)).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseCompleted).
+				Verification(&velerov1api.BackupVerification{Phase: velerov1api.BackupVerificationPhaseInProgress}).
+				Result(),
+			verifying: true,
+			expectedVerification: &velerov1api.BackupVerification{
+				Phase: velerov1api.BackupVerificationPhaseInProgress,
+			},
+			expectVerificationSkipped: true,
+		},
+		{
+			name: "backup whose verification has finished since the lister was updated isn't verified again",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(
+					velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z",
+					velerov1api.VerificationServerInstanceIDAnnotation, "instance-1",
+				)).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseCompleted).
+				Verification(&velerov1api.BackupVerification{Phase: velerov1api.BackupVerificationPhaseInProgress}).
+				Result(),
+			current: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VerificationServerInstanceIDAnnotation, "instance-1")).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseCompleted).
+				Verification(&velerov1api.BackupVerification{Phase: velerov1api.BackupVerificationPhasePassed}).
+				Result(),
+			expectedVerification: &velerov1api.BackupVerification{
+				Phase: velerov1api.BackupVerificationPhasePassed,
+			},
+			expectVerificationSkipped: true,
+		},
+		{
+			name: "backup that failed can't be verified",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z")).
+				StorageLocation("loc-1").
+				Phase(velerov1api.BackupPhaseFailed).
+				Result(),
+			expectedVerification: &velerov1api.BackupVerification{
+				Phase:  velerov1api.BackupVerificationPhaseFailed,
+				Errors: []string{"backup phase is Failed, only Completed or PartiallyFailed backups can be verified"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current := test.current
+			if current == nil {
+				current = test.backup
+			}
+
+			var (
+				client          = fake.NewSimpleClientset(current)
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+				now             = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Local()
+			)
+
+			c := NewBackupVerificationController(
+				velerotest.NewLogger(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+				&fakeResticManager{snapshots: test.resticSnapshots},
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				velerotest.NewFakeCredentialsFileStore("", nil),
+				"instance-1",
+			).(*backupVerificationController)
+			c.clock = clock.NewFakeClock(now)
+			c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
+				return backupStore, nil
+			}
+			if test.verifying {
+				c.startVerifying(test.backup.Namespace + "/" + test.backup.Name)
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup))
+			require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+
+			pluginManager.On("CleanupClients").Return()
+			backupStore.On("GetBackupMetadata", test.backup.Name).Return(test.backup, nil)
+			backupStore.On("GetBackupVolumeSnapshots", test.backup.Name).Return([]*volume.Snapshot(nil), nil)
+			backupStore.On("GetPodVolumeBackups", test.backup.Name).Return(podVolumeBackups, nil)
+			if test.contentsErr != nil {
+				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(&failingReader{err: test.contentsErr}), nil)
+			} else {
+				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader(test.contents)), nil)
+			}
+
+			require.NoError(t, c.processBackup(test.backup.Namespace+"/"+test.backup.Name))
+
+			res, err := client.VeleroV1().Backups(test.backup.Namespace).Get(test.backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			if test.expectedVerification == nil {
+				assert.Nil(t, res.Status.Verification)
+				return
+			}
+
+			if test.expectVerificationSkipped {
+				assert.Equal(t, test.expectedVerification, res.Status.Verification)
+				backupStore.AssertNotCalled(t, "GetBackupContents", test.backup.Name)
+				return
+			}
+
+			test.expectedVerification.StartTimestamp = &metav1.Time{Time: now}
+			test.expectedVerification.CompletionTimestamp = &metav1.Time{Time: now}
+
+			assert.NotContains(t, res.Annotations, velerov1api.VerifyRequestedAnnotation)
+			assert.Equal(t, test.expectedVerification, res.Status.Verification)
+		})
+	}
+}
+
+func TestProcessBackupVerificationFinalPatchFails(t *testing.T) {
+	var (
+		backup = builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").
+			ObjectMeta(builder.WithAnnotations(velerov1api.VerifyRequestedAnnotation, "2020-01-01T00:00:00Z")).
+			StorageLocation("loc-1").
+			Phase(velerov1api.BackupPhaseCompleted).
+			Result()
+		location        = builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Bucket("bucket").Result()
+		client          = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		pluginManager   = new(pluginmocks.Manager)
+		backupStore     = new(persistencemocks.BackupStore)
+		now             = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Local()
+		key             = backup.Namespace + "/" + backup.Name
+	)
+
+	// fail the second patch, which records the verification's result.
+	patches := 0
+	client.PrependReactor("patch", "backups", func(action core.Action) (bool, runtime.Object, error) {
+		patches++
+		if patches == 2 {
+			return true, nil, errors.New("connection refused")
+		}
+		return false, nil, nil
+	})
+
+	c := NewBackupVerificationController(
+		velerotest.NewLogger(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		&fakeResticManager{},
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		velerotest.NewFakeCredentialsFileStore("", nil),
+		"instance-1",
+	).(*backupVerificationController)
+	c.clock = clock.NewFakeClock(now)
+	c.newBackupStore = func(*velerov1api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
+		return backupStore, nil
+	}
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(location))
+
+	pluginManager.On("CleanupClients").Return()
+	backupStore.On("GetBackupMetadata", backup.Name).Return(backup, nil)
+	backupStore.On("GetBackupVolumeSnapshots", backup.Name).Return([]*volume.Snapshot(nil), nil)
+	backupStore.On("GetPodVolumeBackups", backup.Name).Return([]*velerov1api.PodVolumeBackup(nil), nil)
+	backupStore.On("GetBackupContents", backup.Name).Return(ioutil.NopCloser(bytes.NewReader(backupTarball(t))), nil).Once()
+	backupStore.On("GetBackupContents", backup.Name).Return(ioutil.NopCloser(bytes.NewReader(backupTarball(t))), nil).Once()
+
+	require.Error(t, c.processBackup(key))
+
+	// the backup is left in progress, so the retry, which sees this server's
+	// own instance ID on it, should verify it again.
+	res, err := client.VeleroV1().Backups(backup.Namespace).Get(backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, res.Status.Verification)
+	assert.Equal(t, velerov1api.BackupVerificationPhaseInProgress, res.Status.Verification.Phase)
+	assert.Equal(t, "instance-1", res.Annotations[velerov1api.VerificationServerInstanceIDAnnotation])
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Update(res))
+
+	require.NoError(t, c.processBackup(key))
+
+	res, err = client.VeleroV1().Backups(backup.Namespace).Get(backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, res.Annotations, velerov1api.VerifyRequestedAnnotation)
+	assert.Equal(t, velerov1api.BackupVerificationPhasePassed, res.Status.Verification.Phase)
+	backupStore.AssertNumberOfCalls(t, "GetBackupContents", 2)
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -49,7 +50,8 @@ type downloadRequestController struct {
 	backupLocationLister  listers.BackupStorageLocationLister
 	backupLister          listers.BackupLister
 	newPluginManager      func(logrus.FieldLogger) clientmgmt.Manager
-	newBackupStore        func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	newBackupStore        func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore   credentials.FileStore
 }
 
 // NewDownloadRequestController creates a new DownloadRequestController.
@@ -60,6 +62,7 @@ func NewDownloadRequestController(
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	backupInformer informers.BackupInformer,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	logger logrus.FieldLogger,
 ) Interface {
 	c := &downloadRequestController{
@@ -72,8 +75,9 @@ func NewDownloadRequestController(
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
-		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newPluginManager:    newPluginManager,
+		credentialFileStore: credentialFileStore,
+		newBackupStore:      persistence.NewObjectBackupStore,
 
 		clock: &clock.RealClock{},
 	}
@@ -150,7 +154,7 @@ func (c *downloadRequestController) generatePreSignedURL(downloadRequest *v1.Dow
 	)
 
 	switch downloadRequest.Spec.Target.Kind {
-	case v1.DownloadTargetKindRestoreLog, v1.DownloadTargetKindRestoreResults:
+	case v1.DownloadTargetKindRestoreLog, v1.DownloadTargetKindRestoreResults, v1.DownloadTargetKindRestorePlan:
 		restore, err := c.restoreLister.Restores(downloadRequest.Namespace).Get(downloadRequest.Spec.Target.Name)
 		if err != nil {
 			return errors.Wrap(err, "error getting Restore")
@@ -174,7 +178,7 @@ func (c *downloadRequestController) generatePreSignedURL(downloadRequest *v1.Dow
 	pluginManager := c.newPluginManager(log)
 	defer pluginManager.CleanupClients()
 
-	backupStore, err := c.newBackupStore(backupLocation, pluginManager, log)
+	backupStore, err := c.newBackupStore(backupLocation, pluginManager, c.credentialFileStore, log)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -29,6 +29,7 @@ import (
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
@@ -61,6 +62,7 @@ func newDownloadRequestTestHarness(t *testing.T) *downloadRequestTestHarness {
 			informerFactory.Velero().V1().BackupStorageLocations(),
 			informerFactory.Velero().V1().Backups(),
 			func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+			nil, // credentialFileStore
 			velerotest.NewLogger(),
 		).(*downloadRequestController)
 	)
@@ -69,7 +71,7 @@ func newDownloadRequestTestHarness(t *testing.T) *downloadRequestTestHarness {
 	require.NoError(t, err)
 	controller.clock = clock.NewFakeClock(clockTime)
 
-	controller.newBackupStore = func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+	controller.newBackupStore = func(*v1.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
 		return backupStore, nil
 	}
 
@@ -229,6 +231,14 @@ func TestProcessDownloadRequest(t *testing.T) {
 			backupLocation:  newBackupLocation("a-location", "a-provider", "a-bucket"),
 			expectGetsURL:   true,
 		},
+		{
+			name:            "restore plan request with phase 'New' gets a url",
+			downloadRequest: newDownloadRequest(v1.DownloadRequestPhaseNew, v1.DownloadTargetKindRestorePlan, "a-backup-20170912150214"),
+			restore:         builder.ForRestore(v1.DefaultNamespace, "a-backup-20170912150214").Phase(v1.RestorePhaseCompleted).Backup("a-backup").Result(),
+			backup:          defaultBackup(),
+			backupLocation:  newBackupLocation("a-location", "a-provider", "a-bucket"),
+			expectGetsURL:   true,
+		},
 		{
 			name:            "request with phase 'Processed' is not deleted if not expired",
 			downloadRequest: newDownloadRequest(v1.DownloadRequestPhaseProcessed, v1.DownloadTargetKindBackupLog, "a-backup-20170912150214"),
@@ -149,32 +149,46 @@ func (c *gcController) processQueueItem(key string) error {
 		return nil
 	}
 
+	// if there's an existing unprocessed deletion request for this backup, don't create
+	// another one
+	pending, err := hasPendingDeleteBackupRequest(c.deleteBackupRequestLister, backup)
+	if err != nil {
+		return err
+	}
+	if pending {
+		log.Info("Backup already has a pending deletion request")
+		return nil
+	}
+
+	log.Info("Creating a new deletion request")
+	req := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
+
+	if _, err = c.deleteBackupRequestClient.DeleteBackupRequests(ns).Create(req); err != nil {
+		return errors.Wrap(err, "error creating DeleteBackupRequest")
+	}
+
+	return nil
+}
+
+// hasPendingDeleteBackupRequest returns true if there's a DeleteBackupRequest for
+// the backup that hasn't been processed yet.
+func hasPendingDeleteBackupRequest(lister listers.DeleteBackupRequestLister, backup *velerov1api.Backup) (bool, error) {
 	selector := labels.SelectorFromSet(labels.Set(map[string]string{
 		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
 		velerov1api.BackupUIDLabel:  string(backup.UID),
 	}))
 
-	dbrs, err := c.deleteBackupRequestLister.DeleteBackupRequests(ns).List(selector)
+	dbrs, err := lister.DeleteBackupRequests(backup.Namespace).List(selector)
 	if err != nil {
-		return errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
+		return false, errors.Wrap(err, "error listing existing DeleteBackupRequests for backup")
 	}
 
-	// if there's an existing unprocessed deletion request for this backup, don't create
-	// another one
 	for _, dbr := range dbrs {
 		switch dbr.Status.Phase {
 		case "", velerov1api.DeleteBackupRequestPhaseNew, velerov1api.DeleteBackupRequestPhaseInProgress:
-			log.Info("Backup already has a pending deletion request")
-			return nil
+			return true, nil
 		}
 	}
 
-	log.Info("Creating a new deletion request")
-	req := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
-
-	if _, err = c.deleteBackupRequestClient.DeleteBackupRequests(ns).Create(req); err != nil {
-		return errors.Wrap(err, "error creating DeleteBackupRequest")
-	}
-
-	return nil
+	return false, nil
 }
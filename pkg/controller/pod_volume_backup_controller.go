@@ -36,6 +36,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -55,10 +56,12 @@ type podVolumeBackupController struct {
 	pvLister              corev1listers.PersistentVolumeLister
 	backupLocationLister  listers.BackupStorageLocationLister
 	nodeName              string
+	credentialFileStore   credentials.FileStore
 
 	processBackupFunc func(*velerov1api.PodVolumeBackup) error
 	fileSystem        filesystem.Interface
 	clock             clock.Clock
+	operations        podVolumeOperations
 }
 
 // NewPodVolumeBackupController creates a new pod volume backup controller.
@@ -72,6 +75,7 @@ func NewPodVolumeBackupController(
 	pvInformer corev1informers.PersistentVolumeInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	nodeName string,
+	credentialFileStore credentials.FileStore,
 ) Interface {
 	c := &podVolumeBackupController{
 		genericController:     newGenericController("pod-volume-backup", logger),
@@ -83,6 +87,7 @@ func NewPodVolumeBackupController(
 		pvLister:              pvInformer.Lister(),
 		backupLocationLister:  backupLocationInformer.Lister(),
 		nodeName:              nodeName,
+		credentialFileStore:   credentialFileStore,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -119,6 +124,13 @@ func (c *podVolumeBackupController) pvbHandler(obj interface{}) {
 
 	log := loggerForPodVolumeBackup(c.logger, req)
 
+	// the backup controller marks a canceled backup's pod volume backups as
+	// failed, so stop restic if it's still running for this one.
+	if req.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed && c.operations.stop(kube.NamespaceAndName(req)) {
+		log.Info("PodVolumeBackup was marked as failed, stopping restic backup")
+		return
+	}
+
 	if req.Status.Phase != "" && req.Status.Phase != velerov1api.PodVolumeBackupPhaseNew {
 		log.Debug("Backup is not new, not enqueuing")
 		return
@@ -177,6 +189,9 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 
 	log.Info("Backup starting")
 
+	ctx, finish := c.operations.start(kube.NamespaceAndName(req))
+	defer finish()
+
 	var err error
 
 	// update status to InProgress
@@ -231,12 +246,12 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	// set resticCmd.Env appropriately (currently for Azure and S3 based backuplocations)
 	var env []string
 	if strings.HasPrefix(req.Spec.RepoIdentifier, "azure") {
-		if env, err = restic.AzureCmdEnv(c.backupLocationLister, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
+		if env, err = restic.AzureCmdEnv(c.backupLocationLister, c.credentialFileStore, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
 			return c.fail(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
 		resticCmd.Env = env
 	} else if strings.HasPrefix(req.Spec.RepoIdentifier, "s3") {
-		if env, err = restic.S3CmdEnv(c.backupLocationLister, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
+		if env, err = restic.S3CmdEnv(c.backupLocationLister, c.credentialFileStore, req.Namespace, req.Spec.BackupStorageLocation); err != nil {
 			return c.fail(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
 		resticCmd.Env = env
@@ -260,7 +275,12 @@ func (c *podVolumeBackupController) processBackup(req *velerov1api.PodVolumeBack
 	var stdout, stderr string
 
 	var emptySnapshot bool
-	if stdout, stderr, err = restic.RunBackup(resticCmd, log, c.updateBackupProgressFunc(req, log)); err != nil {
+	if stdout, stderr, err = restic.RunBackup(ctx, resticCmd, log, c.updateBackupProgressFunc(req, log)); err != nil {
+		if ctx.Err() != nil {
+			// it's already been marked as failed, so leave its status as is.
+			log.Info("Backup stopped")
+			return nil
+		}
 		if strings.Contains(stderr, "snapshot is empty") {
 			emptySnapshot = true
 		} else {
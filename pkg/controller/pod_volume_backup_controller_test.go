@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -169,3 +170,30 @@ func TestPVBHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestPVBHandlerStopsFailedBackup(t *testing.T) {
+	c := &podVolumeBackupController{
+		genericController: newGenericController("pod-volume-backup", velerotest.NewLogger()),
+		nodeName:          "foo",
+	}
+
+	pvb := &velerov1api.PodVolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "pvb-1"},
+		Spec:       velerov1api.PodVolumeBackupSpec{Node: "foo"},
+	}
+
+	ctx, finish := c.operations.start("velero/pvb-1")
+
+	// updates to a running backup's other fields don't stop it.
+	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseInProgress
+	c.pvbHandler(pvb)
+	assert.NoError(t, ctx.Err())
+
+	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseFailed
+	c.pvbHandler(pvb)
+	assert.Error(t, ctx.Err())
+	assert.Equal(t, 0, c.queue.Len())
+
+	assert.True(t, finish())
+	assert.False(t, c.operations.stop("velero/pvb-1"))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+)
+
+// podVolumeOperations tracks the pod volume backups or restores that a node
+// agent controller is processing, so that their restic commands can be stopped
+// if they're marked as failed while running, e.g. because the backup or restore
+// they're part of was canceled. The zero value is ready to use.
+type podVolumeOperations struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+// start returns a context for the operation with the given key, which is
+// canceled if stop is called for the key. The returned func must be called
+// once the operation is finished, and returns true if it was stopped.
+func (o *podVolumeOperations) start(key string) (context.Context, func() bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.cancels == nil {
+		o.cancels = make(map[string]context.CancelFunc)
+	}
+	o.cancels[key] = cancel
+
+	return ctx, func() bool {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		delete(o.cancels, key)
+		stopped := ctx.Err() != nil
+		cancel()
+		return stopped
+	}
+}
+
+// stop cancels the context of the operation with the given key, and returns
+// true if the operation was running.
+func (o *podVolumeOperations) stop(key string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	cancel, ok := o.cancels[key]
+	if ok {
+		cancel()
+	}
+	return ok
+}
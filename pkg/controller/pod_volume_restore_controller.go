@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -58,10 +60,12 @@ type podVolumeRestoreController struct {
 	pvLister               corev1listers.PersistentVolumeLister
 	backupLocationLister   listers.BackupStorageLocationLister
 	nodeName               string
+	credentialFileStore    credentials.FileStore
 
 	processRestoreFunc func(*velerov1api.PodVolumeRestore) error
 	fileSystem         filesystem.Interface
 	clock              clock.Clock
+	operations         podVolumeOperations
 }
 
 // NewPodVolumeRestoreController creates a new pod volume restore controller.
@@ -75,6 +79,7 @@ func NewPodVolumeRestoreController(
 	pvInformer corev1informers.PersistentVolumeInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
 	nodeName string,
+	credentialFileStore credentials.FileStore,
 ) Interface {
 	c := &podVolumeRestoreController{
 		genericController:      newGenericController("pod-volume-restore", logger),
@@ -86,6 +91,7 @@ func NewPodVolumeRestoreController(
 		pvLister:               pvInformer.Lister(),
 		backupLocationLister:   backupLocationInformer.Lister(),
 		nodeName:               nodeName,
+		credentialFileStore:    credentialFileStore,
 
 		fileSystem: filesystem.NewFileSystem(),
 		clock:      &clock.RealClock{},
@@ -127,6 +133,13 @@ func (c *podVolumeRestoreController) pvrHandler(obj interface{}) {
 	pvr := obj.(*velerov1api.PodVolumeRestore)
 	log := loggerForPodVolumeRestore(c.logger, pvr)
 
+	// the restore controller marks a canceled restore's pod volume restores as
+	// failed, so stop restic if it's still running for this one.
+	if pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed && c.operations.stop(kube.NamespaceAndName(pvr)) {
+		log.Info("PodVolumeRestore was marked as failed, stopping restic restore")
+		return
+	}
+
 	if !isPVRNew(pvr) {
 		log.Debugf("Restore is not new, not enqueuing")
 		return
@@ -261,6 +274,9 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 
 	log.Info("Restore starting")
 
+	ctx, finish := c.operations.start(kube.NamespaceAndName(req))
+	defer finish()
+
 	var err error
 
 	// update status to InProgress
@@ -294,7 +310,12 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 	defer os.Remove(credsFile)
 
 	// execute the restore process
-	if err := c.restorePodVolume(req, credsFile, volumeDir, log); err != nil {
+	if err := c.restorePodVolume(ctx, req, credsFile, volumeDir, log); err != nil {
+		if ctx.Err() != nil {
+			// it's already been marked as failed, so leave its status as is.
+			log.Info("Restore stopped")
+			return nil
+		}
 		log.WithError(err).Error("Error restoring volume")
 		return c.failRestore(req, errors.Wrap(err, "error restoring volume").Error(), log)
 	}
@@ -313,7 +334,7 @@ func (c *podVolumeRestoreController) processRestore(req *velerov1api.PodVolumeRe
 	return nil
 }
 
-func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolumeRestore, credsFile, volumeDir string, log logrus.FieldLogger) error {
+func (c *podVolumeRestoreController) restorePodVolume(ctx context.Context, req *velerov1api.PodVolumeRestore, credsFile, volumeDir string, log logrus.FieldLogger) error {
 	// Get the full path of the new volume's directory as mounted in the daemonset pod, which
 	// will look like: /host_pods/<new-pod-uid>/volumes/<volume-plugin-name>/<volume-dir>
 	volumePath, err := singlePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(req.Spec.Pod.UID), volumeDir))
@@ -331,13 +352,13 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 	// Running restic command might need additional provider specific environment variables. Based on the provider, we
 	// set resticCmd.Env appropriately (currently for Azure and S3 based backuplocations)
 	if strings.HasPrefix(req.Spec.RepoIdentifier, "azure") {
-		env, err := restic.AzureCmdEnv(c.backupLocationLister, req.Namespace, req.Spec.BackupStorageLocation)
+		env, err := restic.AzureCmdEnv(c.backupLocationLister, c.credentialFileStore, req.Namespace, req.Spec.BackupStorageLocation)
 		if err != nil {
 			return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
 		resticCmd.Env = env
 	} else if strings.HasPrefix(req.Spec.RepoIdentifier, "s3") {
-		env, err := restic.S3CmdEnv(c.backupLocationLister, req.Namespace, req.Spec.BackupStorageLocation)
+		env, err := restic.S3CmdEnv(c.backupLocationLister, c.credentialFileStore, req.Namespace, req.Spec.BackupStorageLocation)
 		if err != nil {
 			return c.failRestore(req, errors.Wrap(err, "error setting restic cmd env").Error(), log)
 		}
@@ -346,7 +367,7 @@ func (c *podVolumeRestoreController) restorePodVolume(req *velerov1api.PodVolume
 
 	var stdout, stderr string
 
-	if stdout, stderr, err = restic.RunRestore(resticCmd, log, c.updateRestoreProgressFunc(req, log)); err != nil {
+	if stdout, stderr, err = restic.RunRestore(ctx, resticCmd, log, c.updateRestoreProgressFunc(req, log)); err != nil {
 		return errors.Wrapf(err, "error running restic restore, cmd=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
 	}
 	log.Debugf("Ran command=%s, stdout=%s, stderr=%s", resticCmd.String(), stdout, stderr)
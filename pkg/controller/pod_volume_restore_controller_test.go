@@ -233,6 +233,25 @@ func TestPVRHandler(t *testing.T) {
 	}
 }
 
+func TestPVRHandlerStopsFailedRestore(t *testing.T) {
+	c := &podVolumeRestoreController{
+		genericController: newGenericController("pod-volume-restore", velerotest.NewLogger()),
+		nodeName:          "foo",
+	}
+
+	pvr := &velerov1api.PodVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "pvr-1"},
+		Status:     velerov1api.PodVolumeRestoreStatus{Phase: velerov1api.PodVolumeRestorePhaseFailed},
+	}
+
+	ctx, finish := c.operations.start("velero/pvr-1")
+
+	c.pvrHandler(pvr)
+	assert.Error(t, ctx.Err())
+	assert.Equal(t, 0, c.queue.Len())
+	assert.True(t, finish())
+}
+
 func TestPodHandler(t *testing.T) {
 	controllerNode := "foo"
 
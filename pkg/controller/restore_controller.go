@@ -19,30 +19,39 @@ package controller
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
@@ -77,6 +86,9 @@ type restoreController struct {
 	namespace              string
 	restoreClient          velerov1client.RestoresGetter
 	podVolumeBackupClient  velerov1client.PodVolumeBackupsGetter
+	podVolumeRestoreClient velerov1client.PodVolumeRestoresGetter
+	configMapClient        corev1client.ConfigMapsGetter
+	kubeClient             kubernetes.Interface
 	restorer               pkgrestore.Restorer
 	backupLister           listers.BackupLister
 	restoreLister          listers.RestoreLister
@@ -86,9 +98,18 @@ type restoreController struct {
 	defaultBackupLocation  string
 	metrics                *metrics.ServerMetrics
 	logFormat              logging.Format
-
-	newPluginManager func(logger logrus.FieldLogger) clientmgmt.Manager
-	newBackupStore   func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error)
+	clock                  clock.Clock
+	serverInstanceID       string
+
+	newPluginManager    func(logger logrus.FieldLogger) clientmgmt.Manager
+	newBackupStore      func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error)
+	credentialFileStore credentials.FileStore
+	waitForReady        func(context.Context, kubernetes.Interface, string, time.Duration, logrus.FieldLogger) (pkgrestore.Result, int)
+
+	// cancelFuncs holds the functions that cancel the restores being run by
+	// this controller, keyed by namespace/name.
+	cancelLock  sync.Mutex
+	cancelFuncs map[string]context.CancelFunc
 }
 
 func NewRestoreController(
@@ -96,6 +117,9 @@ func NewRestoreController(
 	restoreInformer informers.RestoreInformer,
 	restoreClient velerov1client.RestoresGetter,
 	podVolumeBackupClient velerov1client.PodVolumeBackupsGetter,
+	podVolumeRestoreClient velerov1client.PodVolumeRestoresGetter,
+	configMapClient corev1client.ConfigMapsGetter,
+	kubeClient kubernetes.Interface,
 	restorer pkgrestore.Restorer,
 	backupInformer informers.BackupInformer,
 	backupLocationInformer informers.BackupStorageLocationInformer,
@@ -103,15 +127,20 @@ func NewRestoreController(
 	logger logrus.FieldLogger,
 	restoreLogLevel logrus.Level,
 	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	credentialFileStore credentials.FileStore,
 	defaultBackupLocation string,
 	metrics *metrics.ServerMetrics,
 	logFormat logging.Format,
+	serverInstanceID string,
 ) Interface {
 	c := &restoreController{
 		genericController:      newGenericController("restore", logger),
 		namespace:              namespace,
 		restoreClient:          restoreClient,
 		podVolumeBackupClient:  podVolumeBackupClient,
+		podVolumeRestoreClient: podVolumeRestoreClient,
+		configMapClient:        configMapClient,
+		kubeClient:             kubeClient,
 		restorer:               restorer,
 		backupLister:           backupInformer.Lister(),
 		restoreLister:          restoreInformer.Lister(),
@@ -121,11 +150,15 @@ func NewRestoreController(
 		defaultBackupLocation:  defaultBackupLocation,
 		metrics:                metrics,
 		logFormat:              logFormat,
+		clock:                  &clock.RealClock{},
+		serverInstanceID:       serverInstanceID,
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
-		newPluginManager: newPluginManager,
-		newBackupStore:   persistence.NewObjectBackupStore,
+		newPluginManager:    newPluginManager,
+		credentialFileStore: credentialFileStore,
+		newBackupStore:      persistence.NewObjectBackupStore,
+		waitForReady:        pkgrestore.WaitForReady,
 	}
 
 	c.syncHandler = c.processQueueItem
@@ -143,9 +176,12 @@ func NewRestoreController(
 			AddFunc: func(obj interface{}) {
 				restore := obj.(*api.Restore)
 
-				switch restore.Status.Phase {
-				case "", api.RestorePhaseNew:
-					// only process new restores
+				switch {
+				case restore.Status.Phase == "", restore.Status.Phase == api.RestorePhaseNew:
+					// process new restores
+				case c.isOrphaned(restore):
+					// process restores left InProgress by a previous server instance
+					// so they can be marked as failed
 				default:
 					c.logger.WithFields(logrus.Fields{
 						"restore": kubeutil.NamespaceAndName(restore),
@@ -161,12 +197,67 @@ func NewRestoreController(
 				}
 				c.queue.Add(key)
 			},
+			UpdateFunc: func(_, obj interface{}) {
+				restore := obj.(*api.Restore)
+				if !restore.Spec.Cancel {
+					return
+				}
+
+				key, err := cache.MetaNamespaceKeyFunc(restore)
+				if err != nil {
+					c.logger.WithError(errors.WithStack(err)).WithField("restore", restore).Error("Error creating queue key, restore can't be canceled")
+					return
+				}
+
+				if restore.Status.Phase == "" || restore.Status.Phase == api.RestorePhaseNew {
+					// the restore hasn't started yet, so process it now to
+					// mark it as canceled.
+					c.queue.Add(key)
+					return
+				}
+
+				c.cancelRestore(key)
+			},
 		},
 	)
 
 	return c
 }
 
+// trackCancelFunc records the function that cancels the running restore
+// identified by key.
+func (c *restoreController) trackCancelFunc(key string, cancel context.CancelFunc) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	if c.cancelFuncs == nil {
+		c.cancelFuncs = make(map[string]context.CancelFunc)
+	}
+	c.cancelFuncs[key] = cancel
+}
+
+// untrackCancelFunc removes the cancel function for the restore identified
+// by key once it's no longer running.
+func (c *restoreController) untrackCancelFunc(key string) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	delete(c.cancelFuncs, key)
+}
+
+// cancelRestore cancels the restore identified by key if this controller is
+// running it.
+func (c *restoreController) cancelRestore(key string) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	if cancel, ok := c.cancelFuncs[key]; ok {
+		c.logger.WithField("restore", key).Info("Canceling restore")
+		cancel()
+		delete(c.cancelFuncs, key)
+	}
+}
+
 func (c *restoreController) resync() {
 	restores, err := c.restoreLister.List(labels.Everything())
 	if err != nil {
@@ -193,20 +284,19 @@ func (c *restoreController) processQueueItem(key string) error {
 		return errors.Wrap(err, "error getting Restore")
 	}
 
-	// TODO I think this is now unnecessary. We only initially place
-	// item with Phase = ("" | New) into the queue. Items will only get
-	// re-queued if syncHandler returns an error, which will only
-	// happen if there's an error updating Phase from its initial
-	// state to something else. So any time it's re-queued it will
-	// still have its initial state, which we've already confirmed
-	// is ("" | New)
-	switch restore.Status.Phase {
-	case "", api.RestorePhaseNew:
-		// only process new restores
+	switch {
+	case restore.Status.Phase == "", restore.Status.Phase == api.RestorePhaseNew:
+		// process new restores
+	case c.isOrphaned(restore):
+		return c.failOrphanedRestore(restore, log)
 	default:
 		return nil
 	}
 
+	if restore.Spec.Cancel {
+		return c.cancelNewRestore(restore, log)
+	}
+
 	// Deep-copy the restore so the copy from the lister is not modified.
 	// Any errors returned by processRestore will be bubbled up, meaning
 	// the key will be re-enqueued by the controller.
@@ -221,6 +311,14 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	// store a copy of the original restore for creating patch
 	original := restore.DeepCopy()
 
+	// register the restore's cancel func before it's marked as InProgress,
+	// so that it can be canceled as soon as the update is observed.
+	key := kubeutil.NamespaceAndName(restore)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.trackCancelFunc(key, cancel)
+	defer c.untrackCancelFunc(key)
+
 	// Validate the restore and fetch the backup. Note that the plugin
 	// manager used here is not the same one used by c.runValidatedRestore,
 	// since within that function we want the plugin manager to log to
@@ -238,6 +336,8 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		c.metrics.RegisterRestoreValidationFailed(backupScheduleName)
 	} else {
 		restore.Status.Phase = api.RestorePhaseInProgress
+		restore.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		metav1.SetMetaDataAnnotation(&restore.ObjectMeta, api.ServerInstanceIDAnnotation, c.serverInstanceID)
 	}
 
 	// patch to update status and persist to API
@@ -255,11 +355,20 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		return nil
 	}
 
-	if err := c.runValidatedRestore(restore, info); err != nil {
+	// the restore may have been canceled before the cancel func was registered.
+	if updatedRestore.Spec.Cancel {
+		cancel()
+	}
+
+	if err := c.runValidatedRestore(ctx, restore, info); err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
 		c.metrics.RegisterRestoreFailed(backupScheduleName)
+	} else if ctx.Err() != nil {
+		c.logger.Debug("Restore canceled")
+		restore.Status.Phase = api.RestorePhaseCanceled
+		c.failPendingPodVolumeRestores(restore, c.logger.WithField("restore", key))
 	} else if restore.Status.Errors > 0 {
 		c.logger.Debug("Restore partially failed")
 		restore.Status.Phase = api.RestorePhasePartiallyFailed
@@ -270,6 +379,12 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		c.metrics.RegisterRestoreSuccess(backupScheduleName)
 	}
 
+	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	if restore.Status.StartTimestamp != nil {
+		restoreDuration := restore.Status.CompletionTimestamp.Time.Sub(restore.Status.StartTimestamp.Time)
+		c.metrics.RegisterRestoreDuration(backupScheduleName, float64(restoreDuration/time.Second))
+	}
+
 	c.logger.Debug("Updating restore's final status")
 	if _, err = patchRestore(original, restore, c.restoreClient); err != nil {
 		c.logger.WithError(errors.WithStack(err)).Info("Error updating restore's final status")
@@ -278,6 +393,83 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	return nil
 }
 
+// isOrphaned returns true if the restore is InProgress but wasn't started by
+// this instance of the server, meaning the server that was processing it
+// exited before it finished.
+func (c *restoreController) isOrphaned(restore *api.Restore) bool {
+	return restore.Status.Phase == api.RestorePhaseInProgress &&
+		restore.Annotations[api.ServerInstanceIDAnnotation] != c.serverInstanceID
+}
+
+// failOrphanedRestore marks a restore that was left InProgress by a previous
+// server instance as Failed.
+func (c *restoreController) failOrphanedRestore(original *api.Restore, log logrus.FieldLogger) error {
+	previousInstance := original.Annotations[api.ServerInstanceIDAnnotation]
+	if previousInstance == "" {
+		previousInstance = "unknown"
+	}
+	log.WithField("previousServerInstance", previousInstance).Warn("Restore was left in progress by a previous server instance, marking it as failed")
+
+	restore := original.DeepCopy()
+	restore.Status.Phase = api.RestorePhaseFailed
+	restore.Status.FailureReason = fmt.Sprintf("the Velero server instance processing the restore (%s) exited before the restore completed", previousInstance)
+	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	metav1.SetMetaDataAnnotation(&restore.ObjectMeta, api.ServerInstanceIDAnnotation, c.serverInstanceID)
+
+	if _, err := patchRestore(original, restore, c.restoreClient); err != nil {
+		return errors.Wrap(err, "error marking orphaned restore as failed")
+	}
+
+	c.metrics.RegisterRestoreFailed(restore.Spec.ScheduleName)
+
+	return nil
+}
+
+// cancelNewRestore marks a restore that was canceled before it started as
+// Canceled.
+func (c *restoreController) cancelNewRestore(original *api.Restore, log logrus.FieldLogger) error {
+	log.Info("Restore was canceled before it started")
+
+	restore := original.DeepCopy()
+	restore.Status.Phase = api.RestorePhaseCanceled
+	restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+
+	if _, err := patchRestore(original, restore, c.restoreClient); err != nil {
+		return errors.Wrapf(err, "error updating Restore phase to %s", restore.Status.Phase)
+	}
+
+	return nil
+}
+
+// failPendingPodVolumeRestores marks the canceled restore's pod volume restores
+// that are still New or InProgress as Failed. Errors are logged rather than
+// returned.
+func (c *restoreController) failPendingPodVolumeRestores(restore *api.Restore, log logrus.FieldLogger) {
+	podVolumeRestores, err := c.podVolumeRestoreClient.PodVolumeRestores(restore.Namespace).List(restic.NewPodVolumeRestoreListOptions(restore.Name))
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing pod volume restores")
+		return
+	}
+
+	for i := range podVolumeRestores.Items {
+		pvr := &podVolumeRestores.Items[i]
+
+		switch pvr.Status.Phase {
+		case "", api.PodVolumeRestorePhaseNew, api.PodVolumeRestorePhaseInProgress:
+			// still pending, so nothing else will ever complete it
+		default:
+			continue
+		}
+
+		pvr.Status.Phase = api.PodVolumeRestorePhaseFailed
+		pvr.Status.Message = "the restore was canceled"
+		pvr.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if _, err := c.podVolumeRestoreClient.PodVolumeRestores(pvr.Namespace).Update(pvr); err != nil {
+			log.WithError(errors.WithStack(err)).WithField("podVolumeRestore", pvr.Name).Error("Error marking pod volume restore as failed")
+		}
+	}
+}
+
 type backupInfo struct {
 	backup      *api.Backup
 	backupStore persistence.BackupStore
@@ -310,6 +502,11 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate the resource modifier rules, if any
+	if _, err := c.getResourceModifiers(restore); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, err.Error())
+	}
+
 	// validate that exactly one of BackupName and ScheduleName have been specified
 	if !backupXorScheduleProvided(restore) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "Either a backup or schedule must be specified as a source for the restore, but not both")
@@ -354,6 +551,26 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 	return info
 }
 
+// getResourceModifiers gets and parses the rules in the restore's resource
+// modifier ConfigMap. It returns nil if the restore doesn't have one.
+func (c *restoreController) getResourceModifiers(restore *api.Restore) (*resourcemodifiers.ResourceModifiers, error) {
+	ref := restore.Spec.ResourceModifier
+	if ref == nil {
+		return nil, nil
+	}
+
+	if !strings.EqualFold(ref.Kind, "ConfigMap") {
+		return nil, errors.Errorf("unsupported resource modifier kind %q, must be ConfigMap", ref.Kind)
+	}
+
+	cm, err := c.configMapClient.ConfigMaps(c.namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting resource modifiers ConfigMap %s", ref.Name)
+	}
+
+	return resourcemodifiers.GetResourceModifiersFromConfig(cm)
+}
+
 // backupXorScheduleProvided returns true if exactly one of BackupName and
 // ScheduleName are non-empty for the restore, or false otherwise.
 func backupXorScheduleProvided(restore *api.Restore) bool {
@@ -406,7 +623,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 		return backupInfo{}, errors.WithStack(err)
 	}
 
-	backupStore, err := c.newBackupStore(location, pluginManager, c.logger)
+	backupStore, err := c.newBackupStore(location, pluginManager, c.credentialFileStore, c.logger)
 	if err != nil {
 		return backupInfo{}, err
 	}
@@ -421,7 +638,7 @@ func (c *restoreController) fetchBackupInfo(backupName string, pluginManager cli
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
 // counts, but *does not* update its phase or patch it via the API.
-func (c *restoreController) runValidatedRestore(restore *api.Restore, info backupInfo) error {
+func (c *restoreController) runValidatedRestore(ctx context.Context, restore *api.Restore, info backupInfo) error {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := newRestoreLogger(restore, c.logger, c.restoreLogLevel, c.logFormat)
@@ -444,6 +661,11 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	}
 	defer closeAndRemoveFile(backupFile, c.logger)
 
+	resourceModifiers, err := c.getResourceModifiers(restore)
+	if err != nil {
+		return err
+	}
+
 	opts := restic.NewPodVolumeBackupListOptions(restore.Spec.BackupName)
 	podVolumeBackupList, err := c.podVolumeBackupClient.PodVolumeBackups(c.namespace).List(opts)
 	if err != nil {
@@ -462,20 +684,42 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
 	restoreReq := pkgrestore.Request{
-		Log:              restoreLog,
-		Restore:          restore,
-		Backup:           info.backup,
-		PodVolumeBackups: podVolumeBackups,
-		VolumeSnapshots:  volumeSnapshots,
-		BackupReader:     backupFile,
+		Log:               restoreLog,
+		Restore:           restore,
+		Backup:            info.backup,
+		PodVolumeBackups:  podVolumeBackups,
+		VolumeSnapshots:   volumeSnapshots,
+		BackupReader:      backupFile,
+		ResourceModifiers: resourceModifiers,
+		Context:           ctx,
+	}
+	if restore.Spec.DryRun {
+		restoreReq.Plan = new(pkgrestore.Plan)
+	} else if restore.Spec.ExistingResourcePolicy == api.PolicyTypeUpdate {
+		restoreReq.ExistingResources = new(pkgrestore.ExistingResources)
 	}
 	restoreWarnings, restoreErrors := c.restorer.Restore(restoreReq, actions, c.snapshotLocationLister, pluginManager)
+
+	if restore.Spec.WaitForReady && !restore.Spec.DryRun && ctx.Err() == nil {
+		timeout := restore.Spec.ReadyTimeout.Duration
+		if timeout <= 0 {
+			timeout = pkgrestore.DefaultReadyTimeout
+		}
+
+		// Restores are processed one at a time, so this blocks any other
+		// restores from starting until the restored items are ready or
+		// the timeout elapses.
+		readyWarnings, unreadyItems := c.waitForReady(ctx, c.kubeClient, restore.Name, timeout, restoreLog)
+		restoreWarnings.Merge(&readyWarnings)
+		restore.Status.UnreadyItems = unreadyItems
+	}
+
 	restoreLog.Info("restore completed")
 
 	if logReader, err := restoreLog.done(c.logger); err != nil {
 		restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error getting restore log reader: %v", err))
 	} else {
-		if err := info.backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader); err != nil {
+		if err := info.backupStore.PutRestoreLog(restore.Spec.BackupName, restore.Name, logReader, info.backup.Status.EncryptionKeyID); err != nil {
 			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading log file to backup storage: %v", err))
 		}
 	}
@@ -493,19 +737,28 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		restore.Status.Errors += len(e)
 	}
 
-	m := map[string]pkgrestore.Result{
+	m := map[string]interface{}{
 		"warnings": restoreWarnings,
 		"errors":   restoreErrors,
 	}
+	if restoreReq.ExistingResources != nil {
+		m["existingResources"] = restoreReq.ExistingResources.Items
+	}
 
-	if err := putResults(restore, m, info.backupStore, c.logger); err != nil {
+	if err := putResults(restore, m, info.backupStore, info.backup.Status.EncryptionKeyID, c.logger); err != nil {
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if restoreReq.Plan != nil {
+		if err := putPlan(restore, restoreReq.Plan, info.backupStore, info.backup.Status.EncryptionKeyID); err != nil {
+			c.logger.WithError(err).Error("Error uploading restore plan to backup storage")
+		}
+	}
+
 	return nil
 }
 
-func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
+func putResults(restore *api.Restore, results map[string]interface{}, backupStore persistence.BackupStore, encryptionKeyID string, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
 	defer gzw.Close()
@@ -518,13 +771,29 @@ func putResults(restore *api.Restore, results map[string]pkgrestore.Result, back
 		return errors.Wrap(err, "error closing gzip writer")
 	}
 
-	if err := backupStore.PutRestoreResults(restore.Spec.BackupName, restore.Name, buf); err != nil {
+	if err := backupStore.PutRestoreResults(restore.Spec.BackupName, restore.Name, buf, encryptionKeyID); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func putPlan(restore *api.Restore, plan *pkgrestore.Plan, backupStore persistence.BackupStore, encryptionKeyID string) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(plan); err != nil {
+		return errors.Wrap(err, "error encoding restore plan to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	return backupStore.PutRestorePlan(restore.Spec.BackupName, restore.Name, buf, encryptionKeyID)
+}
+
 func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	readCloser, err := backupStore.GetBackupContents(backupName)
 	if err != nil {
@@ -537,8 +806,14 @@ func downloadToTempFile(backupName string, backupStore persistence.BackupStore,
 		return nil, errors.Wrap(err, "error creating Backup temp file")
 	}
 
+	// copying the contents also verifies them against the checksum recorded
+	// in the backup's metadata, if there is one.
 	n, err := io.Copy(file, readCloser)
 	if err != nil {
+		// nothing we can do about errors closing or removing the temp file
+		// here, and we're already returning an error about the copy failing.
+		file.Close()
+		os.Remove(file.Name())
 		return nil, errors.Wrap(err, "error copying Backup to temp file")
 	}
 
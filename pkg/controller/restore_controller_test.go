@@ -18,6 +18,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"testing"
@@ -28,14 +29,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
 	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -91,6 +97,7 @@ func TestFetchBackupInfo(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var (
 				client          = fake.NewSimpleClientset()
+				kubeClient      = kubefake.NewSimpleClientset()
 				restorer        = &fakeRestorer{}
 				sharedInformers = informers.NewSharedInformerFactory(client, 0)
 				logger          = velerotest.NewLogger()
@@ -106,6 +113,9 @@ func TestFetchBackupInfo(t *testing.T) {
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(),
 				client.VeleroV1(),
+				client.VeleroV1(),
+				kubeClient.CoreV1(),
+				kubeClient,
 				restorer,
 				sharedInformers.Velero().V1().Backups(),
 				sharedInformers.Velero().V1().BackupStorageLocations(),
@@ -113,12 +123,14 @@ func TestFetchBackupInfo(t *testing.T) {
 				logger,
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credentialFileStore
 				"default",
 				metrics.NewServerMetrics(),
 				formatFlag,
+				"",
 			).(*restoreController)
 
-			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
 				return backupStore, nil
 			}
 
@@ -170,9 +182,12 @@ func TestProcessQueueItemSkips(t *testing.T) {
 			expectError: true,
 		},
 		{
-			name:       "restore with phase InProgress does not get processed",
+			name:       "restore with phase InProgress started by this server instance does not get processed",
 			restoreKey: "foo/bar",
-			restore:    builder.ForRestore("foo", "bar").Phase(api.RestorePhaseInProgress).Result(),
+			restore: builder.ForRestore("foo", "bar").
+				ObjectMeta(builder.WithAnnotations(api.ServerInstanceIDAnnotation, "server-1")).
+				Phase(api.RestorePhaseInProgress).
+				Result(),
 		},
 		{
 			name:       "restore with phase Completed does not get processed",
@@ -192,6 +207,7 @@ func TestProcessQueueItemSkips(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var (
 				client          = fake.NewSimpleClientset()
+				kubeClient      = kubefake.NewSimpleClientset()
 				restorer        = &fakeRestorer{}
 				sharedInformers = informers.NewSharedInformerFactory(client, 0)
 				logger          = velerotest.NewLogger()
@@ -202,6 +218,9 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(),
 				client.VeleroV1(),
+				client.VeleroV1(),
+				kubeClient.CoreV1(),
+				kubeClient,
 				restorer,
 				sharedInformers.Velero().V1().Backups(),
 				sharedInformers.Velero().V1().BackupStorageLocations(),
@@ -209,9 +228,11 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				logger,
 				logrus.InfoLevel,
 				nil,
+				nil, // credentialFileStore
 				"default",
 				metrics.NewServerMetrics(),
 				formatFlag,
+				"server-1",
 			).(*restoreController)
 
 			if test.restore != nil {
@@ -225,7 +246,109 @@ func TestProcessQueueItemSkips(t *testing.T) {
 	}
 }
 
+func TestProcessQueueItemOrphaned(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	var (
+		restore = builder.ForRestore(api.DefaultNamespace, "restore-1").
+			ObjectMeta(builder.WithAnnotations(api.ServerInstanceIDAnnotation, "server-0")).
+			Phase(api.RestorePhaseInProgress).
+			Result()
+		client          = fake.NewSimpleClientset(restore)
+		kubeClient      = kubefake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+		logger          = velerotest.NewLogger()
+	)
+
+	c := NewRestoreController(
+		api.DefaultNamespace,
+		sharedInformers.Velero().V1().Restores(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		kubeClient.CoreV1(),
+		kubeClient,
+		&fakeRestorer{},
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		logger,
+		logrus.InfoLevel,
+		nil,
+		nil, // credentialFileStore
+		"default",
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		"server-1",
+	).(*restoreController)
+	c.clock = clock.NewFakeClock(now)
+
+	require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore))
+
+	require.NoError(t, c.processQueueItem("velero/restore-1"))
+
+	res, err := client.VeleroV1().Restores(api.DefaultNamespace).Get("restore-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, api.RestorePhaseFailed, res.Status.Phase)
+	assert.Equal(t, "the Velero server instance processing the restore (server-0) exited before the restore completed", res.Status.FailureReason)
+	assert.Equal(t, "server-1", res.Annotations[api.ServerInstanceIDAnnotation])
+	require.NotNil(t, res.Status.CompletionTimestamp)
+	assert.True(t, now.Equal(res.Status.CompletionTimestamp.Time))
+}
+
+func TestProcessQueueItemCanceledBeforeStarting(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+
+	var (
+		restore         = builder.ForRestore(api.DefaultNamespace, "restore-1").Phase(api.RestorePhaseNew).Cancel(true).Result()
+		client          = fake.NewSimpleClientset(restore)
+		kubeClient      = kubefake.NewSimpleClientset()
+		restorer        = &fakeRestorer{}
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+	)
+
+	c := NewRestoreController(
+		api.DefaultNamespace,
+		sharedInformers.Velero().V1().Restores(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		client.VeleroV1(),
+		kubeClient.CoreV1(),
+		kubeClient,
+		restorer,
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+		sharedInformers.Velero().V1().VolumeSnapshotLocations(),
+		velerotest.NewLogger(),
+		logrus.InfoLevel,
+		nil,
+		nil, // credentialFileStore
+		"default",
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		"server-1",
+	).(*restoreController)
+	c.clock = clock.NewFakeClock(now)
+
+	require.NoError(t, sharedInformers.Velero().V1().Restores().Informer().GetStore().Add(restore))
+
+	require.NoError(t, c.processQueueItem("velero/restore-1"))
+
+	res, err := client.VeleroV1().Restores(api.DefaultNamespace).Get("restore-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, api.RestorePhaseCanceled, res.Status.Phase)
+	require.NotNil(t, res.Status.CompletionTimestamp)
+	assert.True(t, now.Equal(res.Status.CompletionTimestamp.Time))
+	assert.Empty(t, restorer.Calls)
+}
+
 func TestProcessQueueItem(t *testing.T) {
+	now, err := time.Parse(time.RFC1123Z, time.RFC1123Z)
+	require.NoError(t, err)
+	now = now.Local()
+
 	defaultStorageLocation := builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
 
 	tests := []struct {
@@ -244,6 +367,11 @@ func TestProcessQueueItem(t *testing.T) {
 		backupStoreGetBackupContentsErr error
 		putRestoreLogErr                error
 		expectedFinalPhase              string
+		configMap                       *corev1api.ConfigMap
+		readyWarnings                   pkgrestore.Result
+		unreadyItems                    int
+		expectedWaitForReadyTimeout     time.Duration
+		expectedWarnings                int
 	}{
 		{
 			name:                     "restore with both namespace in both includedNamespaces and excludedNamespaces fails validation",
@@ -263,6 +391,27 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:                     "restore with a nonexistent resource modifier ConfigMap fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).ResourceModifier("modifiers").Result(),
+			backup:                   defaultBackup().StorageLocation("default").Result(),
+			expectedErr:              false,
+			expectedPhase:            string(api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{`error getting resource modifiers ConfigMap modifiers: configmaps "modifiers" not found`},
+		},
+		{
+			name:          "restore with invalid resource modifier rules fails validation",
+			location:      defaultStorageLocation,
+			restore:       NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).ResourceModifier("modifiers").Result(),
+			backup:        defaultBackup().StorageLocation("default").Result(),
+			configMap:     builder.ForConfigMap(api.DefaultNamespace, "modifiers").Data("rules.yaml", "version: v2").Result(),
+			expectedErr:   false,
+			expectedPhase: string(api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{
+				`invalid resource modifiers ConfigMap velero/modifiers: unsupported version "v2", must be "v1"`,
+			},
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", api.RestorePhaseNew).Result(),
@@ -315,6 +464,38 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:        string(api.RestorePhaseInProgress),
 			expectedRestorerCall: NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                 "valid dry-run restore gets executed and uploads a plan",
+			location:             defaultStorageLocation,
+			restore:              NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).DryRun(true).Result(),
+			backup:               defaultBackup().StorageLocation("default").Result(),
+			expectedErr:          false,
+			expectedPhase:        string(api.RestorePhaseInProgress),
+			expectedRestorerCall: NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseInProgress).DryRun(true).Result(),
+		},
+		{
+			name:                        "restore that waits for ready items records the unready items as warnings",
+			location:                    defaultStorageLocation,
+			restore:                     NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).WaitForReady(true).ReadyTimeout(time.Minute).Result(),
+			backup:                      defaultBackup().StorageLocation("default").Result(),
+			expectedErr:                 false,
+			expectedPhase:               string(api.RestorePhaseInProgress),
+			expectedRestorerCall:        NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseInProgress).WaitForReady(true).ReadyTimeout(time.Minute).Result(),
+			readyWarnings:               pkgrestore.Result{Namespaces: map[string][]string{"ns-1": {"deployments.apps/ns-1/app: 0 of 1 replicas available"}}},
+			unreadyItems:                1,
+			expectedWaitForReadyTimeout: time.Minute,
+			expectedWarnings:            1,
+		},
+		{
+			name:                        "restore that waits for ready items uses the default timeout",
+			location:                    defaultStorageLocation,
+			restore:                     NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseNew).WaitForReady(true).Result(),
+			backup:                      defaultBackup().StorageLocation("default").Result(),
+			expectedErr:                 false,
+			expectedPhase:               string(api.RestorePhaseInProgress),
+			expectedRestorerCall:        NewRestore("foo", "bar", "backup-1", "ns-1", "", api.RestorePhaseInProgress).WaitForReady(true).Result(),
+			expectedWaitForReadyTimeout: pkgrestore.DefaultReadyTimeout,
+		},
 		{
 			name:          "restoration of nodes is not supported",
 			location:      defaultStorageLocation,
@@ -392,6 +573,7 @@ func TestProcessQueueItem(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var (
 				client          = fake.NewSimpleClientset()
+				kubeClient      = kubefake.NewSimpleClientset()
 				restorer        = &fakeRestorer{}
 				sharedInformers = informers.NewSharedInformerFactory(client, 0)
 				logger          = velerotest.NewLogger()
@@ -407,6 +589,9 @@ func TestProcessQueueItem(t *testing.T) {
 				sharedInformers.Velero().V1().Restores(),
 				client.VeleroV1(),
 				client.VeleroV1(),
+				client.VeleroV1(),
+				kubeClient.CoreV1(),
+				kubeClient,
 				restorer,
 				sharedInformers.Velero().V1().Backups(),
 				sharedInformers.Velero().V1().BackupStorageLocations(),
@@ -414,18 +599,33 @@ func TestProcessQueueItem(t *testing.T) {
 				logger,
 				logrus.InfoLevel,
 				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				nil, // credentialFileStore
 				"default",
 				metrics.NewServerMetrics(),
 				formatFlag,
+				"server-1",
 			).(*restoreController)
 
-			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, logrus.FieldLogger) (persistence.BackupStore, error) {
+			c.clock = clock.NewFakeClock(now)
+
+			c.newBackupStore = func(*api.BackupStorageLocation, persistence.ObjectStoreGetter, credentials.FileStore, logrus.FieldLogger) (persistence.BackupStore, error) {
 				return backupStore, nil
 			}
 
+			var waitForReadyTimeout time.Duration
+			c.waitForReady = func(_ context.Context, _ kubernetes.Interface, restoreName string, timeout time.Duration, _ logrus.FieldLogger) (pkgrestore.Result, int) {
+				assert.Equal(t, test.restore.Name, restoreName)
+				waitForReadyTimeout = timeout
+				return test.readyWarnings, test.unreadyItems
+			}
+
 			if test.location != nil {
 				sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(test.location)
 			}
+			if test.configMap != nil {
+				_, err := kubeClient.CoreV1().ConfigMaps(test.configMap.Namespace).Create(test.configMap)
+				require.NoError(t, err)
+			}
 			if test.backup != nil {
 				sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(test.backup)
 			}
@@ -489,9 +689,13 @@ func TestProcessQueueItem(t *testing.T) {
 
 				restorer.On("Restore", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
 
-				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
+				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything, test.backup.Status.EncryptionKeyID).Return(test.putRestoreLogErr)
 
-				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything, test.backup.Status.EncryptionKeyID).Return(nil)
+
+				if test.restore.Spec.DryRun {
+					backupStore.On("PutRestorePlan", test.backup.Name, test.restore.Name, mock.Anything, test.backup.Status.EncryptionKeyID).Return(nil)
+				}
 
 				volumeSnapshots := []*volume.Snapshot{
 					{
@@ -546,14 +750,23 @@ func TestProcessQueueItem(t *testing.T) {
 			}
 
 			type StatusPatch struct {
-				Phase            api.RestorePhase `json:"phase"`
-				ValidationErrors []string         `json:"validationErrors"`
-				Errors           int              `json:"errors"`
+				Phase               api.RestorePhase `json:"phase"`
+				ValidationErrors    []string         `json:"validationErrors"`
+				Errors              int              `json:"errors"`
+				Warnings            int              `json:"warnings"`
+				UnreadyItems        int              `json:"unreadyItems"`
+				StartTimestamp      *metav1.Time     `json:"startTimestamp"`
+				CompletionTimestamp *metav1.Time     `json:"completionTimestamp"`
+			}
+
+			type MetadataPatch struct {
+				Annotations map[string]string `json:"annotations"`
 			}
 
 			type Patch struct {
-				Spec   SpecPatch   `json:"spec,omitempty"`
-				Status StatusPatch `json:"status"`
+				Metadata MetadataPatch `json:"metadata,omitempty"`
+				Spec     SpecPatch     `json:"spec,omitempty"`
+				Status   StatusPatch   `json:"status"`
 			}
 
 			decode := func(decoder *json.Decoder) (interface{}, error) {
@@ -572,6 +785,12 @@ func TestProcessQueueItem(t *testing.T) {
 					ValidationErrors: test.expectedValidationErrors,
 				},
 			}
+			if test.expectedPhase == string(api.RestorePhaseInProgress) {
+				expected.Status.StartTimestamp = &metav1.Time{Time: now}
+				expected.Metadata.Annotations = map[string]string{
+					api.ServerInstanceIDAnnotation: "server-1",
+				}
+			}
 
 			if test.restore.Spec.ScheduleName != "" && test.backup != nil {
 				expected.Spec = SpecPatch{
@@ -593,22 +812,28 @@ func TestProcessQueueItem(t *testing.T) {
 
 			expected = Patch{
 				Status: StatusPatch{
-					Phase:  api.RestorePhaseCompleted,
-					Errors: test.expectedRestoreErrors,
+					Phase:               api.RestorePhaseCompleted,
+					Errors:              test.expectedRestoreErrors,
+					Warnings:            test.expectedWarnings,
+					UnreadyItems:        test.unreadyItems,
+					CompletionTimestamp: &metav1.Time{Time: now},
 				},
 			}
 			// Override our default expectations if the case requires it
 			if test.expectedFinalPhase != "" {
 				expected = Patch{
 					Status: StatusPatch{
-						Phase:  api.RestorePhase(test.expectedFinalPhase),
-						Errors: test.expectedRestoreErrors,
+						Phase:               api.RestorePhase(test.expectedFinalPhase),
+						Errors:              test.expectedRestoreErrors,
+						CompletionTimestamp: &metav1.Time{Time: now},
 					},
 				}
 			}
 
 			velerotest.ValidatePatch(t, actions[2], expected, decode)
 
+			assert.Equal(t, test.expectedWaitForReadyTimeout, waitForReadyTimeout)
+
 			// explicitly capturing the argument passed to Restore myself because
 			// I want to validate the called arg as of the time of calling, but
 			// the mock stores the pointer, which gets modified after
@@ -632,6 +857,9 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		sharedInformers.Velero().V1().Restores(),
 		client.VeleroV1(),
 		client.VeleroV1(),
+		client.VeleroV1(),
+		kubefake.NewSimpleClientset().CoreV1(),
+		kubefake.NewSimpleClientset(),
 		nil,
 		sharedInformers.Velero().V1().Backups(),
 		sharedInformers.Velero().V1().BackupStorageLocations(),
@@ -639,9 +867,11 @@ func TestvalidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		logger,
 		logrus.DebugLevel,
 		nil,
+		nil, // credentialFileStore
 		"default",
 		nil,
 		formatFlag,
+		"",
 	).(*restoreController)
 
 	restore := &api.Restore{
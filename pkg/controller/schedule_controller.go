@@ -85,7 +85,7 @@ func NewScheduleController(
 				schedule := obj.(*api.Schedule)
 
 				switch schedule.Status.Phase {
-				case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
+				case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled, api.SchedulePhasePaused:
 					// add to work queue
 				default:
 					c.logger.WithFields(logrus.Fields{
@@ -120,7 +120,9 @@ func (c *scheduleController) enqueueAllEnabledSchedules() {
 	}
 
 	for _, schedule := range schedules {
-		if schedule.Status.Phase != api.SchedulePhaseEnabled {
+		// paused schedules are enqueued as well so that we notice when
+		// they've been unpaused.
+		if schedule.Status.Phase != api.SchedulePhaseEnabled && schedule.Status.Phase != api.SchedulePhasePaused {
 			continue
 		}
 
@@ -154,7 +156,7 @@ func (c *scheduleController) processSchedule(key string) error {
 	}
 
 	switch schedule.Status.Phase {
-	case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled:
+	case "", api.SchedulePhaseNew, api.SchedulePhaseEnabled, api.SchedulePhasePaused:
 		// valid phase for processing
 	default:
 		return nil
@@ -174,6 +176,8 @@ func (c *scheduleController) processSchedule(key string) error {
 	if len(errs) > 0 {
 		schedule.Status.Phase = api.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
+	} else if schedule.Spec.Paused {
+		schedule.Status.Phase = api.SchedulePhasePaused
 	} else {
 		schedule.Status.Phase = api.SchedulePhaseEnabled
 	}
@@ -188,6 +192,7 @@ func (c *scheduleController) processSchedule(key string) error {
 	}
 
 	if schedule.Status.Phase != api.SchedulePhaseEnabled {
+		log.WithField("phase", schedule.Status.Phase).Debug("Schedule is not enabled, skipping")
 		return nil
 	}
 
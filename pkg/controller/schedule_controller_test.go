@@ -116,6 +116,35 @@ func TestProcessSchedule(t *testing.T) {
 			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
 			expectedLastBackup:   "2017-01-01 12:00:00",
 		},
+		{
+			name:          "paused schedule with phase New gets validated and moved to phase Paused without triggering a backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseNew).CronSchedule("@every 5m").Paused(true).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.SchedulePhasePaused),
+		},
+		{
+			name:          "schedule with phase Enabled that gets paused is moved to phase Paused without triggering a backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhaseEnabled).CronSchedule("@every 5m").Paused(true).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+			expectedPhase: string(velerov1api.SchedulePhasePaused),
+		},
+		{
+			name:          "schedule with phase Paused that's still paused does not trigger a backup",
+			schedule:      newScheduleBuilder(velerov1api.SchedulePhasePaused).CronSchedule("@every 5m").Paused(true).Result(),
+			fakeClockTime: "2017-01-01 12:00:00",
+			expectedErr:   false,
+		},
+		{
+			name:                 "schedule with phase Paused that gets unpaused is enabled and triggers a backup if due",
+			schedule:             newScheduleBuilder(velerov1api.SchedulePhasePaused).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").Result(),
+			fakeClockTime:        "2017-01-01 12:00:00",
+			expectedErr:          false,
+			expectedPhase:        string(velerov1api.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120000").ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:00",
+		},
 	}
 
 	for _, test := range tests {
@@ -249,7 +278,11 @@ func TestProcessSchedule(t *testing.T) {
 				}
 
 				velerotest.ValidatePatch(t, actions[index], expected, decode)
+
+				index++
 			}
+
+			assert.Len(t, actions, index, "unexpected actions")
 		})
 	}
 }
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/retention"
+)
+
+const (
+	scheduleRetentionSyncPeriod = 60 * time.Minute
+)
+
+// scheduleRetentionController creates DeleteBackupRequests for the backups of a
+// schedule that aren't kept by the schedule's retention policy.
+type scheduleRetentionController struct {
+	*genericController
+
+	scheduleLister            listers.ScheduleLister
+	backupLister              listers.BackupLister
+	deleteBackupRequestLister listers.DeleteBackupRequestLister
+	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter
+	backupLocationLister      listers.BackupStorageLocationLister
+}
+
+// NewScheduleRetentionController constructs a new scheduleRetentionController.
+func NewScheduleRetentionController(
+	logger logrus.FieldLogger,
+	scheduleInformer informers.ScheduleInformer,
+	backupInformer informers.BackupInformer,
+	deleteBackupRequestInformer informers.DeleteBackupRequestInformer,
+	deleteBackupRequestClient velerov1client.DeleteBackupRequestsGetter,
+	backupLocationInformer informers.BackupStorageLocationInformer,
+) Interface {
+	c := &scheduleRetentionController{
+		genericController:         newGenericController("schedule-retention", logger),
+		scheduleLister:            scheduleInformer.Lister(),
+		backupLister:              backupInformer.Lister(),
+		deleteBackupRequestLister: deleteBackupRequestInformer.Lister(),
+		deleteBackupRequestClient: deleteBackupRequestClient,
+		backupLocationLister:      backupLocationInformer.Lister(),
+	}
+
+	c.syncHandler = c.processSchedule
+	c.cacheSyncWaiters = append(c.cacheSyncWaiters,
+		scheduleInformer.Informer().HasSynced,
+		backupInformer.Informer().HasSynced,
+		deleteBackupRequestInformer.Informer().HasSynced,
+		backupLocationInformer.Informer().HasSynced,
+	)
+
+	c.resyncPeriod = scheduleRetentionSyncPeriod
+	c.resyncFunc = c.enqueueAllSchedules
+
+	scheduleInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+		},
+	)
+
+	// a backup finishing can change which of its schedule's backups are kept,
+	// so enqueue the schedule.
+	backupInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueBackupSchedule,
+			UpdateFunc: c.enqueueBackupScheduleOnPhaseChange,
+		},
+	)
+
+	return c
+}
+
+// enqueueAllSchedules lists all schedules from cache and enqueues all of them so we can
+// apply their retention policies.
+func (c *scheduleRetentionController) enqueueAllSchedules() {
+	c.logger.Debug("scheduleRetentionController.enqueueAllSchedules")
+
+	schedules, err := c.scheduleLister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("error listing schedules")
+		return
+	}
+
+	for _, schedule := range schedules {
+		c.enqueue(schedule)
+	}
+}
+
+// enqueueBackupSchedule enqueues the schedule that created the backup, if any.
+func (c *scheduleRetentionController) enqueueBackupSchedule(obj interface{}) {
+	backup := obj.(*velerov1api.Backup)
+
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName == "" {
+		return
+	}
+
+	schedules, err := c.scheduleLister.Schedules(backup.Namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).Error("error listing schedules")
+		return
+	}
+
+	// the label's value is the schedule's name, shortened if it's too long to
+	// be a label value.
+	for _, schedule := range schedules {
+		if label.GetValidName(schedule.Name) == scheduleName {
+			c.enqueue(schedule)
+		}
+	}
+}
+
+// enqueueBackupScheduleOnPhaseChange enqueues the schedule that created the backup, if any,
+// when the backup's phase changes. Updates to a backup's other fields, such as its progress,
+// don't change which of its schedule's backups are kept.
+func (c *scheduleRetentionController) enqueueBackupScheduleOnPhaseChange(oldObj, newObj interface{}) {
+	oldBackup := oldObj.(*velerov1api.Backup)
+	newBackup := newObj.(*velerov1api.Backup)
+
+	if oldBackup.Status.Phase == newBackup.Status.Phase {
+		return
+	}
+
+	c.enqueueBackupSchedule(newObj)
+}
+
+func (c *scheduleRetentionController) processSchedule(key string) error {
+	log := c.logger.WithField("schedule", key)
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.Wrap(err, "error splitting queue key")
+	}
+
+	schedule, err := c.scheduleLister.Schedules(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.Debug("Unable to find schedule")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting schedule")
+	}
+
+	if !retention.Enabled(schedule.Spec.Retention) {
+		log.Debug("Schedule doesn't have a retention policy, skipping")
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(labels.Set(map[string]string{
+		velerov1api.ScheduleNameLabel: label.GetValidName(schedule.Name),
+	}))
+
+	backups, err := c.backupLister.Backups(ns).List(selector)
+	if err != nil {
+		return errors.Wrap(err, "error listing schedule's backups")
+	}
+
+	var errs []error
+	for _, decision := range retention.Evaluate(schedule.Spec.Retention, backups) {
+		if decision.Keep() {
+			continue
+		}
+
+		if err := c.pruneBackup(decision.Backup, log.WithField("backup", decision.Backup.Name)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
+
+// pruneBackup creates a DeleteBackupRequest for a backup that isn't kept by its
+// schedule's retention policy.
+func (c *scheduleRetentionController) pruneBackup(backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	loc, err := c.backupLocationLister.BackupStorageLocations(backup.Namespace).Get(backup.Spec.StorageLocation)
+	if apierrors.IsNotFound(err) {
+		log.Warnf("Backup cannot be pruned because backup storage location %s does not exist", backup.Spec.StorageLocation)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	if loc.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		log.Infof("Backup cannot be pruned because backup storage location %s is currently in read-only mode", loc.Name)
+		return nil
+	}
+
+	pending, err := hasPendingDeleteBackupRequest(c.deleteBackupRequestLister, backup)
+	if err != nil {
+		return err
+	}
+	if pending {
+		log.Debug("Backup already has a pending deletion request")
+		return nil
+	}
+
+	log.Info("Backup isn't kept by its schedule's retention policy, creating a new deletion request")
+	req := pkgbackup.NewDeleteBackupRequest(backup.Name, string(backup.UID))
+
+	if _, err := c.deleteBackupRequestClient.DeleteBackupRequests(backup.Namespace).Create(req); err != nil {
+		return errors.Wrap(err, "error creating DeleteBackupRequest")
+	}
+
+	return nil
+}
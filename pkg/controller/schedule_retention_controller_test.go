@@ -0,0 +1,237 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	core "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func scheduledBackup(name, schedule string, start time.Time) *builder.BackupBuilder {
+	return builder.ForBackup(velerov1api.DefaultNamespace, name).
+		ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, schedule)).
+		StorageLocation("default").
+		StartTimestamp(start).
+		Phase(velerov1api.BackupPhaseCompleted)
+}
+
+func TestScheduleRetentionControllerProcessSchedule(t *testing.T) {
+	now := time.Date(2020, 6, 10, 12, 0, 0, 0, time.UTC)
+	defaultBackupLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+
+	tests := []struct {
+		name                           string
+		schedule                       *velerov1api.Schedule
+		backups                        []*velerov1api.Backup
+		deleteBackupRequests           []*velerov1api.DeleteBackupRequest
+		backupLocation                 *velerov1api.BackupStorageLocation
+		createDeleteBackupRequestError bool
+		expectDeletions                []string
+		expectError                    bool
+	}{
+		{
+			name: "can't find schedule - no error",
+		},
+		{
+			name:           "schedule without a retention policy doesn't prune backups",
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Result(),
+			backupLocation: defaultBackupLocation,
+			backups: []*velerov1api.Backup{
+				scheduledBackup("sched-1", "sched", now.Add(-2*time.Hour)).Result(),
+				scheduledBackup("sched-2", "sched", now.Add(-time.Hour)).Result(),
+			},
+		},
+		{
+			name:           "backups that aren't kept by the policy are deleted",
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Retention(&velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backupLocation: defaultBackupLocation,
+			backups: []*velerov1api.Backup{
+				scheduledBackup("sched-1", "sched", now.Add(-3*time.Hour)).Result(),
+				scheduledBackup("sched-2", "sched", now.Add(-2*time.Hour)).Result(),
+				scheduledBackup("sched-3", "sched", now.Add(-time.Hour)).Result(),
+				scheduledBackup("sched-4", "sched", now).Phase(velerov1api.BackupPhaseInProgress).Result(),
+				scheduledBackup("other-1", "other", now.Add(-4*time.Hour)).Result(),
+			},
+			expectDeletions: []string{"sched-1", "sched-2"},
+		},
+		{
+			name:           "newest successful backup isn't deleted",
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Retention(&velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backupLocation: defaultBackupLocation,
+			backups: []*velerov1api.Backup{
+				scheduledBackup("sched-1", "sched", now.Add(-2*time.Hour)).Result(),
+				scheduledBackup("sched-2", "sched", now.Add(-time.Hour)).Result(),
+				scheduledBackup("sched-3", "sched", now).Phase(velerov1api.BackupPhasePartiallyFailed).Result(),
+			},
+			expectDeletions: []string{"sched-1"},
+		},
+		{
+			name:           "backups in a read-only storage location aren't deleted",
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Retention(&velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backupLocation: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			backups: []*velerov1api.Backup{
+				scheduledBackup("sched-1", "sched", now.Add(-2*time.Hour)).Result(),
+				scheduledBackup("sched-2", "sched", now.Add(-time.Hour)).Result(),
+			},
+		},
+		{
+			name:           "backups with a pending deletion request aren't deleted again",
+			schedule:       builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Retention(&velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backupLocation: defaultBackupLocation,
+			backups: []*velerov1api.Backup{
+				scheduledBackup("sched-1", "sched", now.Add(-3*time.Hour)).Result(),
+				scheduledBackup("sched-2", "sched", now.Add(-2*time.Hour)).Result(),
+				scheduledBackup("sched-3", "sched", now.Add(-time.Hour)).Result(),
+			},
+			deleteBackupRequests: []*velerov1api.DeleteBackupRequest{
+				{
+					ObjectMeta: builder.ForBackup(velerov1api.DefaultNamespace, "dbr").ObjectMeta(
+						builder.WithLabels(velerov1api.BackupNameLabel, "sched-1", velerov1api.BackupUIDLabel, ""),
+					).Result().ObjectMeta,
+					Status: velerov1api.DeleteBackupRequestStatus{
+						Phase: velerov1api.DeleteBackupRequestPhaseNew,
+					},
+				},
+			},
+			expectDeletions: []string{"sched-2"},
+		},
+		{
+			name:                           "create DeleteBackupRequest error returns an error",
+			schedule:                       builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Retention(&velerov1api.RetentionPolicy{KeepLast: 1}).Result(),
+			backupLocation:                 defaultBackupLocation,
+			createDeleteBackupRequestError: true,
+			backups: []*velerov1api.Backup{
+				scheduledBackup("sched-1", "sched", now.Add(-2*time.Hour)).Result(),
+				scheduledBackup("sched-2", "sched", now.Add(-time.Hour)).Result(),
+			},
+			expectDeletions: []string{"sched-1"},
+			expectError:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				client          = fake.NewSimpleClientset()
+				sharedInformers = informers.NewSharedInformerFactory(client, 0)
+			)
+
+			controller := NewScheduleRetentionController(
+				velerotest.NewLogger(),
+				sharedInformers.Velero().V1().Schedules(),
+				sharedInformers.Velero().V1().Backups(),
+				sharedInformers.Velero().V1().DeleteBackupRequests(),
+				client.VeleroV1(),
+				sharedInformers.Velero().V1().BackupStorageLocations(),
+			).(*scheduleRetentionController)
+
+			key := velerov1api.DefaultNamespace + "/sched"
+			if test.schedule != nil {
+				require.NoError(t, sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(test.schedule))
+			}
+			for _, backup := range test.backups {
+				require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			}
+			for _, dbr := range test.deleteBackupRequests {
+				require.NoError(t, sharedInformers.Velero().V1().DeleteBackupRequests().Informer().GetStore().Add(dbr))
+			}
+			if test.backupLocation != nil {
+				require.NoError(t, sharedInformers.Velero().V1().BackupStorageLocations().Informer().GetStore().Add(test.backupLocation))
+			}
+
+			// the fake client doesn't generate names, so creating a second
+			// DeleteBackupRequest would fail with an "already exists" error.
+			client.PrependReactor("create", "deletebackuprequests", func(action core.Action) (bool, runtime.Object, error) {
+				if test.createDeleteBackupRequestError {
+					return true, nil, errors.New("foo")
+				}
+				return true, action.(core.CreateAction).GetObject(), nil
+			})
+
+			err := controller.processSchedule(key)
+			assert.Equal(t, test.expectError, err != nil)
+
+			var deletions []string
+			for _, action := range client.Actions() {
+				createAction, ok := action.(core.CreateAction)
+				require.True(t, ok)
+				require.Equal(t, "deletebackuprequests", createAction.GetResource().Resource)
+
+				dbr := createAction.GetObject().(*velerov1api.DeleteBackupRequest)
+				deletions = append(deletions, dbr.Spec.BackupName)
+			}
+			sort.Strings(deletions)
+			assert.Equal(t, test.expectDeletions, deletions)
+		})
+	}
+}
+
+func TestScheduleRetentionControllerEnqueueBackupSchedule(t *testing.T) {
+	var (
+		client          = fake.NewSimpleClientset()
+		sharedInformers = informers.NewSharedInformerFactory(client, 0)
+	)
+
+	controller := NewScheduleRetentionController(
+		velerotest.NewLogger(),
+		sharedInformers.Velero().V1().Schedules(),
+		sharedInformers.Velero().V1().Backups(),
+		sharedInformers.Velero().V1().DeleteBackupRequests(),
+		client.VeleroV1(),
+		sharedInformers.Velero().V1().BackupStorageLocations(),
+	).(*scheduleRetentionController)
+
+	require.NoError(t, sharedInformers.Velero().V1().Schedules().Informer().GetStore().Add(builder.ForSchedule(velerov1api.DefaultNamespace, "sched").Result()))
+
+	controller.enqueueBackupSchedule(builder.ForBackup(velerov1api.DefaultNamespace, "manual").Result())
+	assert.Equal(t, 0, controller.queue.Len())
+
+	controller.enqueueBackupSchedule(scheduledBackup("sched-1", "sched", time.Now()).Result())
+	require.Equal(t, 1, controller.queue.Len())
+
+	key, _ := controller.queue.Get()
+	assert.Equal(t, velerov1api.DefaultNamespace+"/sched", key)
+	controller.queue.Done(key)
+
+	// updates that don't change the backup's phase are ignored.
+	inProgress := scheduledBackup("sched-1", "sched", time.Now()).Phase(velerov1api.BackupPhaseInProgress).Result()
+	updated := inProgress.DeepCopy()
+	updated.Status.Progress = &velerov1api.BackupProgress{TotalItems: 10, ItemsBackedUp: 5}
+	controller.enqueueBackupScheduleOnPhaseChange(inProgress, updated)
+	assert.Equal(t, 0, controller.queue.Len())
+
+	completed := updated.DeepCopy()
+	completed.Status.Phase = velerov1api.BackupPhaseCompleted
+	controller.enqueueBackupScheduleOnPhaseChange(updated, completed)
+	require.Equal(t, 1, controller.queue.Len())
+
+	key, _ = controller.queue.Get()
+	assert.Equal(t, velerov1api.DefaultNamespace+"/sched", key)
+}
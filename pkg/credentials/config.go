@@ -0,0 +1,54 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+)
+
+// CredentialsFileKey is the key within a location's config that holds the path to
+// the file containing the location's credentials. Plugins that support per-location
+// credentials should use this file instead of the credentials mounted into the Velero
+// deployment when it's present.
+const CredentialsFileKey = "credentialsFile"
+
+// ConfigWithCredentialsFile returns a copy of the given location config with the path
+// to the file containing the credential referenced by selector added under
+// CredentialsFileKey. If selector is nil, the config is returned unmodified.
+func ConfigWithCredentialsFile(config map[string]string, selector *corev1api.SecretKeySelector, store FileStore) (map[string]string, error) {
+	if selector == nil {
+		return config, nil
+	}
+
+	if store == nil {
+		return nil, errors.New("unable to use location credential: no credentials file store configured")
+	}
+
+	path, err := store.Path(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting location credentials file")
+	}
+
+	res := make(map[string]string, len(config)+1)
+	for k, v := range config {
+		res[k] = v
+	}
+	res[CredentialsFileKey] = path
+
+	return res, nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// DefaultStoreDirectory is the directory under which credentials
+// files are written by the Velero server and restic daemonset.
+const DefaultStoreDirectory = "/tmp/credentials"
+
+// FileStore defines operations for interacting with credentials
+// that are stored on a file system.
+type FileStore interface {
+	// Path returns a path on disk where the secret key defined by
+	// the given selector is serialized.
+	Path(selector *corev1api.SecretKeySelector) (string, error)
+}
+
+type namespacedFileStore struct {
+	secretsGetter corev1client.SecretsGetter
+	namespace     string
+	fsRoot        string
+	fs            filesystem.Interface
+}
+
+// NewNamespacedFileStore returns a FileStore which can interact with credentials
+// for the given namespace and will store them under the given fsRoot.
+func NewNamespacedFileStore(secretsGetter corev1client.SecretsGetter, namespace string, fsRoot string, fs filesystem.Interface) (FileStore, error) {
+	fsNamespaceRoot := filepath.Join(fsRoot, namespace)
+
+	if err := fs.MkdirAll(fsNamespaceRoot, 0755); err != nil {
+		return nil, errors.Wrapf(err, "error creating credentials directory %s", fsNamespaceRoot)
+	}
+
+	return &namespacedFileStore{
+		secretsGetter: secretsGetter,
+		namespace:     namespace,
+		fsRoot:        fsNamespaceRoot,
+		fs:            fs,
+	}, nil
+}
+
+// Path returns a path on disk where the secret key defined by
+// the given selector is serialized.
+func (n *namespacedFileStore) Path(selector *corev1api.SecretKeySelector) (string, error) {
+	secret, err := n.secretsGetter.Secrets(n.namespace).Get(selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting secret %s/%s", n.namespace, selector.Name)
+	}
+
+	data, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", errors.Errorf("secret %s/%s does not contain key %q", n.namespace, selector.Name, selector.Key)
+	}
+
+	keyFilePath := filepath.Join(n.fsRoot, fmt.Sprintf("%s-%s", selector.Name, selector.Key))
+
+	// the file is read by plugins and restic while other goroutines may be asking
+	// for its path, so only replace it if the secret has changed.
+	if existing, err := n.fs.ReadFile(keyFilePath); err == nil && bytes.Equal(existing, data) {
+		return keyFilePath, nil
+	}
+
+	if err := n.writeFile(keyFilePath, data); err != nil {
+		return "", err
+	}
+
+	return keyFilePath, nil
+}
+
+// writeFile writes data to a temp file, which is only readable by its owner, and
+// renames it to path, so that readers of path never see a partially written file.
+func (n *namespacedFileStore) writeFile(path string, data []byte) error {
+	file, err := n.fs.TempFile(n.fsRoot, "."+filepath.Base(path)+"-")
+	if err != nil {
+		return errors.Wrapf(err, "error creating credentials file %s", path)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		n.fs.RemoveAll(file.Name())
+		return errors.Wrapf(err, "error writing credentials file %s", path)
+	}
+
+	if err := file.Close(); err != nil {
+		n.fs.RemoveAll(file.Name())
+		return errors.Wrapf(err, "error writing credentials file %s", path)
+	}
+
+	if err := n.fs.Rename(file.Name(), path); err != nil {
+		n.fs.RemoveAll(file.Name())
+		return errors.Wrapf(err, "error renaming credentials file to %s", path)
+	}
+
+	return nil
+}
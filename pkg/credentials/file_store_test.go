@@ -0,0 +1,194 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNamespacedFileStore(t *testing.T) {
+	testCases := []struct {
+		name             string
+		namespace        string
+		fsRoot           string
+		secrets          []*corev1api.Secret
+		secretName       string
+		secretKey        string
+		wantErr          string
+		expectedPath     string
+		expectedContents string
+	}{
+		{
+			name:       "returns an error if the secret can't be found",
+			secretName: "non-existent-secret",
+			secretKey:  "secret-key",
+			wantErr:    "error getting secret ns-1/non-existent-secret",
+		},
+		{
+			name:      "returns an error if the secret key can't be found",
+			namespace: "ns-1",
+			secrets: []*corev1api.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "credential"},
+					Data:       map[string][]byte{"key-1": []byte("secret-data")},
+				},
+			},
+			secretName: "credential",
+			secretKey:  "non-existent-key",
+			wantErr:    `secret ns-1/credential does not contain key "non-existent-key"`,
+		},
+		{
+			name:      "returns a filepath formed using fsRoot, namespace, secret name and key, with secret contents",
+			namespace: "ns-1",
+			fsRoot:    "/tmp/credentials",
+			secrets: []*corev1api.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "credential"},
+					Data:       map[string][]byte{"key-1": []byte("first"), "key-2": []byte("second")},
+				},
+			},
+			secretName:       "credential",
+			secretKey:        "key-2",
+			expectedPath:     "/tmp/credentials/ns-1/credential-key-2",
+			expectedContents: "second",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespace := tc.namespace
+			if namespace == "" {
+				namespace = "ns-1"
+			}
+
+			client := fake.NewSimpleClientset()
+			for _, secret := range tc.secrets {
+				_, err := client.CoreV1().Secrets(secret.Namespace).Create(secret)
+				require.NoError(t, err)
+			}
+
+			fs := velerotest.NewFakeFileSystem()
+			fileStore, err := NewNamespacedFileStore(client.CoreV1(), namespace, tc.fsRoot, fs)
+			require.NoError(t, err)
+
+			path, err := fileStore.Path(&corev1api.SecretKeySelector{
+				LocalObjectReference: corev1api.LocalObjectReference{Name: tc.secretName},
+				Key:                  tc.secretKey,
+			})
+
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPath, path)
+
+			contents, err := fs.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContents, string(contents))
+		})
+	}
+}
+
+func TestNamespacedFileStoreUpdatesFile(t *testing.T) {
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "credential"},
+		Data:       map[string][]byte{"key-1": []byte("first")},
+	}
+	selector := &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: "credential"},
+		Key:                  "key-1",
+	}
+
+	client := fake.NewSimpleClientset(secret)
+	fs := velerotest.NewFakeFileSystem()
+	fileStore, err := NewNamespacedFileStore(client.CoreV1(), "ns-1", "/tmp/credentials", fs)
+	require.NoError(t, err)
+
+	path, err := fileStore.Path(selector)
+	require.NoError(t, err)
+
+	info, err := fs.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// unchanged contents are left as is.
+	_, err = fileStore.Path(selector)
+	require.NoError(t, err)
+
+	secret.Data["key-1"] = []byte("second")
+	_, err = client.CoreV1().Secrets("ns-1").Update(secret)
+	require.NoError(t, err)
+
+	path, err = fileStore.Path(selector)
+	require.NoError(t, err)
+
+	contents, err := fs.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(contents))
+
+	// no temp files are left behind.
+	files, err := fs.ReadDir("/tmp/credentials/ns-1")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "credential-key-1", files[0].Name())
+}
+
+func TestConfigWithCredentialsFile(t *testing.T) {
+	selector := &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{Name: "credential"},
+		Key:                  "cloud",
+	}
+
+	t.Run("config is returned unmodified if there's no credential", func(t *testing.T) {
+		config := map[string]string{"region": "us-east-1"}
+
+		res, err := ConfigWithCredentialsFile(config, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, config, res)
+	})
+
+	t.Run("credentials file path is added to a copy of the config", func(t *testing.T) {
+		config := map[string]string{"region": "us-east-1"}
+
+		res, err := ConfigWithCredentialsFile(config, selector, velerotest.NewFakeCredentialsFileStore("/tmp/credentials/velero/credential-cloud", nil))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"region": "us-east-1", CredentialsFileKey: "/tmp/credentials/velero/credential-cloud"}, res)
+		assert.Equal(t, map[string]string{"region": "us-east-1"}, config)
+	})
+
+	t.Run("error getting the credentials file path is returned", func(t *testing.T) {
+		_, err := ConfigWithCredentialsFile(nil, selector, velerotest.NewFakeCredentialsFileStore("", errors.New("boom")))
+		assert.Error(t, err)
+	})
+
+	t.Run("error is returned if there's a credential but no file store", func(t *testing.T) {
+		_, err := ConfigWithCredentialsFile(nil, selector, nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+const (
+	// SnapshotAPIVersion is the API version of the CSI snapshot objects that
+	// Velero creates and restores.
+	SnapshotAPIVersion = "snapshot.storage.k8s.io/v1beta1"
+
+	// VolumeSnapshotClassSelectorLabel is the label key used to select the
+	// VolumeSnapshotClass to use for a CSI driver when more than one exists.
+	VolumeSnapshotClassSelectorLabel = "velero.io/csi-volumesnapshot-class"
+
+	// DefaultSnapshotReadyTimeout is how long to wait for a VolumeSnapshot to
+	// become ready to use before considering the snapshot a failure.
+	DefaultSnapshotReadyTimeout = 10 * time.Minute
+
+	// DeletionPolicyDelete is the VolumeSnapshotContent deletion policy that
+	// causes the underlying storage snapshot to be deleted along with the object.
+	DeletionPolicyDelete = "Delete"
+
+	// DeletionPolicyRetain is the VolumeSnapshotContent deletion policy that
+	// keeps the underlying storage snapshot when the object is deleted.
+	DeletionPolicyRetain = "Retain"
+)
+
+var (
+	VolumeSnapshotClassesGVR  = kuberesource.VolumeSnapshotClasses.WithVersion("v1beta1")
+	VolumeSnapshotsGVR        = kuberesource.VolumeSnapshots.WithVersion("v1beta1")
+	VolumeSnapshotContentsGVR = kuberesource.VolumeSnapshotContents.WithVersion("v1beta1")
+)
+
+// NewVolumeSnapshot returns a VolumeSnapshot for the specified persistent volume
+// claim, using the specified VolumeSnapshotClass, and labeled with the backup name.
+func NewVolumeSnapshot(namespace, pvcName, className, backupName string) *unstructured.Unstructured {
+	vs := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"volumeSnapshotClassName": className,
+				"source": map[string]interface{}{
+					"persistentVolumeClaimName": pvcName,
+				},
+			},
+		},
+	}
+	vs.SetAPIVersion(SnapshotAPIVersion)
+	vs.SetKind("VolumeSnapshot")
+	vs.SetNamespace(namespace)
+	vs.SetGenerateName(fmt.Sprintf("velero-%s-", pvcName))
+	vs.SetLabels(map[string]string{
+		velerov1api.BackupNameLabel: label.GetValidName(backupName),
+	})
+
+	return vs
+}
+
+// GetVolumeSnapshotClassForDriver returns the VolumeSnapshotClass to use for
+// snapshots of volumes provisioned by the specified CSI driver. If more than one
+// class exists for the driver, the one labeled with VolumeSnapshotClassSelectorLabel
+// is used.
+func GetVolumeSnapshotClassForDriver(client dynamic.Interface, driver string) (*unstructured.Unstructured, error) {
+	list, err := client.Resource(VolumeSnapshotClassesGVR).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing volume snapshot classes")
+	}
+
+	var candidates []*unstructured.Unstructured
+	for i := range list.Items {
+		class := &list.Items[i]
+
+		classDriver, _, _ := unstructured.NestedString(class.Object, "driver")
+		if classDriver != driver {
+			continue
+		}
+
+		if class.GetLabels()[VolumeSnapshotClassSelectorLabel] == "true" {
+			return class, nil
+		}
+
+		candidates = append(candidates, class)
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, errors.Errorf("no volume snapshot class found for driver %s", driver)
+	case 1:
+		return candidates[0], nil
+	default:
+		return nil, errors.Errorf("found %d volume snapshot classes for driver %s, label one of them with %s=true", len(candidates), driver, VolumeSnapshotClassSelectorLabel)
+	}
+}
+
+// IsVolumeSnapshotReady returns true if the provided VolumeSnapshot or
+// VolumeSnapshotContent is ready to use.
+func IsVolumeSnapshotReady(obj *unstructured.Unstructured) bool {
+	ready, _, _ := unstructured.NestedBool(obj.Object, "status", "readyToUse")
+	return ready
+}
+
+// GetBoundVolumeSnapshotContentName returns the name of the VolumeSnapshotContent
+// that the provided VolumeSnapshot is bound to, or an empty string if it's not bound.
+func GetBoundVolumeSnapshotContentName(vs *unstructured.Unstructured) string {
+	name, _, _ := unstructured.NestedString(vs.Object, "status", "boundVolumeSnapshotContentName")
+	return name
+}
+
+// WaitUntilVolumeSnapshotReady polls the specified VolumeSnapshot until it's ready
+// to use and bound to a VolumeSnapshotContent, and returns it. An error is returned
+// if the timeout is reached, if ctx is canceled, or if the snapshot reports an error.
+func WaitUntilVolumeSnapshotReady(ctx context.Context, client dynamic.Interface, namespace, name string, timeout time.Duration) (*unstructured.Unstructured, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var vs *unstructured.Unstructured
+
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		var err error
+		vs, err = client.Resource(VolumeSnapshotsGVR).Namespace(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error getting volume snapshot %s/%s", namespace, name)
+		}
+
+		if msg, found, _ := unstructured.NestedString(vs.Object, "status", "error", "message"); found && msg != "" {
+			return false, errors.Errorf("volume snapshot %s/%s failed: %s", namespace, name, msg)
+		}
+
+		return IsVolumeSnapshotReady(vs) && GetBoundVolumeSnapshotContentName(vs) != "", nil
+	}, timeoutCtx.Done())
+	if err == wait.ErrWaitTimeout && ctx.Err() != nil {
+		return nil, errors.Wrapf(ctx.Err(), "stopped waiting for volume snapshot %s/%s to be ready to use", namespace, name)
+	}
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.Errorf("timed out waiting for volume snapshot %s/%s to be ready to use", namespace, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+// SetDeletionPolicy patches the specified VolumeSnapshotContent to use the
+// specified deletion policy.
+func SetDeletionPolicy(client dynamic.Interface, contentName, policy string) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"deletionPolicy":%q}}`, policy))
+	if _, err := client.Resource(VolumeSnapshotContentsGVR).Patch(contentName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "error setting deletion policy for volume snapshot content %s", contentName)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotsForBackup deletes the VolumeSnapshots and VolumeSnapshotContents
+// that were created for the specified backup, along with the underlying storage
+// snapshots. It returns a slice of any errors that were encountered.
+func DeleteSnapshotsForBackup(client dynamic.Interface, backupName string, log logrus.FieldLogger) []error {
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", velerov1api.BackupNameLabel, label.GetValidName(backupName)),
+	}
+
+	contents, err := client.Resource(VolumeSnapshotContentsGVR).List(listOptions)
+	if err != nil {
+		return []error{errors.Wrap(err, "error listing volume snapshot contents")}
+	}
+
+	var errs []error
+
+	// Set every content's deletion policy to Delete before deleting anything, so
+	// that the storage snapshots are removed along with the API objects regardless
+	// of the policy of the class they were created from.
+	for _, content := range contents.Items {
+		if err := SetDeletionPolicy(client, content.GetName(), DeletionPolicyDelete); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	snapshots, err := client.Resource(VolumeSnapshotsGVR).List(listOptions)
+	if err != nil {
+		return append(errs, errors.Wrap(err, "error listing volume snapshots"))
+	}
+
+	for _, vs := range snapshots.Items {
+		log.WithField("volumeSnapshot", fmt.Sprintf("%s/%s", vs.GetNamespace(), vs.GetName())).Info("Deleting CSI volume snapshot")
+
+		err := client.Resource(VolumeSnapshotsGVR).Namespace(vs.GetNamespace()).Delete(vs.GetName(), &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "error deleting volume snapshot %s/%s", vs.GetNamespace(), vs.GetName()))
+		}
+	}
+
+	for _, content := range contents.Items {
+		log.WithField("volumeSnapshotContent", content.GetName()).Info("Deleting CSI volume snapshot content")
+
+		err := client.Resource(VolumeSnapshotContentsGVR).Delete(content.GetName(), &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "error deleting volume snapshot content %s", content.GetName()))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newVolumeSnapshotClass(name, driver string, labels map[string]string) *unstructured.Unstructured {
+	class := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"driver":         driver,
+			"deletionPolicy": DeletionPolicyDelete,
+		},
+	}
+	class.SetAPIVersion(SnapshotAPIVersion)
+	class.SetKind("VolumeSnapshotClass")
+	class.SetName(name)
+	class.SetLabels(labels)
+
+	return class
+}
+
+func newVolumeSnapshotContent(name string, labels map[string]string) *unstructured.Unstructured {
+	content := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"deletionPolicy": DeletionPolicyRetain,
+			},
+		},
+	}
+	content.SetAPIVersion(SnapshotAPIVersion)
+	content.SetKind("VolumeSnapshotContent")
+	content.SetName(name)
+	content.SetLabels(labels)
+
+	return content
+}
+
+func TestGetVolumeSnapshotClassForDriver(t *testing.T) {
+	tests := []struct {
+		name      string
+		classes   []runtime.Object
+		driver    string
+		want      string
+		wantError bool
+	}{
+		{
+			name:      "no classes returns an error",
+			driver:    "driver-1",
+			wantError: true,
+		},
+		{
+			name: "a single class for the driver is returned",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-1", "driver-1", nil),
+				newVolumeSnapshotClass("class-2", "driver-2", nil),
+			},
+			driver: "driver-1",
+			want:   "class-1",
+		},
+		{
+			name: "the labeled class is returned when there are several for the driver",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-1", "driver-1", nil),
+				newVolumeSnapshotClass("class-2", "driver-1", map[string]string{VolumeSnapshotClassSelectorLabel: "true"}),
+			},
+			driver: "driver-1",
+			want:   "class-2",
+		},
+		{
+			name: "several unlabeled classes for the driver returns an error",
+			classes: []runtime.Object{
+				newVolumeSnapshotClass("class-1", "driver-1", nil),
+				newVolumeSnapshotClass("class-2", "driver-1", nil),
+			},
+			driver:    "driver-1",
+			wantError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), tc.classes...)
+
+			class, err := GetVolumeSnapshotClassForDriver(client, tc.driver)
+			if tc.wantError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, class.GetName())
+		})
+	}
+}
+
+func TestWaitUntilVolumeSnapshotReady(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    map[string]interface{}
+		wantError bool
+	}{
+		{
+			name: "ready and bound snapshot is returned",
+			status: map[string]interface{}{
+				"readyToUse":                     true,
+				"boundVolumeSnapshotContentName": "content-1",
+			},
+		},
+		{
+			name: "snapshot with an error returns an error",
+			status: map[string]interface{}{
+				"error": map[string]interface{}{
+					"message": "snapshot failed",
+				},
+			},
+			wantError: true,
+		},
+		{
+			name:      "snapshot that never becomes ready times out",
+			status:    map[string]interface{}{},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vs := NewVolumeSnapshot("ns-1", "pvc-1", "class-1", "backup-1")
+			vs.SetName("vs-1")
+			vs.Object["status"] = tc.status
+
+			client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), vs)
+
+			res, err := WaitUntilVolumeSnapshotReady(context.Background(), client, "ns-1", "vs-1", 10*time.Millisecond)
+			if tc.wantError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "content-1", GetBoundVolumeSnapshotContentName(res))
+		})
+	}
+}
+
+func TestWaitUntilVolumeSnapshotReadyCanceled(t *testing.T) {
+	vs := NewVolumeSnapshot("ns-1", "pvc-1", "class-1", "backup-1")
+	vs.SetName("vs-1")
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), vs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := WaitUntilVolumeSnapshotReady(ctx, client, "ns-1", "vs-1", time.Minute)
+	require.Error(t, err)
+	assert.Equal(t, context.Canceled, errors.Cause(err))
+}
+
+func TestDeleteSnapshotsForBackup(t *testing.T) {
+	backupLabels := map[string]string{velerov1api.BackupNameLabel: "backup-1"}
+	otherLabels := map[string]string{velerov1api.BackupNameLabel: "backup-2"}
+
+	otherVS := NewVolumeSnapshot("ns-1", "pvc-2", "class-1", "backup-2")
+	otherVS.SetName("vs-2")
+	vs := NewVolumeSnapshot("ns-1", "pvc-1", "class-1", "backup-1")
+	vs.SetName("vs-1")
+
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		vs,
+		otherVS,
+		newVolumeSnapshotContent("content-1", backupLabels),
+		newVolumeSnapshotContent("content-2", otherLabels),
+	)
+
+	errs := DeleteSnapshotsForBackup(client, "backup-1", velerotest.NewLogger())
+	assert.Empty(t, errs)
+
+	_, err := client.Resource(VolumeSnapshotsGVR).Namespace("ns-1").Get("vs-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = client.Resource(VolumeSnapshotContentsGVR).Get("content-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	_, err = client.Resource(VolumeSnapshotsGVR).Namespace("ns-1").Get("vs-2", metav1.GetOptions{})
+	assert.NoError(t, err)
+	content, err := client.Resource(VolumeSnapshotContentsGVR).Get("content-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	policy, _, _ := unstructured.NestedString(content.Object, "spec", "deletionPolicy")
+	assert.Equal(t, DeletionPolicyRetain, policy)
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the client-side encryption of backup data
+// that Velero stores in object storage.
+//
+// Data is encrypted with AES-GCM in fixed-size chunks so that arbitrarily large
+// backup tarballs can be encrypted and decrypted as streams. The encrypted
+// format is a header, made of a magic string, a format version and a random
+// nonce prefix, followed by the sealed chunks. Each chunk's nonce is the nonce
+// prefix followed by the chunk's index, and the final chunk is sealed with
+// different additional data than the others, so that reordered, dropped or
+// truncated chunks are detected on decryption.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// chunkSize is the maximum number of plaintext bytes sealed in one chunk.
+	chunkSize = 64 * 1024
+
+	formatVersion   = 1
+	noncePrefixSize = 8
+)
+
+var (
+	magic = []byte("VLRENC")
+
+	headerSize = len(magic) + 1 + noncePrefixSize
+
+	notFinalChunk = []byte{0}
+	finalChunk    = []byte{1}
+)
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating AES cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating GCM cipher")
+	}
+
+	return aead, nil
+}
+
+func chunkNonce(aead cipher.AEAD, prefix []byte, counter uint32) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[len(nonce)-4:], counter)
+	return nonce
+}
+
+type writer struct {
+	dst     io.Writer
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	started bool
+	closed  bool
+}
+
+// NewWriter returns a writer that encrypts the data written to it with the given
+// AES key, which must be 16, 24 or 32 bytes long, and writes it to dst. The returned
+// writer must be closed to write the final chunk; closing it does not close dst.
+// Nothing is written to dst until the first chunk is full or the writer is closed.
+func NewWriter(dst io.Writer, key []byte) (io.WriteCloser, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+
+	return &writer{
+		dst:    dst,
+		aead:   aead,
+		prefix: prefix,
+		buf:    make([]byte, 0, chunkSize),
+	}, nil
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("write to closed encryption writer")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		// only seal a full chunk once there's more data, so that
+		// the last chunk is always sealed as the final one.
+		if len(w.buf) == chunkSize {
+			if err := w.seal(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := copy(w.buf[len(w.buf):chunkSize], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+func (w *writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	return w.seal(true)
+}
+
+func (w *writer) seal(final bool) error {
+	if !w.started {
+		header := append(append(append([]byte{}, magic...), formatVersion), w.prefix...)
+		if _, err := w.dst.Write(header); err != nil {
+			return errors.WithStack(err)
+		}
+		w.started = true
+	}
+
+	if w.counter == math.MaxUint32 {
+		return errors.New("too much data to encrypt")
+	}
+
+	ad := notFinalChunk
+	if final {
+		ad = finalChunk
+	}
+
+	sealed := w.aead.Seal(nil, chunkNonce(w.aead, w.prefix, w.counter), w.buf, ad)
+	if _, err := w.dst.Write(sealed); err != nil {
+		return errors.WithStack(err)
+	}
+
+	w.counter++
+	w.buf = w.buf[:0]
+
+	return nil
+}
+
+type reader struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	sealed  []byte
+	opened  []byte
+	buf     []byte
+	done    bool
+}
+
+// NewReader returns a reader that decrypts the data read from src, which must have
+// been encrypted by a writer returned by NewWriter with the same key. Reads return an
+// error if the data was modified or truncated.
+func NewReader(src io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return nil, errors.Wrap(err, "error reading encryption header")
+	}
+	if !bytes.Equal(header[:len(magic)], magic) {
+		return nil, errors.New("data is not encrypted")
+	}
+	if version := header[len(magic)]; version != formatVersion {
+		return nil, errors.Errorf("unsupported encryption format version %d", version)
+	}
+
+	return &reader{
+		src:    bufio.NewReaderSize(src, chunkSize+aead.Overhead()),
+		aead:   aead,
+		prefix: header[len(magic)+1:],
+		sealed: make([]byte, chunkSize+aead.Overhead()),
+		opened: make([]byte, 0, chunkSize),
+	}, nil
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+func (r *reader) open() error {
+	n, err := io.ReadFull(r.src, r.sealed)
+	final := false
+	switch {
+	case err == io.EOF:
+		// every encrypted stream ends with a final chunk, even if it's empty.
+		return errors.New("encrypted data is truncated")
+	case err == io.ErrUnexpectedEOF:
+		final = true
+	case err != nil:
+		return errors.WithStack(err)
+	default:
+		if _, err := r.src.Peek(1); err == io.EOF {
+			final = true
+		}
+	}
+
+	ad := notFinalChunk
+	if final {
+		ad = finalChunk
+	}
+
+	plaintext, err := r.aead.Open(r.opened[:0], chunkNonce(r.aead, r.prefix, r.counter), r.sealed[:n], ad)
+	if err != nil {
+		return errors.Wrap(err, "error decrypting data, it may have been modified or encrypted with a different key")
+	}
+
+	r.counter++
+	r.buf = plaintext
+	r.done = final
+
+	return nil
+}
+
+// NewEncryptingReader returns a reader that produces the data read from src encrypted
+// with the given key, in the format written by NewWriter. The returned reader must be
+// closed once it's no longer needed, even if it wasn't read to the end.
+func NewEncryptingReader(src io.Reader, key []byte) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+
+	w, err := NewWriter(pw, key)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_, err := io.Copy(w, src)
+		if err == nil {
+			err = w.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr, nil
+}
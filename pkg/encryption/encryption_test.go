@@ -0,0 +1,222 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func encrypt(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, key)
+	require.NoError(t, err)
+
+	// write in uneven pieces to exercise chunk boundaries
+	for len(data) > 0 {
+		n := 1 + rand.Intn(3*chunkSize/2)
+		if n > len(data) {
+			n = len(data)
+		}
+		_, err := w.Write(data[:n])
+		require.NoError(t, err)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func decrypt(key, data []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte("k"), 32)
+
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "less than a chunk", size: 100},
+		{name: "exactly one chunk", size: chunkSize},
+		{name: "exactly several chunks", size: 3 * chunkSize},
+		{name: "several chunks and a partial chunk", size: 3*chunkSize + 17},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data := make([]byte, tc.size)
+			rand.Read(data)
+
+			encrypted := encrypt(t, key, data)
+			if tc.size > 0 {
+				assert.NotContains(t, string(encrypted), string(data))
+			}
+
+			res, err := decrypt(key, encrypted)
+			require.NoError(t, err)
+			assert.Equal(t, data, res)
+		})
+	}
+}
+
+func TestEncryptingReader(t *testing.T) {
+	key := bytes.Repeat([]byte("k"), 16)
+	data := make([]byte, 2*chunkSize+5)
+	rand.Read(data)
+
+	r, err := NewEncryptingReader(bytes.NewReader(data), key)
+	require.NoError(t, err)
+	defer r.Close()
+
+	encrypted, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+
+	res, err := decrypt(key, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key := bytes.Repeat([]byte("k"), 32)
+	data := make([]byte, 2*chunkSize+5)
+	rand.Read(data)
+	encrypted := encrypt(t, key, data)
+
+	tests := []struct {
+		name      string
+		key       []byte
+		data      []byte
+		expectErr string
+	}{
+		{
+			name:      "wrong key",
+			key:       bytes.Repeat([]byte("x"), 32),
+			data:      encrypted,
+			expectErr: "error decrypting data, it may have been modified or encrypted with a different key: cipher: message authentication failed",
+		},
+		{
+			name:      "invalid key",
+			key:       []byte("short"),
+			data:      encrypted,
+			expectErr: "error creating AES cipher: crypto/aes: invalid key size 5",
+		},
+		{
+			name:      "unencrypted data",
+			key:       key,
+			data:      bytes.Repeat([]byte("d"), 100),
+			expectErr: "data is not encrypted",
+		},
+		{
+			name: "modified data",
+			key:  key,
+			data: func() []byte {
+				res := append([]byte{}, encrypted...)
+				res[headerSize+10] ^= 1
+				return res
+			}(),
+			expectErr: "error decrypting data, it may have been modified or encrypted with a different key: cipher: message authentication failed",
+		},
+		{
+			name:      "truncated on a chunk boundary",
+			key:       key,
+			data:      encrypted[:headerSize+chunkSize+16],
+			expectErr: "error decrypting data, it may have been modified or encrypted with a different key: cipher: message authentication failed",
+		},
+		{
+			name:      "final chunk removed",
+			key:       key,
+			data:      encrypted[:headerSize+2*(chunkSize+16)],
+			expectErr: "error decrypting data, it may have been modified or encrypted with a different key: cipher: message authentication failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decrypt(tc.key, tc.data)
+			assert.EqualError(t, err, tc.expectErr)
+		})
+	}
+}
+
+func TestGetKey(t *testing.T) {
+	secret := &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      KeysSecretName,
+		},
+		Data: map[string][]byte{
+			"key-1":   bytes.Repeat([]byte("k"), 32),
+			"invalid": []byte("short"),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		keyID     string
+		expectKey []byte
+		expectErr string
+	}{
+		{
+			name:      "existing key is returned",
+			keyID:     "key-1",
+			expectKey: bytes.Repeat([]byte("k"), 32),
+		},
+		{
+			name:      "missing key returns an error",
+			keyID:     "key-2",
+			expectErr: `secret velero/velero-backup-encryption-keys does not contain encryption key "key-2"`,
+		},
+		{
+			name:      "key of an invalid length returns an error",
+			keyID:     "invalid",
+			expectErr: `encryption key "invalid" must be 16, 24 or 32 bytes long, got 5 bytes`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(secret)
+
+			key, err := GetKey(client.CoreV1(), "velero", tc.keyID)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectKey, key)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+)
+
+// KeysSecretName is the name of the secret, in the Velero server's namespace,
+// that holds the keys backups are encrypted with. Each entry in the secret's data
+// is a key, and the entry's name is the key's ID.
+const KeysSecretName = "velero-backup-encryption-keys"
+
+// KeySelector returns a selector for the key with the given ID in the keys secret.
+func KeySelector(keyID string) *corev1api.SecretKeySelector {
+	return &corev1api.SecretKeySelector{
+		LocalObjectReference: corev1api.LocalObjectReference{
+			Name: KeysSecretName,
+		},
+		Key: keyID,
+	}
+}
+
+// GetKey returns the key with the given ID from the keys secret in the given namespace.
+func GetKey(secretsGetter corev1client.SecretsGetter, namespace, keyID string) ([]byte, error) {
+	secret, err := secretsGetter.Secrets(namespace).Get(KeysSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting secret %s/%s", namespace, KeysSecretName)
+	}
+
+	key, ok := secret.Data[keyID]
+	if !ok {
+		return nil, errors.Errorf("secret %s/%s does not contain encryption key %q", namespace, KeysSecretName, keyID)
+	}
+
+	return key, validateKey(keyID, key)
+}
+
+// GetKeyFromFileStore returns the key with the given ID from the keys secret, using the
+// given credentials file store to read it.
+func GetKeyFromFileStore(store credentials.FileStore, keyID string) ([]byte, error) {
+	if store == nil {
+		return nil, errors.New("unable to read encryption keys without a credentials store")
+	}
+
+	path, err := store.Path(KeySelector(keyID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting encryption key %q", keyID)
+	}
+
+	key, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading encryption key %q", keyID)
+	}
+
+	return key, validateKey(keyID, key)
+}
+
+func validateKey(keyID string, key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	default:
+		return errors.Errorf("encryption key %q must be 16, 24 or 32 bytes long, got %d bytes", keyID, len(key))
+	}
+}
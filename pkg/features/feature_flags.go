@@ -22,6 +22,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// APIGroupVersionsFeatureFlag is the feature flag string that defines whether or not
+// all of the API versions served for a resource's group are backed up, and restores
+// choose the best version the target cluster supports.
+const APIGroupVersionsFeatureFlag = "EnableAPIGroupVersions"
+
 type featureFlagSet struct {
 	set sets.String
 }
@@ -29,17 +29,17 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Mo#7\xb2w\xff\x8a\x82\xdfa\x12\xc0\xd2`\xf0.\x0f\xbeM<\x0e\x9e\x90y\x13#\xf6\xf3\x1e\x16{\xa0\xbaK\x12\xd7l\xb2\x97d\xcb\xd6.\xf6\xbf/X$\xfb\xfb\x83\x9a8\x19\x041O6E\x16\xeb\x9b\xc5b\xb1/V\xab\xd5\x05+\xf9#jÕ\xbc\x06Vr|\xb1(\xdd\u007ff\xfd\xf4?f\xcd\xd5\xfb\xe3\x87-Z\xf6\xe1\xe2\x89\xcb\xfc\x1an*cU\xf1\v\x1aU\xe9\f?\xe1\x8eKn\xb9\x92\x17\x05Z\x963ˮ/\x00\x98\x94\xca2\xd7mܿ\x00\x99\x92V+!P\xaf\xf6(\xd7O\xd5\x16\xb7\x15\x179jZ!\xae\xff]%\x9f\xa4z\x96\xdf_\x00d\x1a\t\xc2\x03/\xd0XV\x94\xd7 +!.\x00$+\xf0\x1a\xb6,{\xaaJ\xb3>\xa2@\xad\xd6\\]\x98\x123\xb7\xdc^\xab\xaa\xbc\x86\xe6\a?%\xa0\xe2\xc9\xf8\x81fS\x87\xe0\xc6\xfe\xd4\xea\xfc̍\xa5\x1fJQi&ꕨ\xcfp\xb9\xaf\x04ӱ\xf7\x02\xa0\xd4hP\x1f\xf1\xff=\xee?r\x14\xb9\xb9\x86\x1d\x13\x06/\x00L\xa6J\xbc\x86/\x0e\x83\x92e\x98_\x00\x1c\x99\xe09Q\xe7qR%ʏw\x9b\xc7\xff\xbe\xcf\x0eX0\xdf\t\x90\xa3\xc94/i\\@\x0e\xb8\x01\x06\x8fD\x1a\xe8 \x05\xb0\af\xdd\u007f\x84\x8a\xb4\x06\xec\x01!c\xa5\xad4\x82\xda\xc1O\xd5\x16\xb5D\x8b&@\x06\xc8De,j0\x96Y\x04f\x81A\xa9\xb8\xb4\xc0%X^ |\xf7\xf1n\x03j\xfbw̬\x01&s`ƨ\x8c3\x8b9\x1c\x95\xa8\n\xf4s\xbf_\a\x98\xa5V%j\xcb#\xa3]k)W\xddף\xeb\x9d#\u070f\x81ܩ\x13z\xf4\x83R`\x0e\x86\x98\xe2\xe8\xb0\an@c \x93\x18\xd8\x02\vn\b\x93\x01\xe95\xdc;\xa9h\x03\xe6\xa0*\x91;\x1d<\xa2v|\xca\xd4^\xf2\u007f\u0590\rXEK\nf1\xc8>6.-jɄ\x13Y\x85WĈ\x82\x9d@\xa3[\x03*قFC\xcc\x1a\xfeOi\x04.w\xea\x1a\x0e֖\xe6\xfa\xfd\xfb=\xb7ќ2U\x14\x95\xe4\xf6\xf4\x9e\x8c\x82o+\xab\xb4y\x9f\xe3\x11\xc5{\xc3\xf7+\xa6\xb3\x03\xb7\x989\xe1\xbdg%_\x11⒬i]\xe4\xff\x15\xa5n\u07b50\xb5'\xa7d\xc6j.\xf7u7\xa9\xfa$ߝ\xce{u\xf2\xd3<\xfe\r{]\x97\xe3\xca/\xb7\xf7\x0fmU\xe3\xa6\xcbs\xe2v3\xcd4\x8cw\x8c\xe2r\x87\xda\vn\xa7UA\x10Q\xe6^\xd7HM\x05G\xd9e\xba\xa9\xb6\x05\xb7N\xd2\xff\xa8\xd08uVk\xb8!\xa7\x02[\x84\xaa̝\x16\xaea#\xe1\x86\x15(n\x98\xc1ߜ\xed\x8e\xc3f\xe5X\xba\xcc\xf8\xb6/\xec\x0e\xf4ܪ\xbb\xa3\xcb\x1a\x95\x90\xb7\xf8\xfb\x12\xb3\x8ea\xb89|\xc73R\u007f\xd8)\xdd8\x04\xef\x93\xd6-\x80cF\xe9\x1a\xbed\xa2\xca1\xaf\xddR\xef\xf7\x1e*\xb7\x83\xe1\xe4\xd2\x19\x97N\u007f\x9c\au\xb6'\x9b_\xc9#1\x8d=\xa0\x00N\x86\\zh\xe4k\x0e8\x826\x99\x9e\xc5b\x80\xd5\x04\xc3\x03\xecJ\b\xb6\x15x\rVW\xfd\xa5\xfd<\xa65;\x8dr\"nii\x8c\xa8G\a\v\x12<#O[\xdb\t\xf1\xe2\x0fĆ\x83RO\xf3\xa4\xff\xaf\x1b\xd1\xd89d\x14\t\xc0\x16\x0f\xecȕ\x0e\xc4\x06g\xbbE\xc0\x17\xcc*K\xfb]\xb71\v9\xdf\xedP;(\xe5\x81\x194\u07b9O\xb1`J\x89]\xd3Sb\x1b\xe0߈\x8ci\xf4\xf4N\xa1\f\xcf\a\x94\x84̐\xbb\xbe\xb9\x9dX\xe6\xfc\xc8\xf3\x8a\t\xe0\xd2X&3O\a\xabq\xea\xd3\x01\xd3\xe2\x1c`\xeb\x8d?\xe2\xecx\xdfq\x04J\"(\r\x85\xf3yáf\x14>L\x92\xbbe\x06sP^\ru%Є\x85r\xf2/\x8d]_M\x00\xae\xa5\xe0wH\xc1\xb6(\xc0\xa0\xc0\xcc*=Ɔy\xa1\xfa\xb6\xec\xa3&x7⭂\xd3\f.\xb4\xed\xa8\xd4$L\x80\xe7\x03\xcf\x0e~\xf3r\xfaBP Wh\xc8~YY\x8a\xd38q0/i\xdffL\xb8i\xb3\xc6܇54\xeb\xa6-\xfa\xb9\xa6-x\xbc./k\xd1\xffyX\x19\x1d\xf7ي\xb9\x19L|M\xc5tL\xe4.\x00\xdd\xec\x00\x8bҞ\xae\x80\xdb\xd8\xebb\\FǧI\xf6\xd4k\xff\xe1\x04q\xaeNo\xfa\xf3^Q\xa7\u007f\xa5\x14\xea\xa5\xff0B g\u007f\x1f|}\xa2\x00>\xb7\xe7\\\x01\xdf\xd5\x02ȯ`ǅEݓ\xc4\x1c\xb9j^\x12\xbf\x96\x05\xcb;\x95k\x05\xb3\xd9\xe1\xf6\xc5EG\xa6I|$q\xa3?\xd5ǔ1\xaa\xeen\xa6\xb3P\x81\x8eL\\c\xe1\x0fb\x0f\xc4\xc1\xa6\x87\"\x9f\x8f_>a>\xcd\x14HѰ\x01\t\x1f{h\xb6\x97\r!r\x1a\x01!H\xa9O\x17\xfeP}\x05\f\x9e\xf0\xe4\xa3\vw\xc4/Q3\xb7\x8c\x1b\xbc\bQ#\x9d\xecI\xa1\x9e\xf0D@\xc2a}an\x9a\xe8}{\xc2\xd3\xf2\xa0\x1e\xdb\x1c6܄\xe4\x83\xe3\x9f\xeb \x06\xd0I/\x95e@\xa9\x96\xe8a\x96\x88\x82T\x17\x11[\xe4\xf6\xd9\xe4\xd5bj\xb2\x03^\x90\xef\x8c\x17\x8a\xd3\xf6\x03/\x93\bt\xae\x13\f\x92M\xc4T\xcb#\x13<\xaf\x97\xf1\xfa\xbd\x91W\xf0Eٍ\x9c\nV\xbb\xed\xf6\x85\x9b\x90\xe1\xfa\xa4\xd0|Q\x96z^\x9d\x89\x1e\xe5\xb3Y觑\tI\xef\x86\x1d\xfd\xed\x8c͢\x12\xfb\xb6\xf1'\xacZ$\xdc\xc0F\xba3\x84\xe7\x95Ϲ\xf9\xc5\xe6\xbc}\xb7\x15\x95\xa1\x94\x8cTrE\x9b\xddzl\x9d\xc0\xe2DEnKa\x88V\xbd\xa4_.\t\xe2\x83\xdb\x17\xfcl\x9f?\x14,\xc3\x1c\xf2\x8a\x98H\xf9/fq\xcf3(P\xef\xa77\x82v+\x9d\xcfNY>ɗ\xfav\x96>\xa5lͱ\x05g\x9c/\xa1\xb1r\xb6\xb98&\x8ava\xe0h\xc2kz\xe0\x12\x1d\xb4IRܰ\xc0M\x96\xe7t#\xc1\xc4]\xb2\xf7N\xe6\xfcp\xdf\xf6(\xf9=\xae`\xa5\xb3\xce\u007f\xb9\xad\x8a\x94\xf6\xdfP2\xae\x17-\xf4#\xdd+\b\xec\xcc\fY\xa1\xf6\"\x0e>7\xe0\xa4yd\xa2\x9f6\x1d!K9\xaf\x81\xc2o\xc3j7\x884\xae\xe0\xf9\xa0\x8c\xdf\x15w\x1cE\x0e|.\xd2r\xed\xf2\tO\x97W\x03\x1b\xbf\xdc\xc8K\xbf=\x0f,6\xee\xe5\v\x80\x95\x14'\xb8\xa4\x99\x97_\x1f\xba$i]\xc2 \xbadJ\vf\xddi.\xee\xe2nZ}S\xe1B\xd1il\x13t\xaeT\xc6&\"q\xa7\x8c\xf5\x19\xbaN\xf08\x92\x1b\x9a?ӄ\x9c\x10\xb0\x9d\xbf\x1dR:\xde\x038G\xd6KU:)\x19\x1cMp\x0e \xe6\x01$\x13\x02.\x1b\x1b\xf5\xfe\xf1\xd2_\x0e\xd0\x12,\xa3\xb0`\x06\xa2S\x85R\xab\f\x8d\x99S\x87Eϻ\x90p\xab\x93m\xcc\x1f*|\xaa}.\xb9\x17[j\xd8\xe8XsV\x98}\xfb\xd2\xca\x01:\xd3v\xffϫ\xd9y\x18\x01]\xd6\x16\x05\x93\x8b\x9b\xc5\x00\xb9\x1b?/\x9aB\x00\xe3Cv\xbd\xafȌS#\xbd\xa04\xdfv\x83-\xb8\xdc\x10p\xf8\xf0\xaa\xdb1D\x97\x88\xe7\x87\xd47qf\xc3\xe6\xba\xc3\xdbf\xa9\x86)\xf7\xb1\xf6|@\x8d\x1dI\r3\xc3\x14\xceIe[\xc7\xf34F{<\xde\x19\xd8qml\x1bI\x03լն\x80\x9cuF\x91\xb7Z\u007f\xc5\x11\xe5g?\xaf\x95\x00:\xa8\xe7x\x9f\xe6\x19\x92D1]\x83 \xf0\x1dp\v(3UIJb8#\xa5\x05<K\xbd3]\xdcd}K1l\xd7PVE\n\xe1+\xd2\x1e.gr\x1d\xed\xc1?2>\x97\xa9\x8a\xed,1Y^\xa0\xaaf6\xb5\xa6u\xc4\xf4\xe0\xe7u.B\v\xf6\u008b\xaa\x00V8f'q\xd4\xed̼\xc0\xae|\xe1\x99qK\xde\xddA%Wo\x953\x8aR\xa0M;\rlq\xa74٢\xe19\xd6[f\x90\xb9\x92\xc0`Ǹ\xa8t\x92G;\x83\xa3\xe9\x91}0\xf2\xd7\t\xdaS\x96]\x11\xf9\x8biʤPmΫ\x96:5P\xbb\xd3\xf8\x9a!R\xa9\xb9\xd3\x19\xf5\xbaQRP%&OoaR\x8b7oaҠ\xbd\x85I\x9d\xf6\x16&\xbd\x85I\xb3\xed-Lz\v\x93\xfe\xaca\xd2<&+\xca[\x8d\xfe\xb4\xb0\xfa\xe2\x15\xea4b\x93\x90í\xfe\x8d\xafPN\xab\xcbی\xcfi\xf9\xab\xe7\x03\xda\x03\xeaX\xf8\xbc\xa2\xba졜\x9b\xab\xff\xc6\xcdׅzN\xf9\xa3\xf2\xfa\xf2\xcb\xd9ҽ\x84B\xbc\xadR\x02\x99\x1c\xa3?\xb56s\xa4\xa8\xa4[\x93X\x17vĢD\x15\x97\x18P\x1f\xeb\xbd]\x98ٮ``B\xb4kS\x98n\x98\xf2\x8d\xea\x15\x17K?\x16\n>\xe6\xcb6\xa79\xd4\v\xed\xbb,ҝ\x12\xc3o̡ٺ\x8c\xe9j\x8cp\x93\x81\x96\x1d?\xac\xbb\xbfX\x15j3\xe0\x99\xdbÀ\x00*\x9atG\x16\xb9o\x17GF\x9d\nE\xf6}\u0381\xd2 \xb9\xb8\x1a\xad\x8b\xa9\xdf\x1f\xb4\xd9\t?\x97\xfePt\x96\xbdͅ\xf6)\xb5\x1b_]\xb1ѭ\xc9\x18u\xb2\xe7]v\xa4\x96\x90\xa6\xd7dtk.&6\x99\x84J\x8c\xb3+-\x96\xcf[\xb3U\x15_QK\x11\xeb$\xe66ܙ\n\x8a\x84\x98c\xb9Z\xe2\xabj$\xe82o\x06\xeb\xb3*#ZU\x0f3 \xd3\xea!\x12X\xb2T\xfbpv\xc5C\xbf\xca`\x86\x88\xa5:\x87\xe9\x1a\x86\x19\xa0\xa3\xd5\r)\x95\v30뚆W\xacWX\xa8Rx\x9dJ\xc2_\x1b{N\xd5\x1c,T\x1a,D\xa6sX-\xd4\x12\xa4W\x10,\xf0\xe7+\xab\x05\xeaz\x80\xd15ϭ\x11\xe8V\x01\x8c\x82L\xac\f\x98\xb8\xfb\x1f\x05\x99P\x0f\xb0p\xe3?\nvvc\x9cшɟ\x8cd\xa59(\xfbHO\x1a\xe7\xa3\xc8\xfb\xeeؑÅ\x8bq\xd8\x13B&T\x95װ\x87\xa4\xd0C\xc5\x13\xdc=\x92\x93\xa7\xa70Y\xf3\x10(\xb8\xf2\x18\xfc\xf4\xdf\t\xfd\xf0\x9a\x87\rc\x95f{\xfc\xac\xb2\xd6{\xd4)\xfa\xbbc;\x8f\a\x83P\xe3\x91>\xd6A\xb0\xf8\x8a\xad;u,v\fY6\x1f\x1f\xb6N_\x0eá\xbc'-\xcfZ1K\xc4\xc3\xc3g\x8f\xb8\xe5\x05\xae?U\xfe \xb7*\x996\xe8\xf8\x17\t\xf2\x93\xb6\xeeσz\x1e ,T\xa0\xf4\x87>\xbe\x1a)\x87G\xa7\xc5d\xac\xfd\x8bڨ`\x91M\xf3\xea\xf88>\xa7\x15\x8b\xb6\x84\xe2\x0f6j75k@`빯\x8b\xf6}E\xcbk=Q\x1bw\xce\xe3O$-\xb3\x95Yz$I\x83\xe2\x93\xe7\x90\xf1\xad4\xbd0\xf3\x00\xbc2\x9e\xfdN2\xa4\xb7:\xef\xd0\xe7dr3\x1cO\x0f\x8eu\ue462\xb4\x1a\x8bJ\xfe\xccL\x9d@\x1b\xf1h\r0?\x8f\x82\x01\a\vs\xc0#JP\x92\xf2e\xf4\x82˿\x86\xef\xcf\x19\x9e_[0B:\xae*\x85by\xb4܀Z|D\xfd@\xfeH\x1fQ\xbf3\x93\x10+\x13\x92##\xe4\xf75k\xa7t\xc1\xec5\xe4\xcc\xe2j\x04`\x82\x1f\x1bQ)J\x1e/<ݤ!\xde:(\xefL*!DH<\x17h\f\xdb\xc77\x9b\xcf\xce\x1d\xedQ\xbaMn$K\x14B\xb1&q\xd9}\xbf\xe8Ot,\xb3\xee\xfc\xebQ\vG\xd8֨wC\x9b\x13j\xefN\xd840\xbc\xab\x0e\xfeyܑpiq\x8f\xdd\xf0\b_J\xae\x97}\xf9m=\xccq\x84\x8e\xeed\xe1\xcdg\x06P\xf0=w\x0e\xd1\tv\xcf\xf4\x96\xedq\x95)\xe1\xceQ\\\xc9>F\xbf\x8d\\\xe9y\xe8,!wnD}\xcbӲy\x8cB\x19\xdf/\xc7n\x03V\xf0\x05\xfb\xae\xde'\xf81\u007f\xac\xbf\xd90\x18\xb0\x91wZ\xed]\xe44\xf8\xe9&Z\xf7\xe0\x97;\xa6-gB\x9c<\xf8\x89U\aݟ\xd0\xd9ׄC\x1da\xa0\xb1L\xdb4\xdfu\xdf\x19\xba\xe0\xb6\b.\xe6k\xb8ǒ9#\x19(3]U\xdf\xf4\xbf\xe2q\xe5B\xdb\xf8\xc9\n\xffŁ\xec\xc0\xe4\x9e\u07b4\x82F\xda\xed\xfdӕ\x01Ď\x1f\xea\xf8\x9d.꿏\xcbi>\xe2q\xbb\xec|\x1e{\x83{\xf9G\xe7\x86\x1ax\xd1e|\xc7w\xc3m\xb9,\x05\xcf\x1c\xb6\xdf\u007f\xa3\xbc\xe2q\xf8A\x8f!\xb9\xe1\x83\x1e\xc1,\x83\xdex9D\x00\xe9.\xad\x1b\x1c\x99\x8fֺ\x83\xf2\xf0@9\x13\x1d5\x93\"NVY&@V\xc5\x165\x89 \x0e\x1800~\xed$\x82\nw`\x93\xe1P2!\xb5s8\x87\x90z\xd2\x14!\xa6\xca24fW\t1<\xf9\xd6\xd1\xc6+R\xf5̴\v1\xe7\r\xe0/a\xd0\xc8\xfe\x1b\xe6\xbf\xee\x0e\xdcڀ#~\xbf\xd3\x16<\x12\xc5\xf6\xba\xea\xef\x1d\x1d?4\xff\x11\xfbV\xe1\xe3FG_IA\x0e/oYg@%\xf44\xa11\xcb2t\xba\xfb\xa5\xff\x9d\xa3\xcbK\xfa'~ʈ\xfe͔\xf4\xf9\rs\r\u007f\xfd\xdb\x05\x84\x13\xd6c\xc4\xc3u\xfe'\x00\x00\xff\xff\xabBR\x15\x1bJ\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcX_o\xdb6\x10\u007f\xf7\xa78d\x0fـYn\xb7\x97Aoi\xba\x01\xc5\xd26\x88\xdb\xeca\x1bP\x9a<\xd9\\(R\xe3\x91N\xbdO?\x1cEɖ,;)\xb0No$O\xc7\xdf\xdd\xfd\xee\x8f4\x9b\xcf\xe73\xd1\xe8{\xf4\xa4\x9d-A4\x1a?\a\xb4\xbc\xa2\xe2\xe1'*\xb4[l_\xae0\x88\x97\xb3\amU\tב\x82\xab\xef\x90\\\xf4\x12_c\xa5\xad\x0e\xda\xd9Y\x8dA(\x11D9\x03\x10ֺ x\x9bx\t \x9d\r\xde\x19\x83~\xbeF[<\xc4\x15\xae\xa26\n}\xba\xa1\xbb\xff\xdbh\x1f\xac{\xb4\xdf\xcd\x00\xa4Ǥხ\x91\x82\xa8\x9b\x12l4f\x06`E\x8d%\xac\x84|\x88\r\x05\xe7\xc5\x1a\x8d\x93\xedu\xc5\x16\rzWh7\xa3\x06%\u07fe\xf6.6%\xec\x0fZ\r\x19Ykի\xa4l\xd9*\xbb\xc9\xcaҹ\xd1\x14~=-s\xa3)$\xb9\xc6D/\xcc)XI\x84\xb4]G#\xfc\t\xa1\x19@\xe3\x91\xd0o\xf1c\xeb\x86_4\x1aE%T\xc2\x10\xce\x00H\xba\x06Kx\xc7\xe8\x1b!Q\xcd\x00\xb6\xc2h\x95\xdeo\xedq\rګ\xdb7\xf7?.\xe5\x06k\xd1n\x02($\xe9u\x93\xe4\xa6-\x01M \xa0\x03\x03\x8f\x1b\xf4\b\xf7\xc9i\xc0H\x912\xec\xac\x11\xc0\xad\xfeB\x19\xa8\xc8\x1b\x8dw\r\xfa\xa0;\xcf\xf2s@\xae~o\x04\xe6\x92Ѷ2\xa0\x98NH\x106\b\x99\x14\xa8\x80\x92%\xe0*\b\x1bM\xe01\xb9Ɇ}\x90zD\x15\b\x9bq\x15\xb0dWz\x02ڸh\x14sp\x8b>\x80G\xe9\xd6V\xff\xd3k&\b.]iD\xc0\x1c\xce\xee\xd16\xa0\xb7°\x9f#~\x0f\xc2*\xa8\xc5\x0e<\xf2\x1d\x10큶$B\x05\xbcu\x1eA\xdbʕ\xb0\t\xa1\xa1r\xb1X\xebХ\x93tu\x1d\xad\x0e\xbbEJ\n\xbd\x8a\xc1yZ(ܢY\x90^υ\x97\x1b\x1dP\x86\xe8q!\x1a=O\xc0mK\xefZ}\xe3s\xee\xd1\xe5\x01ҰcfP\xf0ڮ\xfb\xed\xc4\xed\x93~gV\xb7Ao_k\xf1\xef\xdd\xcb[앻\x9f\x97\x1f\xa0\xbb4\x85`\xe8\xf3\xe4\xed\xfdk\xb4w<;J\xdb\n}\x1b\xb8ʻ:iD\xab\x1a\xa7mH\vi4ڡ\xd3)\xaej\x1d8\xd2\u007fG\xa4\xc0\xf1)\xe0:\x15\x15X!\xc4F\x89\x80\xaa\x807\x16\xaeE\x8d\xe6Z\x10~u\xb7\xb3\x87i\xce.}\xda\xf1\x87\xb5p(\xd8z\xab\xdf\xeej\xd4d\x84&\xd3t٠\x1c\xe4\t\xabЕ\xcei[9\x0f\"\xa7\xed\xc0\xa7\x93ʊ\x03\x91\xa9\xf4M),%\x12\xbdu\n\x87\xfb#\xb0W\xbd\xd8\x00]\x83\xbe֔\xfaI\xc2\xc6{m\x19\x81\\\xfeFJ\xa1/A\xc5\xe8\x04m\xac\xc7\x10\xe6p\x87B\xbd\xb7f7y\xf0\x9b\xd7a|\xc1d\xc0\xf8ia-wVޢ\xd7N\x9d5\xf7\xd5H\xb87z\xe3\x1e\xa1Jĵ\xc1츲\xd0\xce\xcaq\xe9잫\xdb7]\x19m\xd3#gS\xf6M\x01W9+]\x05/@i\x12+\x83\x94T\x8e\xdd\xc3͑OK\b>>\xdbh\xe9l\xa5\xd7cS\x85R\xa9\xa9\vs{\x82\x15g\x95\x8e|u\x9d\xee\xe0R\xc3\fh\xbc\xdbj\x85~\xde\x117c\x88>385\xbd\xb1u\x93\xd9\x03}\xf9ɼ>\x1b\xb2\xf7\x87\x92}\xd7\xcb(\xbad\xc2\xc0u\x8f\xc0\"\xd3Y\xf81\xaf\x80#*\x9d\xb5\x1c\xa5\xe0@\xf4\xf6\\\xd28x\xa3WO%\x18?\xab(\x1f0\x1c\xef\x8fY\x97\xc4ؓ)\x8f\xdaUp\x10\t\x93o\xcf\x03x\"fi\xfc\xa8\xf4\xe7'Q\xdc&\xb1\x0eE#\xc2\x06\xb4%\xad\x10\xc4\x04\xa6\x89Z\xd4==\xcb\xdf7-۾\x101\xa7\x99\xf6x\x94\xa9\xf3\f\xe3\xb9\x1c\xeaBx\x96>\xb7Y\xa8\xb7\xbb[\xa7\xb9d\\զ\xf9{dŔ\x05\xf3!\xa7\a'ݥO\xf6\x95 B\xa4/\xec,\xe9\x9d,\xb8\xca\t!\xa3\xf7hCV\b\xae\x1a\xf6\x86n@\xfc\xda\xdd\xe5⠽\xf0\xc8b!\xdaH\xa8\xdajQ\xc0\x1f\x16^\xf3\x00\"y0(\x199\xcf\x02tD'\xeb\x1e\xf9\xe5\x03mI\x018\x9b\xacM͕G\xbcv^IG\x8f\xda\x18\x9e:<\xd6n\x9bF\xee\xe1\xc3#\x82G\xb3\x03\xc1\xee\x81\xed\x0fŋ\xe2\xe2\u007fn]FP\xe0^\x84\xea\x0e\xb7z<n\x1f{\xf3\xe6H\xbecu\xdfmx\xf1\xa9\x9bc\x16>\x8b}:2\xbf҆\xa7\xae\x89\x14\xd8\u007fK\xb4\xb35\x05\b\xbaƴz\xb5\xbc\xb9\xa4\xf4U\xc8\x13\xe3\x91\xd2G\x0e\x1f%\x80<\x81\xbb<(F\n\xe8'\x82\xdd\xc7J\x13X\a\xc6\xd9\xf5 E\xda'\x8f\x8d\xe0<\xb4\xd4q\x1e\x14\xf2\xc4Ǔ\xae\xdc\b\xbb\xc6\xfd\xa7@\xc6~\x80\x92\x89q\x8ctȎ=\x1b\xb4\x9d\xa6\xc23b\xc8_\xbcg\xe3w3\x10\xedB7\xf4p\x8f:\xc7\xd2\x1c~\xd4>\xdb\xd7#\xe9\xca\xf9Z\x84\x12ؑs\xbe\xea?\x99A\x9a\x8d\xa0\xf3\x06߲Dg\xe7aI\xea\xa9\xfad\x01\x82\x93ix\xb5\x15:\xa1>:\xf9hŉ\xb3\x13\xb6L\xd4\xe2\xd1V\xff\xabc\xfbr\xbfJ5q\x9e\xffk\xa4\x03\x80\xf4\x1f@\x1d82gU\xde\xd9\x17x\xae\xa0M@\xf5n\xfcO\xe3\xe2b\xf0c\"-\xa5\xb3\xeddG%\xfc\xfe\xe7\xacՊ\xea\xbe\xc3\xc1\x9b\xff\x06\x00\x00\xff\xff\xf1$\r\x8f\x16\x12\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96\xcdn\xe46\f\x80\xef~\nb{\xd8\x16\xe8x\xb0\xe8\xa5\xf0\xad\xcdn\x81\xa0m\x10$\xdb\\\x8a\x1e4\x12g\xccF\x96T\x92\x9a4}\xfaB\xb2=\u007fq\x90\xed\xa1\xbe\x89\xa2\xf8\xf3\x91\x94լV\xab\xc6$z@\x16\x8a\xa1\x03\x93\b\xffV\fe%\xed\xe3\xf7\xd2R\\\xef?lP͇摂\xeb\xe0*\x8b\xc6\xe1\x0e%f\xb6\xf8\x11\xb7\x14H)\x86f@5Ψ\xe9\x1a\x00\x13BTS\xc4R\x96\x006\x06\xe5\xe8=\xf2j\x87\xa1}\xcc\x1b\xdcd\xf2\x0e\xb9z\x98\xfd\u007f\x9d\xc3c\x88O\xe1\x9b\x06\xc02V\v\x9fi@Q3\xa4\x0eB\xf6\xbe\x01\bf\xc0\x0e\x1czT\xdc\x18\xfb\x98\x13\xe3_\x19E\xa5ݣG\x8e-\xc5F\x12\xda\xe2{\xc71\xa7\x0e\x8e\x1b\xe3\xf9)\xae1\xa7\x8f\xd5ԏ\xd5\xd4\xddh\xaa\xeez\x12\xfd\xf95\x8d_h\xd2J>\xb3\xf1\xcb\x01U\x05\xa1\xb0\xcb\xde\xf0\xa2J\x03\x90\x18\x05y\x8f\xbf\x8d\xc9\xffD\xe8\x9dt\xb05^\xb0\x01\x10\x1b\x13vpS\xa2NƢk\x00\xf6Ɠ\xabx\xc6<b\xc2\xf0\xc3\xed\xf5\xc3w\xf7\xb6\xc7\xc1\x8cB\x00\x87b\x99R\xd5[\xca\x01H\xc0\xc0\x14\th\x9c\x02\x84\x18\x10\"\xc3\x10\x19a\x8cV\xda\xc9d☐\x95f\x82\xe5;i\xa1\x83\xec\xc2\xf9\xfb\x12ݨ\x03\xae4\r\nh\x8f0\x95\x1e\x1dH\x8d\x1c\xe2\x16\xb4'\x01Ɗ%\x8cmtb\x16\x8a\x8a\t\x107\u007f\xa2\xd5\x16\xee\v:\x16\x90>f\xefJ\xa7\xed\x91\x15\x18m\xdc\x05\xfa\xe7`YJ~ť7:\x17x\xfe((r0\xbep\xcd\xf8-\x98\xe0`0\xcf\xc0X|@\x0e'֪\x8a\xb4\xf0k\x81Ca\x1b;\xe8U\x93t\xeb\xf5\x8et\x1e\x1a\x1b\x87!\a\xd2\xe7um}\xdad\x8d,k\x87{\xf4k\xa1\xddʰ\xedI\xd1jf\\\x9bD\xab\x1ax\xa83\xd3\x0e\xee+\x9e&LޟD\xaaϥ\x13D\x99\xc2\xee \xae=\xfc*\xf7ҿc\x99\xc7cc\xfcG\xbcET\xa8\xdc}\xba\xff\f\xb3\xd3Z\x82s\xe6\x95\xf6\xf1\x98\x1c\xc1\x17P\x14\xb6\xc8c\xe1\xb6\x1c\x87j\x11\x83K\x91\x82օ\xf5\x84\xe1\x1c\xba\xe4\xcd@*s\xfb\x95\xfa\xb4pU\xaf\x0e\xd8 \xe4䌢k\xe1:\xc0\x95\x19\xd0_\x19\xc1\xff\x1d{!,\xab\x82\xf4m\xf0\xa77\u07b9\xe2H\xeb \x9e\xef\xa2\xc5\n-\x8c\xe5}B[jV\xc0\x95\xb3\xb4%[\xc7\x00\xb6\x91\xe1\xa9'\xdb\xcfcyF\xf40\xc0\xed\x89xi`\xcb7\x1a(\xb7ʹ\xfc\x95d\xa1։\x18\xcfzmub\xe6M\nj4\xcb\u007f\xe2PO\xcc$lfƠ\x93\x9dz\v,\x1d\xfa\x92ܑ9\xb2\\\xe6}\x16Χ\xaaR\u007f\\\x86\x82\x80\t\xcf\xd31\xd0\xde(<!\x97\x16\xb71\x97\xbb\x03\x1d\xb8|\xc1kB\xd1\xe3X\x94R\xbe\xc4ѢH{\xa1E\x8aËh^\xadC\xf9ʟ\xd0l<v\xa0\x9cq\xb1~\x86\xd9<\x9f\xed\xa4\xdeȋb\x9f%}[4\x96x\xe3x/\xe3[\xc0+ܐ\x87K/+\xb8\xc1\xa7\x17\xb2\xebp\xcbq\xc7(\xf2b\xebv$U\u007fv_\xc0d\xa1\xe1.D\x877\xc6\xfe\xc3qU\xa1\xaf\xa6\aE\xdd\x00\xa8\xbfbw\x02V4\xb2\xd9ͨ\x8f]l\xacŤ\xe8n.\x9f\x13\xefޝ\xbd\v\xea\xd2\xc6\xe0h|\x10\xc1\xef\u007f4\xa3Ut\x0fs\x1cE\xf8o\x00\x00\x00\xff\xff\x969\x95'\x8f\t\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x10\xbd\xebW\f\xd2C\x12 \x92\x11\xf4R\xe8\xd6nR h\x1a,\xbc\x9b\xbd\x14=\xd0\xe4Xb\x97\"Y\x0eig\xfb\xeb\x8b!%\u007f\xc8\xf2nz\xa8o\x1a\x0e\x87\x8f\x8f\xef\r骮\xebJx\xfd\x80\x81\xb4\xb3-\b\xaf\xf1[D\xcb_\xd4<\xfeD\x8dv\xab\xdd\xfb\rF\xf1\xbez\xd4V\xb5p\x93(\xbaa\x8d\xe4R\x90\xf8\x01\xb7\xdaꨝ\xad\x06\x8cB\x89(\xda\n@X\xeb\xa2\xe00\xf1'\x80t6\x06g\f\x86\xbaC\xdb<\xa6\rn\x926\nC^aZ\xffM\xb2\x8f\xd6\xed\xed\xdb\n@\x06\xcc\x15\xee\xf5\x80\x14\xc5\xe0[\xb0ɘ\n\xc0\x8a\x01[Pno\x8d\x13*\xe0\xdf\t)R\xb3C\x83\xc15\xdaU\xe4Q\xf2\xba]pɷp\x1c(sGLe?\x1f\xc62\xebR&\x8f\x18M\xf1\xb7\xa5\xd1\xcfz\xcc\xf0&\x05a.A\xe4AҶKF\x84\x8b\xe1\n\xc0\a$\f;\xfcZ6\xfa\xabF\xa3\xa8\x85\xad0\x84\x15\x00I籅/\x8c\xd2\v\x89\xaa\x02\xd8\t\xa3U\xa6\xa2\xe0v\x1e\xedϷ\x9f\x1e~\xbc\x93=\x0e\xa2\x04\x01\x14\x92\f\xda\xe7\xbc9n\xd0\x04\x02F\x14\x10\xdd\x01\x18\b\v\"D\xbd\x152\xc26\xb8\x016B>&?\xd6\x04p\x9b\xbfPF\xa0\xe8\x82\xe8\xf0\x1dP\x92=\b\xaeV\x12\xc1\xb8\x0e\xb6\xda`3N\xf1\xc1y\fQO,\xf3\xefDb\x87\xd8\f\xf0k\xdeQ\xc9\x01ŢB\x82\xd8#\x8c\xd2@\x05\x94w\vn\v\xb1\xd7\x04\x013\x95\xb6\xc8\xec\xa4,p\x8a\xb0#\xf2\x06\xee\x98\xee@@\xbdKF\xb1\x12w\x18\"\x04\x94\xae\xb3\xfa\x9fCeb^xI#\xe2$\x84\xe9\xa7m\xc4`\x85\xe1\xb3H\xf8\x0e\x84U0\x88'\b\x98\xd9I\xf6\xa4ZN\xa1\x06~w\x01Aۭk\xa1\x8f\xd1S\xbbZu:N\xa6\x92n\x18\x92\xd5\xf1i\x95\xad\xa17)\xba@+\x85;4+\xd2]-\x82\xecuD\x19S\xc0\x95\xf0\xba\xce\xc0m\xf6T3\xa8\x1f\xc2\xe8@z}\x824>\xb1z(\x06m\xbbC8\xeb\xfc*\xef\xac\xf3\"\x8f2\xad\xe0?\xd2\xcb!fe\xfd\xf1\xee\x1e\xa6E\xf3\x11\x9cs^tr\x98FG\xe2\x99(m\xb7\x18\xca\xc1e\x95qE\xb4\xca;mc\xfe\x90F\xa3='\x9d\xd2fБ&\xd9\xf2\xf94p\x93[\vl\x10\x92W\"\xa2j\xe0\x93\x85\x1b1\xa0\xb9\x11\x84\xff;\xed\xcc0\xd5L\xe9\xcbğv\xc4\xf3\xc4\xc2\xd6!<\xf5\xab\xc5\x13\x9aY\xf9Σ\xe4\xf3b\xd2x\x9e\xdej\x99-\x00[\x17@\x1c\x9d=\xd2֜\xd4]\xf2f\x06%B\x87\xf1<6Cq\x9fSx\xe1}/\xce[\xc8\x1bl\xba\x86\xfb\x00\x8d\x10Jgx\xdb\xcc\xea][}I\xa3\x8b\x18&\xa9\xf2֙G6:\xb7\x9eS4\xf3E\xf9\x876\rK\xc5k\xf8%#\xfd\xec\xbagFo\x9c\x8d,\xe8gR\x1e\x9cI\x03\xdeY\xe1\xa9w\xcffN\xf7\xe6\xe1\"\x99\xa7\xad\x91[-^\x834\x0e\xaf\x91\x92Y\\hQ\x88\xd3/_\x9c/\xb1\xccw\xcf\xc42O(\x1d\x17\x81\xef\xec`1\"\x1d\xdb\xc0^\xc7\x1e\xf6\xbd\x96\xfdBU\xc8\xd3\xf2\x01q\u007f!rRg\xc7\xfe7جc\x1d\xf0B\x1eu\x16\xcdE\x90!WK\xc5g\x9e[.\\\x8f^xѱQ\xc4D\xdf\xedٜ=\x91*S\bh\xe3X#\xdfV\xf3\t\xdfc\xdaI\xf1_ן\x9fu\xee\x87c^~\x86\tm\v\x0e\x1f\xb0&\xdd\xf1\xdd\xcac\xec\xdd\xec\xac9\x01\xe5wzǿxj\xf8\xcd\xebp\xf2d\xb9\x02\xed\xe3!\xad4\x16\xb4劘\xbf^r9\xa4|\xedJa/\xb0m\x10\x14\x1a\x8c\xa8`\xf3T:\xe3\x13E\x1c\xe6x\xb7.\f\"\xb6\xc0\x17G\x1d\xf5\x85P\xf8})6\x06[\x88!-\xabha\xb3\xbe\x17ta\xab\xb3}\xder\xc6\xd2\xf1\x1f\xcc\xf5\xcc\xf9Õ\x0eV\xc3\x17\xdc_\xc4n\x83\x93H\x84sc\\A\xbf \xeeY\xe8\xf06\u07fd?~e)\xd6\xe3C<\x0f\x00\xe4g\xad:\xa1n|3\x8e\x91\xa3c\x84\x94\xe8#\xaa/\xf3\xa7\xf8\xabWgo\xeb\xfc)\x9dU\xba\xfc\x91\x80?\xfe\xacJUT\x0f\x13\x0e\x0e\xfe\x1b\x00\x00\xff\xff\x9a4\x15\xc5\xc7\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YMs\x1b\xb9Ѿ\xf3Wti\x0fZWiȲ߷R)\xde\xd6R\x9cR\xb2+\xabL\xad/.\x1f\x9a\x83&\a\x11\x06@\xd0\x18\xd2L*\xff=\xd5\xc0\f\xc9!\x87\x14\xb5\xc9:s\x91\x06\x034\xba\x1f\xf4\xc7\xd3\xe0\xa8(\x8a\x11z\xfd\x99\x02kg\xa7\x80^ӷHV\xdex\xfc\xfcG\x1ek7Y\xbd\x9dSķ\xa3gm\xd5\x14n\x1b\x8e\xae\xfeD\xec\x9aP\xd2\x1d-\xb4\xd5Q;;\xaa)\xa2\u0088\xd3\x11\x00Z\xeb\"\xca0\xcb+@\xe9l\f\xce\x18\nŒ\xec\xf8\xb9\x99Ӽ\xd1FQH;t\xfb\xff\xd8\xd8g\xeb\xd6\xf6\xcd\b\xa0\f\x94$<\xe9\x9a8b\xed\xa7`\x1bcF\x00\x16k\x9a\x82wj\xe5LS\xd3\x1c\xcb\xe7\xc6\xf3xE\x86\x82\x1bk7bO\xa5\xec\xbb\f\xae\xf1S\xd8}\xc8k[\x9d\xb2=\x8fN}Nb\xde'1\xe9\x8b\xd1\x1c\xff:\xf4\xf5g\xcd1\xcd\xf0\xa6\th\x8e\x95H\x1fY\xdbec0\x1c}\x1e\x01\xf8@LaE\xbffC?h2\x8a\xa7\xb0@\xc34\x02\xe0\xd2y\x9a\u0083h\xe9\xb1$5\x02X\xa1\xd1*A\x91\xf5v\x9e\xecO\x8f\xf7\x9f\xffoVVTc\x1e\x14\xc9\xceS\x88\xba3O\x9e\xbd\xb3ݎ\x01(\xe22h\x9f$µ\x88\xcas@\xc9i\x12C\xac\b\xda3!\x05\x9c\xb6\x01\xb7\x80Xi\x86@\xc9\x06\x9b\xcfwO,\xc8\x14\xb4\xe0\xe6\u007f\xa32\x8ea&v\x06\x06\xae\\c\x94\xb8\xc0\x8aB\x84@\xa5[Z\xfd\x8f\xadd\x86\xe8Җ\x06#\xb5\xf8v\x8f\xb6\x91\x82E# 4t\x03h\x15Ը\x81@\xb2\a4vOZ\x9a\xc2c\xf8\xc5\x05\x02m\x17n\nU\x8c\x9e\xa7\x93\xc9R\xc7ΛKW\u05cd\xd5q3I>\xa9\xe7Mt\x81'\x8aVd&\xac\x97\x05\x86\xb2ґ\xca\xd8\x04\x9a\xa0\xd7ER\xdc&g\x1e\xd7\xea\x87к>_\xefi\x1a7rl\x1c\x83\xb6\xcb\xedpr\xb0\x93\xb8\x8b\x83\x81f\xc0vY\xd6\u007f\a\xaf\f\t*\x9f\xfe4{\x82n\xd3t\x04}\xcc\x13ڻe\xbc\x03^\x80\xd2vA!\x1f\xdc\"\xb8:I$\xab\xbc\xd36\xa6\x97\xd2h\xb2}й\x99\xd7:\xcaI\xff\xbd!\x8er>c\xb8M1\rs\x82\xc6+\x8c\xa4\xc6po\xe1\x16k2\xb7\xc8\xf4\xbb\xc3.\bs!\x90\xbe\f\xfc~*\xeaO\xcchm\x87\xbbD1xB\a\xb1?\xf3T\xcay\th\xb2N/t\x99B\x00\x16.\x00\x1eN\x1f\xef\x89\x1d\nMyrV\x98E\x17pI?\xbbr/\xc8O\xe8\xf4~hE\xa7\x95\xe4\xb6\x1c\xa6Ԋ\x06\xce3\x0fD\x02\x98n麢@iE \x8e\xba\x14Gr\xac\xa3\v\x1b\x11+\xebI\x8d\x0f\xd6\x0f\x82.\x8fu\x8a\xce\xea\xff\xe0\x14\r\xa9+\v!V\x98}\xf2ѥ\xc8\b\x8d\xb5\x12\x05\xce^\xac\x80w\xea\xec\xfe\xadd\x84@\v\nd%\xa2r\xf2\xf1.\xa5\xa8\x88\xdav\x91\x97S7Dw\x04\xdf<\x03L\n\xfa\a}\xee\xb0\xe1d>\x1e\xd4\xf4\xa7\xc7\xfb.\aw \xb5:\xc7\xc3\x1d\xcf\"\"\xcfB\xaa\xcc#\xc6\xea\xc5]\xaf\xef\x17y\x9b\x94\x91\xa2\x03\x04\xaf\xa9\xa4^j\am9\x12\xaa<8 \x12@\x027P;\xff&\xe7\x9f6\xcd\xedʁ`\r\x98\xeb\x1b\xfce\xf6\xf1a\xf2g\x97u\x1d\x94\x89eI,b0RM6\xde\x007e\x05\xc8b\x82\x0e\xa4f\xf2e\\\xa3\xd5\v\xe28nw\xa0\xc0_\xde}\x1d\xc2\f\xe0\x83\v@߰\xf6\x86n@g\x94\xb7\t\xb5s\x10\xcd\x19\x88\xad<X\xebX\xe9a\xc3Q\x1c\xa95x\x9d\f\x8d\xf8L\xe0ZC\x1b\x02\xa3\x9fi\nW\x92B\xf6T\xfc\xa7Dÿ\xae\x06e\xfe\x98\x83\xf4J\xa6\\eŶ5s?\x88v\n\xe6H\nz\xb9\xa4@\xc3h\xa6B \t\xf6\r\xb8 \xb6[\xb7' \x89\x953ˉ\x8eԑ\xc2_\xde}=\xa1m\x1f'\xd0V\xd17x\a\xdafT\xbcSo\xc6\xf0\x94<bc#~\x93}\xca\xca1Yp\xd6l\x86\xb5uPኀ]M\xb0&c\x8a\xccU\x14\xacq#\xf6w\xc7%\x1e\x86\xe01\xc4>\x1b\x19\x94\xfa\xf4\xf1\xee\xe34k%.\xb4L\x99T\xaa\xdcB\v\xe7\x10\xb2\x91+\xa7\xf8d\x82\xa3\xc9\xce\x11\x1d\x94\x15ځ\xc4\n\x89\xb4$t\x17\x8dԲ\xf1\xf5k\xa3\xf5\x906t\xcf\x00}8L\f\xff\xa3\"|\x91Y\x89\xb5\xbfh\xd6Þ?\x9f5KZ\x88`)R\xb2L\xb9\x92Ũ\x92|\xe4\x89[QXiZO\xd6.<k\xbb,\xc4\x11\x8b\xec\t<I-\xc0\xe4\x87\xf4\xe77Y\x91\x98\xf9e\xa6\xa4\xa9\xdf\xc3\x1eه'\xaf6\xa7㕗V\xa5\xebY\xcb|\x0eWJH\xac+]V]\x93\xb0˞\x831R\xa3\xca)\x17\xed\xe6ww[\x01\xb2\t\xa2Ϧh;\xd1\x02\xad\x92\xffYs\x94\xf1W#\xd7\xe8\v\x82\xf4\xd7\xfb\xbb\xef\xe3̍~uD\x0e\x12\xe2\xec\x13\xde\xdd+\x81o\xa1)\x9c\xa5S\x9fzS;b7\xc0$\xb7s.fr\x11\x97G\x04\n\x95Jw\rh\x1eϐ\xac36\xf7\x94\u007f\xc2%\x03\x06\x02\x84\x1a\xbd\x9c\xd33m\x8a\\\xa4=j\xa9\xb1RF[\xbe2'@\xef\x8d\x1e(\xa7m)n\xe9b˼\xa5\xad\xc5%\x0f\xdb;\x80z^}\x16\xed\xdc^\f\xd1\xe7v\xeb\xccK\xb6\x14:\xba\x1dQ=\xf6\xdf#\xe2z\x027\xe9\x02\x85]\xed\xabV\f\xb7.\xbd\x19B\xe9{\x03ީ\xde{\xdf\xcfz\x9f\xb2=/vo\x11c\xc3\x17\xf7oiv\x87^\xce\a\xb1\x95\x91\xb8\xc2o\xe9\xe0J'ܱ\u007fMu\xee\bo\x8f\xe7\xa7\v\x91\xa0\xb2ZQ\xd7⏭\x0f\xad\x91\xbb\x1d\x8e\x9b0\xd8\x13\x96ץ\xbc+\xb2H%j'\xacs\x81ڐ\x82\xee\x8e\xecp͑\xcc}\x19sZH\xaaj\xbcq\xa8\xba\xa6\xa8U\xad\xbb\xe4y\x92n8\xdd7\\\xf3I\x89\r\x93J]\xf2\x80\xf9\x87\xe5a\xe1B\x8dq\n\n#\x15\x03\x02mc\f\xce\rM!\x86\xe6\xf0\xe3\xc9Я\x89\x19\x97\xe7\xc3\xeb\x97<'\xf7\x87\xed\x02\xc0\xb9k\xe2\xb6A\xec\x85\xf85\xb7\xdesyw:Ђ\xf5]\x16\x850sK\x1f\x8dI+\xf6\xc3zw\x8f\x9a\xf4\x99\x93\x1c\xcb\u007f\x1a\xe1\x00\xbeB>\x0fΣ\xcc\x18\n\x9em\x0e:\x13=\x90:¦>ܡ\x80\aZ\x1f\x8d\xdd\xdb\xc7\xe0\x96\x81\xf8\xd05\x8a\xce\u007f\x8e\x8c-\xe0C\xf2\xf3\x8b\xedm78or;\t*g\xba\xf0t\x11\rئ\x9eS\x10\xbb\xe7\x9bH\xdcO\xc2\xc7=\u007f\xea\"v\xa0\xed\xad\xee\xae\x10\xb2\x9c\xb6)*Ѧ[6\x89\x99\xe8@i\xf6\x06\x8f\xbb\xa2΄\xc4$$d$\xa4w\xdeڅ\xa9\xa7\x90>\xbd\xe6\x96\"is\xe7\xec \xc7\xed\xe2S\xdb\xf8\x87\xff?\xc98\xb4\x8d\xb4\xec%\xf5\xf6\xab\x00\xf8^\xe4\xff\xb7e\x9f,\xacl\xd1s\xe5\xe2\xfd\xdd\xd9Ӟm\xa7u^\xbe#-)w\xa5{\xbfvRw\xe4\xfd\x92\x96\x9f\xec\x06\x17\x87\x1eG\f\xf1\xb2\xe21\xebM}\xa1n$\xb9\xa4\xc60#\x8f\x01\xe3\xb1c\xa6\xfb\xe0\xdb\xc3_Yn\x80u\xba\x16\x13\xee\x93\xc9PnuYʉP;\x17\xb2\xaf\x1eK\xec\x15\x82^\xe2\xef\xab\xfe=r\xfe\x80?\x1c\fm\u007fuZ\xbdݽ%\xbf,ڟ\x98҇\xd6,\xb5\xb7y{\xabڎ\xech\b\x96B\xd9I=\x1c\xfe\xc8t\x95\xafJ\xba_\x8d\xd2k\xe9lf\xb3<\x85/_G\xd0\u07b5~\xee\xf4\x90\xc1\u007f\a\x00\x00\xff\xff\xb4\x9eo5\xa1\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_o\x1b\xb9\x11\u007fק\x18\xf8\x1e|\x01\xb2\x12\x92\x16E\xa1\xb7;\xbb)\xdc\xde9F\xe4\xcbK\x90\aj9Ҳ\xe6\x92[\xceP\xb2Z\xf4\xbb\x17C\xeeJZi%\xcbA/\xd5K\xb2\xe4p\xf8\x9b\xdf\xfc\xe1\x90\x1e\x15E1R\x8d\xf9\x8c\x81\x8cwSP\x8d\xc1gF'_4~\xfa3\x8d\x8d\x9f\xac\xde͑ջѓqz\n7\x91\xd8ן\x90|\f%\xde\xe2\xc28\xc3ƻQ\x8d\xac\xb4b5\x1d\x01(\xe7<+\x19&\xf9\x04(\xbd\xe3\xe0\xad\xc5P,э\x9f\xe2\x1c\xe7\xd1X\x8d!\xed\xd0\xed\xffctOίݛ\x11@\x190ix45\x12\xab\xba\x99\x82\x8b֎\x00\x9c\xaaq\n\x8d\xd7+oc\x8d\x01\x89}@\x1a\xaf\xd0b\xf0c\xe3G\xd4`)\x1b/\x83\x8f\xcd\x14v\x13yq\v*\x1b\xf4\xe0\xf5\xe7\xa4\xe7S֓\xa6\xac!\xfe\xfb\xe0\xf4/\x868\x8946\x06e\ap\xa4Y2n\x19\xad\n\xc7\xf3#\x80& aX\xe1o\xd9\xda\x0f\x06\xad\xa6),\x94%\x99\xa6\xd278\x85{Aڨ\x12\xf5\b`\xa5\xacщ\x8f\x8c\xdd7\xe8~z\xb8\xfb\xfc\x87YYa\xad\xf2\xa0h\xf6\r\x066\x9d\x89\xf2\xdbs\xf0v\f@#\x95\xc14I#\\\x8b\xaa,\x03Z\\\x8a\x04\\!\xb4\x8eA\r\x94\xb6\x01\xbf\x00\xae\fA\xc0d\x83\xcbN\xdeS\v\"\xa2\x1c\xf8\xf9?\xb0\xe41\xcc\xc4\xce@@\x95\x8fVK\x1c\xac00\x04,\xfdҙ\u007fm5\x13\xb0O[Z\xc5\xd82\xdc\xfd\x8cc\fNY!!\xe2[PNC\xad6\x10P\xf6\x80\xe8\xf6\xb4%\x11\x1aï> \x18\xb7\xf0S\xa8\x98\x1b\x9aN&K\xc3]H\x97\xbe\xae\xa33\xbc\x99\xa4\xc04\xf3\xc8>\xd0D\xe3\n\xed\x84̲P\xa1\xac\fc\xc91\xe0D5\xa6H\xc0]\x8a\xe8q\xad\u007f\bm\xfc\xd3\xf5\x1eRވۈ\x83q\xcb\xedp\n\xb2\x93\xbcK\x8c\x81!P\xed\xb2\x8c\u007fG\xaf\f\t+\x9f\xfe2{\x84n\xd3\xe4\x82>\xe7\x89\xed\xdd2\xda\x11/D\x19\xb7\xc0\x90\x1d\xb7\b\xbeN\x1a\xd1\xe9\xc6\x1b\xc7飴\x06]\x9ft\x8a\xf3ڰx\xfa\x9f\x11\x89\xc5?c\xb8I\x89\rs\x84\xd8hŨ\xc7p\xe7\xe0F\xd5ho\x14\xe1\xefN\xbb0L\x85P\xfa2\xf1\xfb\xf5\xa8/\x98\xd9\xda\x0ew\xc5b\xd0C\x87\xe9?k\xb0\x14\x87\tk\xb2\xd0,L\x99r\x00\x16>\x80:\x92\x1f\xef)\x1eJN\xf9\xcdU\xf9\x14\x9b\x19\xfb\xa0\x96\xf8\x8b/\xf7\xd2\xfc\x04\xaa\x9f\x87Vt\xb0\xa4\xc2\xe5D\xc5V5P\x96<P\t`\xbb\xa5\xeb\n\x03\xa6\x15R\xa5L)\xa1\xe4ɰ\x0f\x1bQ\x9bL\xd1\xe3\x83\xf5\x83\xb4'C\xbd>\v\xff\xc1\xb7A\x1fp\x81\x01\x9d\x84t\xce\xfeƧ\x1a\xc1ʸ.\xf4s\xf1\x04\xf6G\xe8\xe7\x19\xed\x10\xb4ST\xc3\xc9z8\b\xf4\xa7\x87\xbb\xae\x06v\x8c\xb6\x90\xf9pǳ\x84\xc8o!U\xfeAq\xf5\xe2\xae\xd7w\x8b\xbcM\xaa\b\xecAAc\xb0\xc4^i\x05\xe3\x88Q\xe9<8\xa0\x12@\x12'`+\xff6\xe7\u007f[fv\xe5X\xa8\x06\x95\xcf\x17\xf8\xdb\xec\xe3\xfd\xe4\xaf>c\x1dԩ\xca\x12I\xd4(\xc6\x1a\x1d\xbf\x05\x8ae\x05\x8a\xc4\x04\x13P\xcfdf\\+g\x16H<nw\xc0@_\xde\u007f\x1d\xe2\f\xe0\x83\x0f\x80Ϫn,\xbe\x05\x93Y\xde\x16\xb4.>\fe\"\xb6\xfa`m\xb82Æ+\x89\xa3\xd6\xe0u2\x94\xd5\x13\x82o\r\x8d\b\xd6<\xe1\x14\xae$\x83\xf7 \xfe[R\xe7?W\x83:\u007f\xcc)r%\"W\x19\xd8\xf6\xcc\xdaϸ\x1d@\xae\x14\x03\a\xb3\\b\xc0a6S!\x96\x02\xf7\x06|\x10\u06dd\xdfS\x90Ԋ\xcfr\x9dA}\x04\xf8\xcb\xfb\xaf'\xd0\xf6y\x02\xe34>\xc3{0.\xb3\xd2x\xfdf\f\x8f)\"6\x8eճ\xecSV\x9eЁwv3\x8c\xd6C\xa5V\b\xe4k\x845Z[\xe4^A\xc3Zm\xc4\xfe\xce]\x12a\n\x1a\x15\xb8\xdf\r\fj}\xfcx\xfbq\x9aQI\b-S\x1d\x93Sfa\xe4̗\xc3>\x9f\\\x12\x93\x89\x8e\x98\x83\x83=\x94\x95r\x03e\rRӐ\xd8]D9K\xc6\u05ef\xcd\xd6\xc3c\xbb\xfb\r\x1c߇\x85\xe1\xfft\b^dVj\x9d_4\xeb~/\x9eϚ%}|pȘ,Ӿ$1\xaaĆi\xe2W\x18V\x06ד\xb5\x0fO\xc6-\v\t\xc4\"G\x02MR\x1b>\xf9!\xfd\xf3MV\xa4\xce\xf82S\x92\xe8\xf7\xb0G\xf6\xa1ɫ\xcd\xe9\xfa\xbaKO\xa5\xebY\xdbx\x1c\xae\x94\x94XW\xa6\xac\xba&}W=\as\xa4V:\x97\\\xe56\xbf{\xd8\n\x911\b\x9eM\xd1^\a\v\xe5\xb4\xfc\x9f\f\xb1\x8c\xbf\x9a\xb9h.H\xd2\xdf\xeen\xbfO0G\xf3\xea\x8c\x1clHsL4\xfeN\v}\v\x83\xe1l7\xf5\xa9'\xdau\x81\x03}\xdcV\xe6\xe2F\x8e\x9cj\xa8\xf2|w{\x16\xc1l+\xd6\xed\xbe\xa3\xbcm\xdf:M\x12\xa2g\xfa\xb6\x93H\xb2\x9a\xb3(r\xdf=\xd4\x05\xb7\x18rǐF\xa4\x03\xfd&$r\x1d\x926g\x1fI1\xdc\xc1\xf7$\x1a\xaf{\xdf}\xff\xf6\xa6v\xa4\xf7\x86\xb3\x11/^fXq\xa4˯3I\xbc\xe3,\xe7'\xb7J\xd2\xd9\xfdM\x17\x9a\xd2K3\xd7\u007f\xbc9繛c\xf9\xf4B\x10t\xc6Ŧ\xc6t[H\b`\xad\xa8\xdb\xe2\xd8o\xb0\xa7-/L\x95P\x94\xa1N͖\xf4\x81\ve,j\xd8>\x1d\xc1\xa3\xdc\xe7ҕ\xf9\xfa\xb8Vvj\"\xa1N\xf7\xbc\x01\xc0\x87\xab\x16>Ԋ\xa7 \xd7\xe4B\x14\x1c̻h\xad\x9a[\x9c\x02\x87x8y2\rj$R\xcb\xf3y\xf0k\x96\xc97\xacv\x01\xa8\xb9\x8f\xbc\xbdb\xb5\tњ\u007fM\xad\xc7/\xbf\xe0U\x8a\u0383x\x10\x89\xa1\xb8\xda&\xe5\xb9\xc0\x82t{\x89\xf5\xe1\x16\x05\xdc\xe3\xfah\xec\xce=\x04\xbf\fH\x87>(:G\x1d\xb5\xdf\x05|H\x11p\xb1\xc1\xed\x06\xe7mn\x85\xa0\xf2\xb6\x8b\\\xcfʂ\x8b\xf5\x1c\x83\x18>\xdf0R\xc7@\x97\xe8\xc77\xd4\xd4\xf3\xeexۭ\xef\xaaUV\xd4v\xf0\xa5r\xe9IF\xa2\x93=hC\x8dU\xc7-|gC:\xf6$8%Cvq\xd1e\x97\xa4t\x9a{͝:\xc1\xb9\xf5n\xb0#\xebR\xc18\xfe\xd3\x1fO\x9e\x8f\xc61.{\xa5\xb0\x9d\x15\n\u007f\x16\xfd\xffk\xdd'\x0f_b\x15\xf8\xb2\xd25뉾T\xb5\x92⡚\xb5_~\x8e\xcbM\u007f\x93\xefQi\x06\xa89\x18ھٯ\xde\xed\xbe\x92\x8b\x8a\xf6\x81>M@6K\xefm\xde>F\xb5#\xbb\x03K\x95\xd2k\xa1\xbe?|\xa1\xbf\xba\xea=\xb8\xa7\xcf\xd2;m\xf2\x1f\x18\xe0\xcb\xd7\x11\xb4OT\x9f;\x1c2\xf8\xdf\x00\x00\x00\xff\xff\xbb\x93\x18C\xdf\x18\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W͎\xe36\f\xbe\xe7)\x88\xeda[\xa0N\xb0\xe8\xa5\xf0\xad\x9dv\x81Ew\x06\x8b\xccv.E\x0f\xb2D'\xecȒ*R\x99\xa6O_H\xb6\x13'q\xb2\x83\x05\xea\x9b(\x8a\xfc\xf8\xf1G\U000a2aaa\x85\n\xf4\x84\x91ɻ\x1aT \xfcG\xd0\xe5\x15/\x9f\u007f\xe4%\xf9\xd5\xee]\x83\xa2\xde-\x9eə\x1a\xee\x12\x8b\xef\xd6\xc8>E\x8d\xbf`K\x8e\x84\xbc[t(\xca(Q\xf5\x02@9\xe7Ee1\xe7%\x80\xf6N\xa2\xb7\x16c\xb5A\xb7|N\r6\x89\xac\xc1X<\x8c\xfe\xbfM\xee\xd9\xf9\x17\xf7\xdd\x02@G,\x16>S\x87,\xaa\v5\xb8d\xed\x02\xc0\xa9\x0ek\x88\xc8B:b\xf0L\xe2#!/wh1\xfa%\xf9\x05\a\xd4\xd9\xf3&\xfa\x14j8n\xf4\xa7\aT}D\xebbh=\x1aڗ-K,\xbf\xcdn\u007f$\x96\xa2\x12l\x8a\xca\xce\x01)\xdbLn\x93\xac\x8a\x17\n\xd9A\x88\xc8\x18w\xf8{\x1f\xef{Bk\xb8\x86VY\xc6\x05\x00k\x1f\xb0\x86\x87\f5(\x8df\x01\xb0S\x96La\xa4\a\xef\x03\xba\x9f>}x\xfa\xe1Qo\xb1S\xbd0[\xf6\x01\xa3\xd0\x18c\xfe&)>\xc8\x00\f\xb2\x8e\x14\x8aEx\x9bM\xf5:`rR\x91A\xb6\bCj\xd0\x00\x177\xe0[\x90-1D,1\xb8>\xcd\x13\xb3\x90U\x94\x03\xdf\xfc\x85Z\x96\xf0\x98\xe3\x8c\f\xbc\xf5ɚ\\\t;\x8c\x02\x11\xb5\xdf8\xfa\xf7`\x99A|qi\x95\xe0@\xf1\xf8\x91\x13\x8cN\xd9LB\xc2\xefA9\x03\x9d\xdaC\xc4\xec\x03\x92\x9bX+*\xbc\x84{\x1f\x11ȵ\xbe\x86\xadH\xe0z\xb5ڐ\x8cE\xad}\xd7%G\xb2_\x95Ҥ&\x89\x8f\xbc2\xb8C\xbbb\xdaT*\xea-\tjI\x11W*PU\x80\xbbR\xd3\xcb\xce|\x13\x87\x0e\xe0\xb7\x13\xa4\xb2\xcfic\x89\xe46\aq\xa9\xb2\xab\xbc\xe7\"\x03bPñ\x1e\xff\x91\xde,ʬ\xac\u007f}\xfc\f\xa3Ӓ\x82S\xce\v\xdb\xc7c|$>\x13E\xae\xc5\xd8'\xae\x8d\xbe+\x16љ\xe0\xc9IYhK\xe8NI\xe7\xd4t$9\xd3\u007f'd\xc9\xf9Y\xc2]imh\x10R0J\xd0,Ⴣ;ա\xbdS\x8c\xff;\xed\x99a\xae2\xa5_&~:\x91N\x15{\xb6\x0e\xe2qZ\xccf\xe8\xbc\xff\x1f\x03ꜰ\xccZ>H-\xe9\xd2\x03\xd0\xfa\b\xeaB\u007f91<ל\xf9k\x94~N\xe1Q|T\x1b\xfc\xe8\xf5\xa4ͯ\xa0\xfay\xee\xc4\b+\x8f\xb8\xbeQq^\xf1\xcc2\x80l\x95L:T\x14\xb9C\x9b\xcf\xc4q\x95\xf2B\xbb\xca\xed\xea\x94\xd3\xf8\xbeԎ\xd3\xfb\x9b\xb1\xdc\xcf\x1cȡl\xfd\v\xf8V\xd0MM\x8e(\x1b\xbc\b\"&\xf7j\x90\xfdL\xfe`ri\xb5\x84\xf1&\xc0\xf5\x99\xf2\xc8s\x9b\xac\x1d,U\xdawA\t5\x16\xc7Fn}\xbc\x80H\xbd\x8d}\xdf\xd5_\xc7\xef\xce\xdb\xd4\xe1\xe1n\xb8\x89\xfc\xe9TwZ \xbd`\x00\x91C\x80xz\x05N\xbf\xa1&\x18\x827\x03\x80\xa1h9\xc7\xf9J\xec9\xb9\x14\xf1d\x1aV\xf3\xc5\u007f\xa21WQ'\n\xe7\xd9<\xd9<\xe3\xeb\x8b\xc3@\x94$~\xfd8(\xea#\xb1:ňN\x06#\xe5&\xfc\xaa\x81`\x15ˤ-\xf2\x1b\xe8f\x9e?^ꏐ\xb2)\x90,\x98vы\xe2\xb9~i}\xec\x94ԐG{\x95\x0f\x9d\xed\xe7\x17\x98j,\xd6 1\x9do^\x9f\bȬ6\xb7#\xb8\xefu\xfa\xabp8\x00\xaa\xf1I\xae\x10[.\xc5\x1b\xd4\xdeD\x14\xb6\x8ao\xe3\xf9\x945\xe6Ҋ\xafu\x8e.u\xe7.*x\xc0\x97\v\xd9\x1a\x959\xef\xb9\n\x1e\xbc\xccm\\\x89i\xa6\x96\xcfD\x87W\xf6\xee\xddqU\xea\xae\x1a\x9e\xd4e\x03\xa0\xbcL\xcd$\xc5\xdc\xf7\xe6 96\x88\xd2\x1a\x83\xa0y8\u007fR\xbfys\xf2B.K흡\xfe\x97\x00\xfe\xf8s\xd1[E\xf34\xe2\xc8\xc2\xff\x02\x00\x00\xff\xff\x99vi\x8d\x91\f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xd4Y\xcdo\xeb\xb8\x11\xbf\xfb\xaf\x18\xa4\x87}\x0f\x88\x1d<\xf4R\xf8\x96\xe6e\x81`w\xd3 yM\x0fE\x0f\xb44\xb6YS\xa4\x96\x1fvܢ\xff{1CR\x1f\x96,{\xd1.\xb6\xd5\xc505\x1c\xce\xfc8ߚ\xcd\xe7\xf3\x99\xa8\xe5;Z'\x8d^\x82\xa8%~x\xd4\xf4\xcf-v\u007fp\vi\xee\xf6_V\xe8ŗ\xd9N\xear\t\x0f\xc1yS\xbd\xa23\xc1\x16\xf8\x15\xd7RK/\x8d\x9eU\xe8E)\xbcX\xce\x00\x84\xd6\xc6\vZv\xf4\x17\xa00\xda[\xa3\x14\xda\xf9\x06\xf5b\x17V\xb8\nR\x95h\xf9\x84|\xfe\xa7\xa0w\xda\x1c\xf4\xe7\x19@a\x919|\x93\x15:/\xaaz\t:(5\x03Т\xc2%Xt\xdeXt\x8b=*\xb4f!\xcd\xcc\xd5X\xd0y\x1bkB\xbd\x84\xf6Eܓd\x89z\xbc\xc6\xed\xbc\xa2\xa4\xf3?tW\u007f\x94\xce\xf3\x9bZ\x05+T{\x18/:\xa97A\t\xdb,\xcf\x00j\x8b\x0e\xed\x1e\xff\x1c\x15\xf8^\xa2*\xdd\x12\xd6B9z\xed\nS\xe3\x12\x9eI\x8aZ\x14X\xce\x00\xf6BɒU\x8cr\x99\x1a\xf5\xfd\xcb\xd3\xfb\xefߊ-V\".\x02\x94\xe8\n+k\xa6\xcb\xf2\x81t \xe0\x9d\xf5#!\xf8.\xc0o\x85\a\x8b,\x8a\xf6\x0e\xfc\x16AԵ\x92\x05\x9f\x02f\x9dXB\xb3\xc7\xc1ښ\xaa\xe5\xb5\x12\xc5.\xd4\xe0\r\b\xf0\xc2n\xd0\xc3\x0fa\x85V\xa3G\a\x85\nΣ]$6\xb555Z/3\xb0\xf4t\xac\xa9Y;\xd1\xe1;R2\xd2@I\xf6\x83Q\xd4d\x05X\x82c\x00\xc0\xac\xc1o\xa5kUb5:l\x81H\x84\x06\xb3\xfa;\x16~\x01ot\x03ցۚ\xa0J2\xba=Z\x82\xa40\x1b-\xff\xd1pv\xa4 \x1d\xa9\x84\xc7t\xd3\xf9\x91ڣ\xd5B\xd1\xf5\x04\xbc\x05\xa1K\xa8\xc4\x11,\xd2\x19\x10t\x87\x1b\x93\xb8\x05\xfc\xc4W\xa2\xd7f\t[\xefk\xb7\xbc\xbb\xdbH\x9f\xfd\xa70U\x15\xb4\xf4\xc7;\xf6\x02\xb9\n\xdeXwW\xe2\x1e՝\x93\x9b\xb9\xb0\xc5Vz,|\xb0x'j9g\xc15\xbbϢ*\u007f\xd7\\\xd6w\x1dI\xfd\x91\f\xcay+\xf5\xa6Yf\xd3>\x8b;\x99x\xb4\x9c\xb8-\xca\xdf\xc2KK\x84\xca\xeb\xe3۷\xaeUI\xd7ǜ\xd1\xee\x18Z\v<\x01%\xf5\x1am\xbc8\xb6-∺\xac\x8dԞ\xff\x14J\xa2\xee\x83\xeeª\x92\x9en\xfa瀎L\xd7,\xe0\x81\xa3\b\xac\x10B]\n\x8f\xe5\x02\x9e4<\x88\nՃp\xf8\xab\xc3N\b\xbb9Az\x19\xf8n\xf0\xeb\x13F\xb4\x9a\xe5\x1c\xa2Fo(y\xf7[\x8dE\xcf3h\x93\\g7^\x1b\xdbs~ڲ\xe8\xb0\x1csKz\xa2oS\bꯟ\b\xf1ǆ\x8cl\x85\x8e\x0fZ\xfe\x1c\x90Ch\xf4I\x1c\x86\v\xdb\t\xa7݇L`q\xb2:\x8a =\xf8Q\xa8PbلI7)\xe9〜\U000cc41al\x9c\x82:\x89\xab۷\x1c ň\x94dgRGn 5\xab8\x82,=\xd2c5\x10kB'\xe0\xac%V\n\x97\xe0m8=;\xee\x13֊\xe3(\x149\xd1^\x87DC\x9d\xdc\\ɂ\xaf\xacqf\x06\xe3\xff\t\x87$\xcdC\xcc<ס\xf14\xbe'\xbb\x11:8l\xd1o\xd1\xe6\x846\xe7\xf4\\\x0e\x94i\xb3d\xca(+l\xe1!7,\x8cv\xb2D\x1b\x1d\xf3\x040xZ\x0f\x01\x0eJݒo\x8b\xa0|LC6\f\xb0\xbd\x02\xa9\x951\n\x85\x1e\xc3\xeaZ\xf7y\x1a\x90\x9fXM\xe39\xd9lL>b\xa0W\x8cr1\xe2\x93\xe2\x80U폷 \x94\xea:\xa0\xb0-\x80\xbf\xadA]\xe9XO\xa7\xd4\x17\x1c\xeb<BC\xe3\xe8b\xd4ZZ\xa2K\x91\xf5\u007f\x000%V\xa8\xdePa፝\x04\xeb\xc7.e\x04\x8a\xf2\xe2\xfeˢ\xff\xc6\x1bXK\xe5\xd1\xc2A\xfa\xed@\x81\xc3\x16u\u0089*\x12\xa9K\xb9\x97e\x10\xaage\x1d\x94Z0\xc1X\xd0R\xdd\x0ex\x12\xc6yw\x0fS\xf8\x13\v/\xd4/\xf2\xc1s)\x96\x9eJ\xf8b\xfb\xf8Aők\x1b\xa0\t\xd8N7D\xe4r\xfab\xf8\xc1e\xec\xa8B\x92\x16+\xae\xbbF8\x03|c+k\xa9X\xdf\xfb\xe7\xafC\x03\x82\xf3F4\x10\xf2~B\x90\xe4\x13\xcd\xf5Rvɉx\x943\xa4\xca\xf9\x16\x04\xec\xf0\x18\x8bl\xaa\xe3k\n\xa5\x99\x85E.\xcf\xf9\xa2wxd\xa2Tq\x8fr\x9d\xba\x94\xf8\xec\xf0x\xeeՉ\xbat^\xaa~\xa2\u07b4\xc0Rq=\x96U\xe5\xee\n\xcf)I\x8f7\xe3\xc2´\xa7\xe6'#r\xa5\xd8\r\x80\x9d.\x90!\xfe\xceE8ɾ\xb626x\x13R;d\xdb\xcb\xfd\xcd;u\xaa\r\xf3hQO\xfa\x16\x9e\x8d\xa7\x9f\xc7\x0fI5\xbb\xd0\xc3\f\xda>_\r\xbag\xe3\x99\xf6?\x82$\nu% \x91\x98\rT\xc7\xd8Fzu\xdb\x1f\xc7уn5\xeb7\xa1\x84tԂ\x18\x9b5\xe7\xb65\x1e\x11\x99W\xc1qǢ\x8d\x9esD\xca\xdc'\x986\x97&]\x86\xd2\xd8\x1e^g\x0e\x9a\xe0\xb9BH\xc7\u007f\xa3F,\ue26d\xb4\x12\x05\x96P\x06\x86\x80[A\xe1q#\v\xa8\xd0n\xa6\xe4\xac)N\x9d\xbf\xba\x89H\x12\x9f+\xee\xf6|\x16\xcaO\n;\xe5\xf8As\xb2\xf53o&\xafw\xb4W\xbbN*\x0eߜ\xe0F\xb5\x17e)c\x86y\xb9\x10\x9f.\xe03\xcc\x19\xf1ДhEM\x96\xfdO\n\xa7l(\xff\x82ZH\xeb\x16p\xcf\xf3*5~\xb3]\xfaTytY\x13W\xe9\x800\xdf\vE\xa1\x9e\x02\x87\x06T\x1c\xf8GY\x9a\xf5 \xa3\xdd\xc2ak\\\x8c\xe2k\x89\x8a\xa7\x107;<\xde\xdc\xf6<\x0f\xe4x(\xbdy\xd271I\f\xfc\xa0i\xf8\x8cVG\xb8\xe1w7\x8bA\x12\x1ce;\x99\x18',\xe2쫦\xd2\xfdIԵԛ\xd3{\xbe\xce\x16&\xec\xa0g\x03\xcf'\xa7\xf5\f\xa1[\x96\xf6J\xf8\xe1qq\xcc7R\xec\xdb<l\xd4\xde,\xe0^\x1f\a\\\x1du\x8c#\xa5n\xbf\x83$\x91\x0eR)\x8aJ\x89g\xc9L\xbb\x8c\xd2`\xc1\x89*\xf2\x1f\x1f\x1a\x8c\x80\x9e8\xbe\xbcOW\xf2\xaf\r\xd9H\x1f\xd8Q\x96*\xc5F\x81\x97\xf7\xa1\xe5p\xf1鴨\xdd\xd6x\xf8\xb4\x97\"M\xb5L(kk\xf6\xd4\x0f~\xfe/ut\xae\xd8b\x19\x14^\x1cڼu\b/\x8fm2ۡ-\xb484\x9d\\F\xab\x8c\x1e\xd8\x1f\x0f\xa5\x16&\xf1\xa5K\x1ek\xa2\x1b\x86\xd1\x1c\x8c\xe3\x99,\x85\x13\x17\x8a\x02\x9d[\a\x95;\x1e\x1e\xfbS{\x1dɥk\xa4\xbdr\x8c4\x96!\xe6\x9d\xe1\xd7\xc5\t\x9d\x17>\xb8\x8b3:\xa6\x82B\xd4>\xd8T\xa4\x16\xc1ZV*\xbe3\xeb\xc1\x98\xee\x8a)\x1dZk\xec\x85i\x0f\x93Dw/L\xd0\\\xab\x91\xdd\xf2^\xa8\xd09\xb1\xc9c\x9e\x03Z\x84\rj\x8a\xb0##\x8eT\a\xe0\a\x16!}$\xe8\xf7\xa9\x14IE\xe1\xa9\xfd\x8a\xa2q\xd8l\x9c\xf8\\\xce$\x02\xb19sgR{\xdc`?\x13\xaf\x85T\xc1\xe2+\n\xd7\xffv0P\xff\xfb.e*\xed\xa2\xe6\xb1\xf3\x10\xc1a\x99\xc6\xce^Z<;\x9b\xa4>T\xc8A\xe7w6\xfe\xd6[\xe1\xa6\xdd\xf0\x85(\xb2\xffu͡\xf1\xc0\xd7QYP\x87\xea\x94\xf1\x1c\x9e\xf10X#\xe5\xb1|o>\x1d\r\b\x9e\xf4\x8b5\x1bʼ\x83W\x0f\xa6\xaa\x15\x0e\xad`\x0e/\xc2z)\x94:F\xf6gN\xbd\x16\xa7\xf6\xc3\xd6\xe3ec~?!>\x19\xb0\x90Y\xb7\xfc\xb2\t~\x92\xc3\xd1Z\xfaҵR\xf8\xf9\xb7\x19\x94\x1c\x84\xd5Ro\xa6\xd5\xfdK\"\x1a\xf1\u07b4\xff\xd7\xf3\xdf,`߃\xcf\xcc\xf2~\xa9\a\x8f\xc4ғ\xa5\xe6+\xef\xfeK\xfb\x8fњ\xa7O\xba\xfc\x82\xbaO\xbbǲ\x83}\x12%\xad\xb4\x01Z\x14\x05\xd6>M0\xbb\x1fwon\xf8O\xfez\xcb\u007f\v\xa3c\xf5\xe5\x96\xf0\u05ff\xcd !\xf0\x9e\xe5\xa0\xc5\u007f\a\x00\x00\xff\xff\x1d\\\x87\xf9\x11\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<Ko#\xb9\xd1w\xff\x8a\x82\xbf\xc3\xec\x02\x96\x8c\xc1w\tt\x9b\xf5x\x11#\x93\xd9\xc1\x8e\xe3K\x90\x03\xd5]\xb2\x18u\x93\x1d\x92-[\t\xf2߃*\xb2\x9f\xea\a\xdb\xeb\t&\v\xf3dSd\xb1Xo\x16\x8b}\xb1Z\xad.D!\x1f\xd0X\xa9\xd5\x06D!\xf1١\xa2\xff\xec\xfa\xf0\a\xbb\x96\xfa\xfa\xf8~\x8bN\xbc\xbf8H\x95n\u09b4N翢եI\xf0#\ue912Nju\x91\xa3\x13\xa9pbs\x01 \x94\xd2NP\xb7\xa5\u007f\x01\x12\xad\x9c\xd1Y\x86f\xf5\x88j}(\xb7\xb8-e\x96\xa2\xe1\x15\xaa\xf5\u007f(\xd5A\xe9'\xf5\xe3\x05@b\x90!\xdc\xcb\x1c\xad\x13y\xb1\x01Uf\xd9\x05\x80\x129n\xc0&{L\xcb\f\xed\xfa\x88\x19\x1a\xbd\x96\xfa\xc2\x16\x98Ђ\x8fF\x97\xc5\x06\x9a\x1f\xfc\xa4\x80\x8c\xdf\xc8\xd70\x9f\xbb2iݟ:ݟ\xa4u\xfcS\x91\x95Fd\xad\xf5\xb8\xd7J\xf5Xf\xc24\xfd\x17\x00\x85A\x8b\xe6\x88\u007f\xf1\xbb\xf8Yb\x96\xda\r\xecDf\xe9g\x9b\xe8\x027\xf0\x990)D\x82\xe9\x05\xc0Qd2\xe5}z\xdct\x81\xea×\xbb\x87\xff'<r\xe1;\x01R\xb4\x89\x91\x05\x8f\xabQ\x04iA\xc0\x03o\x12L\xe0\b\xb8\xbdp`\x90qQ\x8eF\x14\x06W\x15\x96)h\x13`\x02\x14h\xa4Ne\x02?\x89\xe4P\x16~\xaa\xdd\xeb2Ka\x8b`J\xb5\x0ec\v\xa3\v4NV$\xa4\xd6\x12\x9c\xba\xaf\x87\xe9;ڊ\x1f\x03)\x89\nZp{\x84\xc0pL\x99z\xb9\x00\xbd\x03\xb7\x97\xb6\xc1\x9bI\xd2\x02\v4D(\xd0ۿc\xe2\xd6\xf0\x95\xe8ll\x85m\xa2\xd5\x11\r\xed;яJ\xfe\xb3\x86l\xc1i^2\x13\x0e\x03G\xab&\x95C\xa3DFL(\xf1\n\x84J!\x17'0Hk@\xa9Z\xd0x\x88]ß\xb5A\x90j\xa77\xb0w\xae\xb0\x9b\xeb\xebG\xe9*UIt\x9e\x97J\xba\xd35\v\xbcܖN\x1b{\x9d\xe2\x11\xb3k+\x1fW\xc2${\xe90q\xa5\xc1kQ\xc8\x15#\xaeXS\xd6y\xfa\u007f\x15\x17\xed\xbb\x16\xa6\xeeDbc\x9d\x91\xea\xb1\xeef!\x1e\xa5;ɲ\x17\x0f?\xcd\xe3ߐ\x97\xba\x88*\xbf\xde~\xbdo\x8b\x8e\xb4]\x9a3\xb5[\xd2\xd4\x10\x9e\b%\xd5\x0e\x8dg\xdc\xce\xe8\x9c!\xa2J\v-\x95\xe3\u007f\x92L\xa2\xea\x12ݖ\xdb\\:\xe2\xf4?J\xb4\x8e\xf8\xb3\x86\x1b6\x18$se\x91\n\x87\xe9\x1a\xee\x14܈\x1c\xb3\x1ba\U0005b4dd(lWD\xd2y·\xed\\w\xa0\xa7V\xdd]\x19\xa3A\x0eU:\xfc\xb5\xc0\xa4\xa3\x1a4K\xeed\xc2\n\x00;m\x1a\x15\xb7m\x8b5\xae\x97\xbcv\x18\xda흴#7F+\xc0g\xe2t\xa3\xaf$'O{T\xa4E\xa6T\x84a\x0f\"\x04\xe3\xb1\xee\xf5\x0fҎ\u007f\xc0\xbc e\x9cD\xed>\f\"Ԉ*i\xedg\xbc\xa9\xc0\xdad\xe9`\xa9@\x0fcW\x18}\x94i\xb0\a=\xeaMQ\x90\x1a>'Y\x99bZ[\xec\x811=\xc4oϦ\xb0\xef\x13R\x11\x8d\xc9\xcd\xd0\x06T\xf3+\xd9\xdc\x01\xa0\x00\xc2 \x90RH\xe5!\x82\xe4\r\xc2v\x90\xdcԤ\xc3|\x10\xc3\tn\xf8F\x8eUl3܀3\xe59\r\xab\xf9\xc2\x18q\x1a\xa5R\x15\x13\xc4\x13\xa9\x9e\x11LU&\x13$\xf2\xd4\x06\x89\xe9\xf4; \xd1^\xeb\xc3<Y\xfeH\xa3\x1ac\v\t\x87Z\xb0Ž8Jml\xdf?\xe33&\xa5\xe30b\x806\x0eR\xb9ۡ!H\xc5^X\xb4\x95ꌓgJ\x19\xa8\x99)\x16\x9f\xed\xa7a/1\x8ai0\xb6\x0562#0\x81\x11&KT\x16 U*\x8f2-E\x06RY'T\xe2\xf7%j܆\xf6\x05Ӭ?\xc3\xdc\x1b\x97\n\u007f\xe2K\xc7Nk\x85\xa0\r\xe4\xe4\x94·\xda\xd15`t\xfb[a)4\xf3\xe2k(\xca\f\x8b\xa5\xec\x02\x1a{q5\x01\xbc\xe6\x8e\x0fe2\xb1\xc5\f,f\x988m\xc6\xc82\xcft\xdf\xe2l\xe1\b=\a\xacb\xf0s\xc1\xeb5\x1b\x9c\x04\nd\xef\x9f\xf62\xd9\xfb\xa8\x83d\x8a!A\xaaѲ-\x10E\x91\x9d\xc67\v\xf3\x92\x10\x16\x9a6\aM\x9b5\f}\x98\xc3&\xa2iQ\xf6\xb4i3\x96\xb5K\xe7ZD\xde\xc8\\9\x8d\x17\t\xf4\xdd\xd9\xe4\xd7\x16h\"\xb0\xa4\x13\xc7\xdd\x0e0/\xdc\xe9\n\xa4\xabz\xe7a\x8a,k\xe1\xf0\xbb`\xd4K\xf4\xe1\xae?\xf7\x95\xf5\xe1\x15\xb8T\xa3\xf0?\xcd$v6_\x83\xafY\xc0\xa0O\xedyW w5\x83\xd2+\xd8\xc9\xcc!\xc7=\xd3(\xb6\\\xdf,\xa7^\x8b,q^\x93Z.\\\xb2\xbf\xad\x0fV\xb3\xe3{\x14\xeaO\xf7\xb1ru\x92\xe8:\xf9Y\xc8\xc0\xe7ni0\xf7\xa7\xf9{ց\xa6\x87#\xb5\x0f\x9f?b:M(\x88\x95ȳ\xed|\xe8\xa1\xdc^>\x1c\x03\xe27\x13\x02\xaa\xfa\x84\xe5\xb34W \xe0\x80'\x1f\x05\t\x05\xc4(AK\x8d\x1e$Ή\xc4\xe9\"6\x11\a<1\xa0\x90\x01\x8a\x98\x1f/\x1a\xbe\x1d\xf0\x147\xb0GJ\xc2,\x9c\x8f=M\xa9\x83\t\u0089\x84%d\x04\xce籆\x80\xd31\x9b\x84%\xe6\xa6j\x15'^\xb4ݚ\x8d\x9d\xe4\xe6\x01O\xef\xacg\x18i\xc7^\x16\xd1\x1b&\x03\f\x16Y\x8f\xaa\xfcރ\xc8dZ/\xe5\xf5\xe1NME\xdd\xdd\xf6Y\xbb;u\x05\xb7\xcf\xd2\x12z*\x85\x8f\x1a\xedg\xed\xb8\xe7\x9b\x11֣\xff\"\xb2\xfa\xa9\xaczʛy\xa2G;m\x18%\xf4\xbe\xdd\xf9\x13f\xcd*i\xe1N\xd1Y)Ѕ\x93\xbf\f3^,\x19\xa5\xbc\xb4\x9c\x1fTZ\xad\xd8Ѯ\a֊\x86\x19أM\x87;m\xf4Z\xcbFC\xa5\x03\x9dG\xed\x9e|\x8f\x87\xe0\x93ڙH0\x85\xb4d\xa2\x8ah\x88\xd6\x19\xe1\xf0Q&\x90\xa3yD(\xc8\x17\xc4r#\xda>\xfb\xb6X\xe6bC\x83\xaa\x05C\x9fƠ\xb4\"\xbd\x8e\x1aW\xb1?b\xf0`\x96vzp\xcc\xde\xd8As\x1c\x13Am\x91\xa6\x9c\xc6\x14ٗE^b\x11w\xce\xe3\a\x8f\x9e\xf7\xaf\xb9(H\xc3\xffE.\x92\x85\xfd\xdfP\bi\xa2\xb4\xfc\x03_|eؙ\x1d\xb2n\xed\x85h\ri\x818~\x14Y\xff\x0e`d\x8b\x9a,\x10f>\x14л\xb3\xc8\xe7\n\x9e\xf6\xdaz\x8f\xbc\x93\x98\r'\xbe\xbaMZ\xb8<\xe0\xe9\xf2\xea\xcc.]ީK\x1f\"\xf4\xb5>\x02l\x1dqh\x95\x9d\xe0\x92g_\xfe\xb6p*Z:#\a\xf2-i| N'\xd9*\x9a\xa0\xa9\xf5\x95\x1c\x85\xd0\xd3\xd8G\xcaf\xa1\xad[\x80\xd0\x17m\x9dψv\x02\xdee\xf96\xf0r\x15\xf2l v\x0e\rX\xa7Mu\x01FF\xb2\x976&.ڹ\x03\a1\xb6\xce\xdey\xb0t\x98\xbbl\xf4\xdb\xdb\xdfK\u007f3F\u007f\xcfAL8\x80aȅ\xd1\tZ;'6Q\x16~&\xb1Y'5\x85?,\xf1\x8dӬ\xb0\xce%[\xab\xb6$\x14&r.>N\xdc>\xb7\xf2\xb2d>\xe8\xffy\x91]\x8e\x1d\xb0\xd6\xe7\xb9PQ\x0e\xec\f\xd1\x1b?\xb7R\xb1\x00\xca\x1fQ\xccc\xc9\xe6bI\xe4\x1a\x84\xef\xfb\t\x06r\xa9\xeex\x11x\xffM\u0087\xda\xe8\xe2ˎ\x0f7\xd5\xec\x86\x05u\xc7\xf0\xd5\xe1X+4\xdfW\x18\xecp\xf2<\xab\xbf(lVڵS\x1f\x04\xb9\xd0\xe9;\v;i\xack\x90\x8d\x86)-\x94\xb3\x16\xa4i\xcb\xcfr\xea֘\x17\x1e\xe5~\xf1s[ɸ\xbd~\xaa\xaf\xb9\x99\x90\v\xa2\xee\xbd8\"\xc8\x1dH\a\xa8\x12]*N\x1a\x911\xe0E<;\xe2\x05\x19b\xfd^\xd3P\x95y,!V,\x89R\xcd\xe4\x97\xda\x13~\x162\xfbVlt2G]\xce8\xe6\xa6uo\xe9\xfd\xdcN\xfdB.\x9ee^\xe6 rbD4\xc9)ڐ9ve\x00\x9e\x84t\xec\x91\b2\xbb'\xa7\xa3A&:/2t\b[\xdci\xc3\xfane\x8a\xb5\xeb\x0fr\xa1\xc7\xef\x1d\xfbM\xc0NȬ4\xd1Vw!7\x96\x9d\x90\x82\xe1y݃O,\n+&_T:::\xa4\x9d\xf3\x04\x85Y\x12\xd0~1\xf8\xda\xe1ca$ɢ\x9e\x8b g r|ٍ \x83\x88\nu\x1a\v!g`2\x16o!\xe4[\b\x19\a\xf7-\x84|\v!\xe7\xdb[\b\xf9\x16B\xc6Lx\v!\xdfB\xc8\xe5(\xfc\xf7C\xc8y\xccV\x9c\xfb\x1c\xfd9\x02\x9b\xa8\x12\x82id'W\t\xd507Yi\x1d\x9a\xf8\x9aۻ\xe1y-\xfb\xf9\xb4G\xb7G\x03\x89\x1f\xb2\xe2\xe7*òє[4.\xab.\xc2%e\xab\x14\xc5W\xb1\xcfFǑŵ[\xad3\x14\xe7\xca\x16W\xca5W\xc0խA\xae\x8b\xa7\xaa\"\xe4a\xab\x11\x96\x0e\xdc\xf2\xef \xda\xd5@\xdd:,\x8e\xcc+l\xbf\xa3\xfa\xe4\xa8\x12\xab\x99ª\xe9\x12n]\xad1B\xc5N\x02\xbeK>\xd3)#\xfe\x0e\xa97[\xfb4^\xf1\x14n\xe4Љ\xe3\xfbu\xf7\x17\xa7C\xfd\x13<I\xb7\x1f\xdc\x14\xbf̠\xe3\xa2zl\x17FW\xb2\x18^>\xf5\xa9\nڀ\x92\xd9pM\x03\x11\xbc\x9a\xdf!7\xfcR\xf8C\xe9\x8b\xf4w\xee\x98\x14[#\xf5\xe2ʨn\xddӨ\x81_~\x81\xb7\xa4\xac<\xbe\xf6i\xaeTiI\xc5S\xbb\x9ai\x02dl\x9dS܉w\xb6\xa6\xe9\x05\x95L\xd1u\x95\xafr]\x19W\xab\xf4-*\x94\x16\xd4%u\xeb\x8df\xe0.\xabF\x8a$SL\xe5\xd1\xe2z\xa3P\xdb3\xb3\x9f\x88*\xa3\xd1\xea\xa1\x19\xd0\x03uL\xf35Cs\xe4\xef\xa0\xf2*\x95B/\xa8\x0fz\xdd:\xe2\u05ca\xba\xa7\xaa}\"j|\"\xe2\xf29L#\xaax\x96\xd5\xeeD\xd0\xf0\x85u:u\x15\xce\xe8\xdaK\xabs\xba\xb57\xa3`cjrF*nFaNV\xe2\xc4\xd6ٌB\x9fu\xdf3\x923\xf9\xb3U\xa2\xb0{\xed\x1etV\xe6\x111\xf3\xd7\xee\xf8\x81\xa3\x17El‐d\xbaLk\xf8\xc3\xdb\xe3\xf7\xf0'\xf8\xf2\xc0΅\x1f\xfa%\xcd\x13\xc8\xe0>\xaaP\xae\xffBr\xf8\xcd.\xfc\xf6\xa3\x98uڈG\xfc\xa4\x93\xd6G\r\xa6h\xd2\x1d\xdfy\xb3\x1e\x98_%[BU\xd2p\xd8\x1av\xd4\a\xd7\xe4X}\x14\xdc:\xaf\x12\xa6\xc3r1\xa9\xb9\xcee\xb3\x9b\xba\xbf\xff\xe47\xe2d\x8e돥?\x06\xaf\na,\x12m\xab\r\xfaI\xdb1\x03\xb1\xd7O\x90\xe9\xb0\xfb\x9f\xfa\xf8\x1b\xe4l.\x9f\xb7\x17\xef\xe2\xc8\"X\tdE\xaey\x11~\x18\x9e\u05ca\xbc[L\xe3\xa3ߘ\xec\x8eA\x12\xd6\xeaD\n~\x13*]x\xc6\xf1-\x1e\xf4\x8e;\x84\x11\xa5\x1f\xf2c\xab\xa1g\xe5\xab\xfa\x8d\xfb\xc5\fP\xeb\x84+\xed\xfcG\x02x\x18$\xa2p\xa5\tIҤ4\xfc\xb4\x97@\xa0\u007f\x01\xbb\xfc3\x01\x99\xb0\xce\v\xd6\xe4k\xfcO\xf5\xb0&J\xb7\xceg[+̓'a\xf9\x1d\xbeϵJ\xdb\xfd\xc0J\xbb\x8d\xbc\xc4\xdfi\x93\v\xb7\x81T8\\\x11\xec\xde\uf4d6i\x94\xd9\xfc\xf4yrw_hD}\xa3\x13\xc8\xcaӪ\a\xd3#;\x19Jٯ\xe03>\x9d\xf5\xdd*B\xbc\x9fK\xf3YyL\x1f\xeao\xc0\xc4n\xaa\xf9j\f_\x82\x9c)@Wg{\x83{\x99\x1a:\xf17\xf0\xfc\x85\x87\x85\x1f乡\xe5\xe3WB;\xf9\xb1\xf7ۈ\x16Nh\xe0\xb0\xf6\r(I\xaf\xab\xfeT\xd0\xf1}\xf3\x1f/\xbd\n\xdf\x05:\xfa\xbbC\xfe\x10Oڒ\x95\xe0\x99BO\xa3y\"I\xb0p!\x13\xd8\xfe@\xd0\xe5%\xffS}\xff\x87\xffM\xb4\xf21\xa0\xdd\xc0_\xffv\x01\xc1\x8b<TxP\xe7\u007f\x02\x00\x00\xff\xff\x04C\xfb2VI\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VK\x8f\xe44\x10\xbe\xe7W\x94\x96ÂD\xd2ZqA\xb9\xa1\x01\xa4\x110\x1aM/sA\x1c\xdcv\xa5\xbb\x18\xc7\x0e\xaer\x0fͯG\xb6\x93~\xa4\xd3;\xbb\arK\xa5\x1e_}\xf5HUu]Wj\xa0g\fL\u07b5\xa0\x06\xc2\u007f\x04]z\xe3\xe6\xe5{nȯ\xf6\x1f6(\xeaC\xf5Bδp\x17Y|\xff\x84\xecc\xd0\xf8#v\xe4HȻ\xaaGQF\x89j+\x00\xe5\x9c\x17\x95Ĝ^\x01\xb4w\x12\xbc\xb5\x18\xea-\xba\xe6%np\x13\xc9\x1a\f9\xc2\x14\xff\xeb\xe8^\x9c\u007fu\xdfT\x00:`\xf6\xf0\x91zdQ\xfdЂ\x8b\xd6V\x00N\xf5\xd8\x02cHv\xa2$r\xc0\xbf#\xb2p\xb3G\x8b\xc17\xe4+\x1eP\xa7\xd8\xdb\xe0\xe3\xd0\xc2\xe9C\xb1\x1fq\x95\x9c\xd6\xd9\xd5:\xbbz*\xae\xf2WK,\xbf\xdc\xd2\xf8\x95F\xad\xc1Ơ\xec2\xa0\xac\xc0\xe4\xb6Ѫ\xb0\xa8R\x01\f\x01\xf3\x87\xdfK\xf2?\x13Z\xc3-t\xca2V\x00\xac\xfd\x80-<$ԃ\xd2h*\x80\xbd\xb2d2=%\x0f?\xa0\xfb\xe1\xf1\xfe\xf9\xbb\xb5\xdea\xaf\x8a\x10\xc0 \xeb@C\xd6[\xca\x01\x88A\xc1\x88\x04ă\xd2\x1a\x99A\xc7\x10\xd0\t\x14\xa4@\xae\xf3\xa1\xcf\xe1F\xc7\x00j㣀\xec\x10\x9e3\xb5cnͨ0\x04?`\x10\x9a\x88\xce&\xa7N;\xcaf\x18ߧ$\x8a\x0e\x98\xd4[\xc89\xc6\xd8!h\x80s\x82\xe0;\x90\x1d1\x04\xcc\xec9\xb9D\x979\xe9@9\xf0\x9b\xbfPK3f\xcf\xc0;\x1f\xadI\r\xb9\xc7 \x10P\xfb\xad\xa3\u007f\x8f\x9e9ѐBZ%S\x1fL\x0f9\xc1\xe0\x94M\xf4G\xfc\x16\x943Ы\x03\x04L1 \xba3oY\x85\x1b\xf8\xcd\a\xcc\x04\xb6\xb0\x13\x19\xb8]\xad\xb6$\xd3li\xdf\xf7ё\x1cVyBh\x13\xc5\a^\x19ܣ]1mk\x15\xf4\x8e\x04\xb5Ā+5P\x9d\x81\xbb<ZMo\xbe\n\xe3 \xf2\xfb3\xa4rH\r\xc3\x12\xc8m\x8f\xe2\xdc\xea7yOm^\xba\xa1\x98\x15\xfc'z\x93(\xb1\xf2\xf4\xd3\xfa#LAs\t.9\xcfl\x9f\xcc\xf8D|\"\x8a\\\x87\xa1\x14\xae\v\xbe\xcf\x1eљ\xc1\x93+\xbd\xa4-\xa1\xbb$\x9d\xe3\xa6'\xe1\xa9KS}\x1a\xb8\xcb\x1b\x066\bq0J\xd04p\xef\xe0N\xf5h\xef\x14\xe3\xffN{b\x98\xebD\xe9\xdbğ/\xc6K\xc5\xc2\xd6Q<\xad\xac\xc5\n-L\xefz@\x9dj\x96\x88K\xb6ԑ\xcec\x00\x9d\x0f\xa0\x96L\x9a71d\xed/B1\ue202c\xb69\xd2\f\xbe\x85ciUd\xf9N1^\x8afh\x1e\x93\xc6<\xb2\xa5\x0e\xf5A[,\x0eʦ\xc0\xb7@\xa4\a]\xec\xe7\xf1jx\xc0\xd7+\xd9c\xf0iO\xe6U|\xfe,\xd6\x1f\xca?bK\x8e?\x9dM\xd1\xc9\u007f\x9d\xf3\x95{\xb6jG7\x10\xa2si\"\xbdK\xe2\x99S\xb8\xdcȳ\xaf$\xd8_\xe1XDr\xef:\x9f\u007f\xdc*\x85TR\xe6\x04Ǣ\x8e1\n\xa2+w\xb7jZ\x9e\xf9*\xfa\f\x02˓\xff\xfc_n\x98V\a\x05\\\x88Yg,\v\xe2\x14\xe9J\xbc81#\xb2h\xad\xdaXlAB\x9c[\x16;\x15\x82:\\v\xc5\xd4F\xa7\x1b\xe7\x93\rr\xa5\x9ez\xffu\x87\xeeV\x87ë\xe2\xa5\xda\x147\xb09\xdc2\xbc;\xdek\xf3!)m\xd9Bں\xb5\xd0\x15K\x9fA\xc4B\x95J\xab.\\\aW$\xac\xcf5\xa7ٿh\xf8\xe9X\x98#\xbf\x11|\xa1\xa83\xd1\xf1<\xdd\u007f8\xbd\xe5Ʈ\xc7[4\u007f\x18\xb30g\x99\xb3\xf8\xa0\xb6\x13\x17\xa7ݚάA\xd0<\xcc/\xd1w\xef.N\xca\xfc\xaa\xbd3Tni\xf8\xe3ϪxE\xf3<\xe1H\xc2\xff\x02\x00\x00\xff\xff\xc5\xf1\a\xa8\xca\v\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x8f\x1b7\f\xbdϯ \xd2CZ\xa03Ƣ\x97bn\xc1\xb6\x05\x82\xa6\xc1\"\x9b\xec\xa5\xe8A\x9686\xbb\x1aI\x15)\xa7\xdb__\xe8c\xd6\x1e\xc7NZ\x14\x9d\x9bh\x8a|||\xa4\xd5\xf5}ߩ@\x0f\x18\x99\xbc\x1bA\x05\xc2?\x05]>\xf1\xf0\xf8=\x0f\xe47\x87\x9b-\x8a\xba\xe9\x1eə\x11n\x13\x8b\x9f\xdf!\xfb\x145\xfe\x80\x139\x12\xf2\xae\x9bQ\x94Q\xa2\xc6\x0e@9\xe7Ee3\xe7#\x80\xf6N\xa2\xb7\x16c\xbfC7<\xa6-n\x13Y\x83\xb1dX\xf2\u007f\x9dܣ\xf3\x1f\xdd7\x1d\x80\x8eX\"\xbc\xa7\x19Y\xd4\x1cFp\xc9\xda\x0e\xc0\xa9\x19G8x\x9bfd\xa7\x02\xef\xbdX\xafk\xbe\xe1\x80\x16\xa3\x1f\xc8w\x1cP\xe7\xf4\xbb\xe8S\x18\xe1\xf8C\rѠղ\x1eJ\xb4\xfb\x16\xedM\x8bV\x1c,\xb1\xfc\xfc\x19\xa77\xc4R\x1c\x83MQ٫Ȋ\x0f\x93\xdb%\xab\xe25\xaf\x0e Dd\x8c\a\xfcP\xb9\xf8\x89\xd0\x1a\x1eaR\x96\xb1\x03`\xed\x03\x8e\xf06W\x10\x94F\xd3\x01\x1c\x94%S\xeeך|@\xf7\xea\xee\xf5\xc3w\xf7z\x8f\xb3\xaaF\x00\x83\xac#\x85\xe2w\xa5\x18 \x06\x05\v\x1a\xf8\xb8ǈ\xf0P\x98\x03\x16\x1f\x91\x1b\xf0\x16\x12`\xa9\x80\x87f\n\xd1\a\x8cB\v\xc1\xf9;\x11ٳ\xed\f\xcf\xcb\f\xb8\xfa\x80ɲB\x06\xd9#4q\xa0\x01.ŀ\x9f@\xf6\xc4\x10\xb10\xe5\xe4ت\xe5\xf3\x13(\a~\xfb;j\x19\xe0>\xb3\x19\x19x\xef\x935Y\x8b\a\x8c\x02\x11\xb5\xdf9\xfa\xeb92\x83\xf8\x92\xd2*\xc1\xd6\xd3\xe5#'\x18\x9d\xb2\x99\xea\x84߂r\x06f\xf5\x04\x11s\x0eH\xee$Zq\xe1\x01~\xf1\x11\x81\xdc\xe4G؋\x04\x1e7\x9b\x1d\xc92V\xda\xcfsr$O\x9b2\x1c\xb4M\xe2#o\f\x1e\xd0n\x98v\xbd\x8azO\x82ZRč\n\xd4\x17ப|6_\xc56\x83\xfc\xf2\x04\xa9<eq\xb0Dr\xbbgs\x91\xf8U\u07b3\xb6k\xdb뵊\xffHo6eV\xde\xfdx\xff\x1e\x96\xa4\xa5\x05k\xce\v\xdb\xc7k|$>\x13En\xc2X\x1b7E?\x97\x88\xe8L\xf0\xe4\xa4\x1c\xb4%tk\xd29mg\x92\xdc\xe9?\x12\xb2\xe4\xfe\fp[\x96\vl\x11R0J\xd0\f\xf0\xda\xc1\xad\x9a\xd1\xde*\xc6\xff\x9d\xf6\xcc0\xf7\x99\xd2/\x13\u007f\xba\x13\u05ce\x95\xad\xe3\x10\xb5Uu\xb1C\x97'\xf5>\xa0^\rJ\x8eA\x13\xb5ɝ|\x04\xb5b\xb3M\xf1\xe5hÉ\xeb\xa5\x01\x86\xba\xc4'ڭm\x00ʘ\xf2\a\xa0\xecݕ{W\xe9\xb9P\xebmɑ\xe5\x98\v\b\xd1\x1f\xc8`\xec\x97\xda\x1a\x86\x14[\x91e7\x0eݥ\\g\f\xb7\xc2J\xb8sx+\x04w\xcd)cȴ.\x97\xea\xde\xc1\xb6\xfe\xca2T;\xbc\x9c\xfb\x93:\xb3\x82)\xe2j\n\xfb\xe7\xd0_T\x87(I\xfco\xf5Q.5\xcfmӈN1\xa2\x93\x16\x11\xfc\xb4\x82\xaf\xfe\xbbF\xc2^1~\x96\xdf˱\xef\xf2\xbd\x85rK\x13\xea'\x8b5Z\xd9\xe6\x9f\b\xea\x1f#\xcd\x1f\xba4\x9f\x83\xea\xe1\xd5A\x91U[\x8b\x9f\xfc\xf2\xc1\xa9+\xbf]\xe9\uf176\x9d\x99\x9e\xdf8\x87\x9b㩐\xd7/\x0f\x9a\x9b\xfaB\xc8Kӌ 1\xd5\xe4Mi\xcdrԂ\xd2\x1a\x83\xa0y{\xfe\x96y\xf1b\xf5\x1c)G\xed]\x1dS\x1e\xe1\xd7ߺ\x1a\x15\xcdÂ#\x1b\xff\x0e\x00\x00\xff\xff'\x03\xd5\b\x0f\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<]o\x1c9r\xef\xfd+\nʃ\xf7\x00\xcd8N\x90 \x987\xaf\xec\xc5\t\xbb\xf1\t+\x9d\xef\xe1p\x0f\x9c\xee\x9a\x19F\xddd\x1fɖ<\x1b\xe4\xbf\aU$\xfb\xfbkde7\x8b\xb3\xda\x0f\x9en\xb2X\xdf,\x16\x8bL6\x9bM\"J\xf9\x19\x8d\x95Z\xed@\x94\x12\xbf8T\xf4\xcbn\x1f\xff\xc3n\xa5~\xfb\xf4n\x8fN\xbcK\x1e\xa5\xcavpSY\xa7\x8b\x9f\xd1\xeaʤ\xf8\x01\x0fRI'\xb5J\nt\"\x13N\xec\x12\x00\xa1\x94v\x82^[\xfa\t\x90j\xe5\x8c\xces4\x9b#\xaa\xedc\xb5\xc7}%\xf3\f\r\x8f\x10\xc7\xff\xaeR\x8fJ?\xab?$\x00\xa9A\x86\xf0 \v\xb4N\x14\xe5\x0eT\x95\xe7\t\x80\x12\x05\xee`/\xd2Ǫ\xb4\xdb'\xcc\xd1\xe8\xadԉ-1\xa5\xe1\x8eFW\xe5\x0e\x9a\x0f\xbeK@œ\xf1=\xf7\xe6\x17\xb9\xb4\xee\xc7\xd6˟\xa4u\xfc\xa1\xcc+#\xf2z$~g\xa5:V\xb90\xf1m\x02P\x1a\xb4h\x9e\xf0\xcf\x1e\xf7\x1f$\xe6\x99\xdd\xc1A\xe4\x16\x13\x00\x9b\xea\x12w\xf0I\x14hK\x91b\x96\x00<\x89\\fL\x9d\xc7I\x97\xa8\xde\xdf\xdd~\xfe\xd7\xfb\xf4\x84\x05\xb3\x90^ghS#Kn\x17\x90\x03iA\xc0g&\rL\x90\x02\xb8\x93p\xf4\x8bQQ\u0382;!\xa4\xa2t\x95A\xd0\a\xf8\xb1ڣQ\xe8\xd0\x06\xc8\x00i^Y\x87\x06\xac\x13\x0eA8\x10Pj\xa9\x1cH\x05N\x16\b߽\xbf\xbb\x05\xbd\xff/L\x9d\x05\xa12\x10\xd6\xeaT\n\x87\x19<\xe9\xbc*\xd0\xf7\xfd\xc36\xc0,\x8d.\xd18\x19\x19MOK\xb9\xeaw=\xba\xde\x10\xe1\xbe\rd\xa4N\xe8\xd1\x7f\xf2\xef0\x03\xcbL!:\xdcIZ0\x18\xc8d\x06\xb6\xc0\x025\x11* \xbd\x85{\x92\x8a\xb1`O\xba\xca3\xd2\xc1'4ħT\x1f\x95\xfc\xa5\x86l\xc1i\x1e2\x17\x0e\xad\xeb@\x94ʡQ\"'\x91Ux͌(\xc4\x19\f\x12c\xa0R-h\xdc\xc4n\xe1?\xb5A\x90\xea\xa0wpr\xae\xb4\xbb\xb7o\x8f\xd2EsJuQTJ\xba\xf3[6\n\xb9\xaf\x9c6\xf6m\x86O\x98\xbf\xb5\xf2\xb8\x11&=I\x87)\t\xef\xad(\xe5\x86\x11WD\xac\xdd\x16\xd9?E\xa9\xdb7-Lݙ\x94\xcc:#ձ~ͪ>\xc9w\xd2y\xafN\xbe\x9b'\xb1a\xafTG\xe6\xca\xcf\x1f\xef\x1fڪ&\x1b%\xa2\xc7s\xbb\xe9f\x1b\xc6\x13\xa3\xa4:\xa0\xe1^p0\xba`\x88\xa82\xafk\xf4#\xcd%\xaa.\xd3m\xb5/\xa4#I\xff\xbdBKꬷp\xc3N\x05\xf6\bU\x99\x91\x16n\xe1V\xc1\x8d(0\xbf\x11\x16\xff\xcf\xd9N\x1c\xb6\x1bb\xe92\xe3۾0\xfeQ\xff]\xe0V\xfd:\xba\xacQ\ty\x8b\xbf/1\xed\x18\x06\xf5\x91\a\x99\xb2\xfa\xc3A\x9b\xc6!x\x9f\x14\rr\xca(\xe9I\x85J1\xef\xbe\xeb\r\x7f\xc3MZB 'C\x12\xf3\xa3\x90$\xac\xd3eI\x92x\x1f_\x92'z\xd3U\x10z>\xe13h\x03\xb7\xea\xce\xe8\xa3Ak\xe1\xf9\x84\n\xa4{c\xc1\xa2\x03T\x99e\xcfsB\x12t\x8a9fP\x9eH\xae=X\x9e\x8d{\xads\x14\xaa\xf3-Ã\xa8r\xf7\x99}\x93}\xd0?\xa3u2\x9d%\xf1\xc3h\x97\xc8ad,\xdd\t\r\xa9?\x7f`O҃\bĉ\xcab\xc6nD<\"\x88\xc8\r\xf2Gy\x0e\xa5\x8e.\xd3\xc2\xfe\x1c\x11\xedSF\xb3\x9b\xd8\xe7\xb8\x03g*\\M6~I\xf3*ì\x9e`\xec,\xc9\x1f\a\xcd\xc91:!\x15y\x02\x9a\vɋ\xaa\xe6+I\x14\x84\xe9#\x04@\xd6(\x95\x87\x16e7T@z\xa4\xc3b\x80Մ\xe9\xac\xe6\x850F\x9cG9\x11\x83\x93u\x8c\xa8[\a_\x98˔\xe7\xcc\xda\xe31/~Gl8i\xfd8O\xfa\x1f\xa9E\xe3\xb1!\xe5\x98\x0e\xf6x\x12OR\x9b \xf30m\xee\x11\xf0\v\xa6\x95\xe3ȥ\xfb\b\a\x99<\x1cРr\xde\\-\xb1n\x9a\x05S\ue21e\xc8\xf0\x91O=\xfc\x1b\x91\t\x83\x9e\xde)\x94ɂ\x15\x9b㐻\xfe\xa9J\x90*\x93O2\xabD\x0eRYG\xfe\x87\xe9\x105N}:f\xc49\xc0ֻ\xf1\x883\xf1\xbe\xe3ҵBr\x8d\x05\x05\ræCW\x1a\x84?A\xee^\x90#\xd2^\rM\x95\xa3\r\x03e<S4v}=\x01\xb8\x96\x82\x8fur\xb1\xc7\x1c,\xe6\x98:m\xc6\xd80/Ե>j\x82w#ުq\xceDb\xdbQ\xe9I\x98\x00\xcf'\x99\x9e|\x18B\xfa\xc2.\x1e2\x8d\x96ݘ(\xcb\xfc<N܂\xa4\x17Mx\xa51/\x9b\xf5\x90\x9bQO.efݯ5\xd1\x11/k\xd1\xff\xe3\xb0R\xaa\xbe~\xad\xe4\xe5\xed\xa0\xe3k*&1Q\xa2\xdd\xc2\xed\x01\xb0(\xdd\xf9\x1a\xa4\x8bo)\xcc\x10\xbc\x10\x9ez\x9a\xb1\x7fw\x82\xb8T\xa7o\xfb\xfd^Q\xa7\xbfR\n\xf5п\x1b!\xb0\xb3\xbf\x0f\xbe~\xa5\x00~j\xf7\xb9\x06y\xa8\x05\x90]\xc3A\xe6\x0eMO\x12\x93p\x814{V\x12_˂噊\x9eB\xb8\xf4\xf4\xf1\ve\x19l\x93\xc2Zō~W\x90\xed\xa8\xba;\x99\xceB\xa5p\xe8\xef\x954X\xf8%\xf5\xc3\t;o(\"\x87\xf7\x9f>`6\xad]\xab4l@\xc2\xfb\x1e\x9a\xedaC\x88\xbc\x8e\x80\x10\xa4ԫ\vN/\xd8k\x10\xf0\x88g\x1f]P\xb2\xa6D#h\x18j\xbc\b\xd1 \xe7hش\x1f\xf1\xcc@B\xdae\xa1\xef:ч\xbc\t\x9e\x97\x1b\xf5\xd8F\xd8H\x1b\xd2H$fzQ/\x99W\xca<Dյ\x87\x99\x97\xed\x05.\">\x91\xdb\x17\x93W\x8b\xa9\xc9\xf3xA\xbe\xa14Mι\b{\x92\xe5\n\xb8l\xe6\xa4El\x131i\xf6\x99R\xa25~>\xb2\xbfU\xd7\xf0I\xbb[u\x9d\xac\x80\n\x1f\xbfH\x1br\x95\x1f4\xdaO\xda\xf1\x9bWg\xa2G\xf9b\x16\xfanlBʻa\xa2\xbf\x9d{[Tb\xff\xef\xf6\xc0:U\x8bDZʄi\x13x\xc5\x1f\xc3`s\u07be\xfbWT\xd6\xd1JBi\xb5\xe1\xc9n;6N`\xf1JEnKa\x88V=\xa4\x1fn\x15\xc4\a\x8a\x93|o\x9f\t\xce)\xa3\x0eY\xc5L\xe4L\xa6px\x94)\x14h\x8e\x98,\x80\xe3\x7f%\xf9\xec5ï\xf2\xa5/Ч5Ss\xfc\vθ\x93\xd6\x1d{6d\x9b\x8bm\xa2h\x17\x1a\x8e\xa6._N\aO\x92\x1c7,pSd\x19\xef-\x89\xfcn\xb5\xf7^\xcd\xf9\x8em\xb6Pb\x03\x85Bp\xf6\xee\xbfi\xaab[\xfa\x1f(\x854\x8b\x16\xfa\x9ew\x88r\xec\xf4\fY\xa1\xf6 \x04_Z i>\x89\xbc\x9f\x00\x1f\xfe\x91\xcbT\x809\xc7\x03\x84Y?Ҹ\x86瓶Hb\x87\x03mAA/O?|\xae\x1e\xf1|u=\xb0\xf1\xab[u\xe5\xa7\xe7\x81\xc5ƹ|\x01\xb0V\xf9\x19\xae\xb8\xe7\xd5\xcbC\x97UZ\xb7\xa2\x11\xad\x86v\xc9*5\xa0e`\x9cũ[\xbd\xe7DK\xb3m\xf2\x15:Wj\xebV\"q\xa7\xad\xe3\xd4O7x\x1c\xc9\rͯiBN\b\xc4\xc1\xef\xf3i\x13wtȑ\xf5R\x95$%\x8b\xa3\t\xce\x01\xc4,\x80\x14y\x0eW\x8d\x8d\xfa\xb5\xfd\x95\xdf\xe6\xa1\xff\x83H\xe9˜\xb6\xd0,_\x1a\x9d\xa2\xb5s\xea\xb0\xe8y;\f\x1cr\xaaN\xb6\t\xbf\xa8\xa0T\xd8|r\xefҰ\x91X3ߢ\x87\xe4\xc7/\xad\x1c\xa0P\x9cc]P\xb3\xcb0\xa2\x876\xbdDw\x0fp\x15r7\xbe_4\x85\x00\x86}\x820Ǌ|В\x0f\b\x96\xa1\xa3\xd2\xfc\xb6\x13l!\xd5-\xeb\x10\xbc{\xd5\xe9\x18\xe2\xe6\t^\x1eR\xdfĞ\r\x9b\xeb\x17\xde6K\x9d%\xb3\xf0\xc2\xf3|B\x83\x1dI\r3\xc3\x1c\xceQ\x82\xaeY\x9e\xaf\x82\x1d\xf0xc\xe1 \x8d\xad\x97s\x1e\xebj\xd6j_(-\xad>\x1a\xf3\x82%ʟ|\xbf\x9a@J\xa8=ǝщ\x9d\xbb\xb1\x87\xb7A\x902\x19\x926&S]Q\r\x00G\xed\xc8\x03x\x96zg\xba8\xc96{2k\x18\x85\xaa*\xd6\x10\xbea\xed\x91j&\xd7\xd1<\x1b\xf8A\xc8<Ylw\x99\x98\xa8HDWn\xb7ذ'&\xaa\xe7ѕ\xab}\x1f)X!\xbeȢ*@\x14\xc4\xec\x15\x10\x81fD\u00a0+_x\x16\xd2\xf1F\aA%\xa6\xd3Z3\xd5E\x99\xa3[\xc3*\x92\xfe\x81vbR\xad\xac̰\x9e2\x83̵\x02\x01\a!\xf3\xca\xe0\xf6u9\xba>\xb2\x0fF\xbe\xd0nU\xf8\xb4n\xd8\r;\xf1\xe4+\xc7Z\xf6\xaa\xa5Y\x1b\xa8\xdd\x19|\xcd\x10\xa94\x92tF\xbfn\x94\x14TI\xa8\xf3\xb70\xe9[\x98\xf4-L\xfa\x16&}\v\x93\xbe\x85I\xdf¤oa\xd2ׄI\xf3\x98l\xb8\xf0 y\xc1\xe8\x8b[\xa8ӈMB\x0e\xbb\xfa7\xbe\xd6<\x86\x1a\x83\xb9klG\xbf\xdfg\xa4(3\x94\xb0o\xb8\xc2~(\xe7\x18\xb7\xd4\x05\xe0{\xac\xcb\fX\xf9\xa3\xf2\xf2\xe6U/\xd2K.`\xcetm\xa6\x1cT\x89\xec\x92ˊJ\xba5\x89uaG,J\xd4q\x88\x1e\xd8X\x96m9\x1b\u05ee`\xa0\xa4]S\x1fB\xa1l\x8d\xe56Y\x15g\xcc\x18\xeb\n6\r\xf5'\x0e\x7f\x91z\xac.ۜ\xe6PW\xe0=\x165\xca\xf3\xff\x81C\x0e\x8b\xbfh\xf3\x88f\x817M\xbb\x18,\xa9\xaaأ!\xdda\\I\xcbQ\xa4\xa7\x86M\xa3\xdb\xeeD3\x19\x02fP\x95\xe4\xe4\xd3\xcaP\x8dg~fU\xaa\x94Ű\xa1\xc7'_\xcc\x1b\x1b\x8b\x9a\xa7\u009dB*\x9a\xbav\xf0Ͻ\x0f^\x9b\xe8\x98\xc5\x11M\xb2\xba\x1ae\xba\x06\x850\x10\\\x81\xff\xf4n\xdb\xfd\xe2t\xa8H\x81g\xe9N=\x88\x1c\x1f*\xa0\x85\x9a:\xb6KB\xa3%9=\xaa/T\xbc\xa9d~=Z\r\x14\xfbv\x94\b\xfe\xc4x\x8b|{\x89r\xcc-h\xfa\x9bA\xc3\x16=\x8e\xf5;\xccթ\xc4\x19\x87\x973\xdbd|[\xf6\x92-\x9e\t\xab\xf9\x8aJ\x94n\xa5I2\xb7m?[\x7frq}\xc9\xf2*s\xb6\x96\xe4\x05\x15$\xb1:d\x12&\xcc֍̸\xa6\xf8D\x8e\xacD{me\bMVb\x12$\\V\x0fҪ\xf5H\xd6\xd5\x1f|\x15K\x96*>:\fYS\xe7ѯ\xad\x98\x84\f\x8b\xd5\x1dӕ\x1b3@Gk:\xd6\xd4k\xcc\xc0\xac+9^\xb1Jc\xa16cƓ\xac\x96\xed\xf4\xb4\x1b\xff\x96\"\xee\xa9J\x8b\x85\xfa\x8aɨy\x19\xabV%\xc1\x18R\xeb\xeb&\x16\xf8\xd3\xd1\xeb\xf55\x12u\x15\xc4蘗VFtk\x1fFA\xae\xac\x87\x98\xa8x\x18\x05\xb9\xa2\nb\xa1\xcea\x14\xec\xec\xc48\xa3\x11\x93\x9f\xac\x12\xa5=\xe9x\x86m\x97\xccH\xf0\xbe\xdbvdI\x15O\xb0\xa5\xb9\xae\xb2\x1a\xf6\x90\x14:\x1c\xa3\xcep\xf7\x99\xcb\xff\xf8\x00P\xda\x1c\x7f\n\xae<\x06?1\xf0\x89\x9f\xbf\x7f\xcd%\x16e\xec\xc5\x11\x7f\xd2i\xeb<\xf5\x14\xfdݶ!\x86\xe00=\n5&2b\xf5\x87\b\xd8\xf6\xba&ӹ\xc5p\xf4\xafYs\x12\x86CyOZ\x9es\xf3\xc72\x1f\x1e~\xf2\x88\xd3\xf6\xd7\xf6Ce\x18\xa1M)\x8cE\xe2_$\xc8S\xbe\xa7\xff\x9e\xf4s\x0f\"@\xae\x03\xa5\xdf\xf7\xf15H\x8c\xf0k\xe4\xd5X\xfb\xe3\x8dQ\xc1\"\x9b\xe6\xd5\xf1\xf3x\x9fV,\xda\x12\n\t\x84\x0feM\xf4\xea\r\x04\xed\xe3\xea\x14\xeds\x122\x06\xefɪid\x92\xd8)\xe7<j\xa4tH\xbe\xea@\x1f;\xe4ˍ\xe2\x91\xfd\x90\xe7\xf6k\xae\x00\x80H\x7f\xc99\xdf\x13\xa6\x8f\xb6\x1a\x92\xb7n\x8e\x98aA\x87\x8a\x9b8\fG\xfe\x84\xfd\xfd\x1f\xdfo\xfe\xe5\xdf\xfe\xbdA\xa0{0\xf0\x8d\x05'\xcc^\xe4\xf90\xbf\xde\x1c\x97\r\x8d)\x92\xaa_\xd5N\x89\xd6rh\xaf\xc9\xc3\xd3\xc1\xb73\xff\xe6\xc4H_\xbe\xbc@9\xbf\xe1|eQ\xb6\xf7\xe6\b!\xeaF!\x0eR\x16ۜK\x87\x19\x9fch\x19\xf3\xc8\x1cQ\xb7}\x81\x1f\x1bq\xe3!\xf9ڹ\xefb\x96\xe1\xc3\xf6|\xb1\x81ɼ\xf2\x90sh\xce!?\v[\xa7wGf\x9e\x06\x98O\x16sim\xaaM\x86\x19\xe0\x13*Њ\xb3\xb9\xc47\x06h\xb7-\x04\xb8\xcf\x00f\x1bF`vU\xe6Zd\xd1\xc3\x06\xd4\xe2e\r\x0f\xedd\xc2\x14D\xca,\x90[\x1a#\xbf/\xa2\x836\x85p;\xa0\xbb\x026#\x00W\xc8iD\uf24b!\xc4X\x94Oh\x17\x17u\xad\xae\x01\xb9\xaeID\x83\x18\xca\xe7\xfb`\x05\xe4ǨtB\x84\xab\x1aHg\x8f\xbf\xc8r\x13A\x0f\xb5ql\xa7dÝ\x06/\x7f\xb1\xae\x9fD\xddТb5o\x82IH\xad~\xc4\xf3\xed\x87Y\xfe|춍<\xba\xfd\x10y\xd2Y\xfcF\x9fу\xe8\xb7\x15)ֺ\x86\\\x1f\xd9Kī\x17\x82Y\xcbx\x1dH\f\x14\xba\x86Ή\xa0!\xc3\x1f\x02\x02\xac\xc4\"kn\xae\b\x92j(%\xefCW\x18\xa4\x06]\x8co\xbc\x93\x1e\x00\xad\xb5\xbbN\xbfng%K\xc5Z5\xa2\xdb\xd5B\xa0\xbd7;\xcf{nB,\x17\xc0\xd5M\xf1\x9e\x02_\xdeT\xa0\xb5\xe2\xc8I\x10\xe1\xe0\x99\xf6L\x8f\xa8(Z\x1e9\x01\x1e\xd6t;OG\xa5\xb7\x9e\x95\"u\x94Hc\xf01\x176/W\x92&\tp(\xbf\xed\xea\xfc!~)\xa5Y\x0e\n?\xd6͈#\x9c\x03\xe4P\xa1\xb9o\asy\x94\x14Y\x91\xe79\x92\x91\x1eq\x93\xd2mF\\ߺ\xfdU\x1cO\xd8M\xfb\x19\x85] \xe8\x87vː\x86`և,\x99`/J\xec\xa7\xdbMB\xa6\xb7\x1a\x9eܡl\xa9\x90\xf9v-\x86|\xfe\x7f\x16\xb3;j\x01r\x18ބB멥\xc1\xb8\x13\xfb\x84\xfd\xa8\xd6\x17\xbaa\xf6\xb9\xbe^iР\xb9\x81d\xf0)\xcc)\x03%\xdf\xc0\x9d0N\x8a<?{\xf0\x83\xef\x13\xaf\xe3E&\x83\x0f\x1f\x90\xa6zu\\\xcdـ\xf2<sC\xa3fyJW\x10\x91*\x92j\x8b=M\x1cm\x9bk\xf6j{P\x9b\xf1\xb6t\xa2\b\xa3\x1b\x96]\x88\xd2\xc2\x1e\xad\xdb\xe0᠍\xf3k\xe1͆\x02\xa7\t\xffG\x1a\xccYt\x7f\x7f\x0f\x9d\xa5\xad3B\xc1\xaf\x92\x81Q\xb9\x94a\xdd\xe5Cυ8\xd3\"J*\x91\xa6\xb4\xd6\xc1\xb7։\x1c\xb7\x97\x18\xd5\\\x96\x96SH\xa4v\x98\xfdy\x10r\r\x98|\xdbn=\xb5\xc7\xc2\xfc\xe2\xe2\b\xef\xf8\xf2s2\x80\xca\xf92T\xf0l\xa4s\xa8\xba\x9b\v1\x12\x00K&8X\x84ͻ=z\x9cv\"\xbf\x9dJ\x8eu(z\xa8\x9bFr\xb8\xf3\x90(Mb\xd83\xa3F`\xd2=\x1a\x14\xc4I\x1b{\x92\xe0ғPGR \xa3\xab\xe3)j\xe0\xc4d1\n5\xab\b!(\xf3\xeaH*\x1d\x92\xf4\xae2\xaa\xb5\x82\ti\xfb\xac\x85\xaaH\x1f\xa1*\xc7kw(L\xd8#\xfb\xc1Ѓ\xa6$V`\x9e\x0e\xf9\x02\x8d\x94\xd0Fi\x9a\x9d2iyQ\x8a٥\xf2\x98\f\xfa\xad\x13\xc6\xd5\x01\xec.\x99\x11\xd3}\xa7\xe9B\xa8\xcfpi\xa3\xe9\x1eKA6Ӄ\f>\xa0\xb9\xe9߰wMi\xbbx\x9d\x9c\x0f1\xbd\x04-\xad\x00\xe8\x0e$m(7\xffp\xc2\xe9\xe8&\xae \xeaX\xbd\x8b\xba\xfdUf\xcb悽\x8f\xcb\xf1P3]\xb4#\xa3zG\x99\"\xa3\x06^\x8cb\xbe\x93\x87d\xf4\xe0nJ\xd8֗\xe2\xbd<Ű\x82\xf0ar\xf8\tM}5\xd8<ͭ\x86\xd1\xf0\rZ\xda\xc1\rFYh\xcbw\xe5\xd1\x1c\xdd\x06ۃ\n]#\xa6\x9d`\xe1\xc4r\xdc\xf6b\x87\xbdb\xa5<\xa0vd\xb984\xa1p\xe9\xe04\xa1\xf4,\xac\xa4\x97\xf5y\x91\xf6\x05\xb5\x98\x8a\xf0\a4\xb7u9n\xec\x12\x89\xa5\xd1\xfb\x9c\xdc\xfdAW\xaa\u038d5\xf2\x1b\x81\v,\xd31j'\xd4z\x91\x86\xd5<\x18\xaa\xf8d\xa8\xf9\x82p\xb3-\xee1\xf2\xc6\x02υ82F\x8c\xb4\x18\x1f\xfd4\x1a,.2l~\xaa\xb8x\xba\xe8\x13O\xd3\xc6\b\xcc0\xeeo\xa3\xe9\x93\xd3f\xb8\x19t\x97\xcc0 \xde(*m;\xae\ni\x97\x00`\x9b\xac\x9dû\xd9m\xfb\xde9*\xfd\xc0l\x1e\x85\x89NSq\x96\x88\rz@\xe3\xf0\xcdvL(ݜ\xccg\xaf&\xa4^\xf2\\BH\xddi\x8a\x10[\xa5t\xa0\xf3P\x8dE\xbe\xb5\xf3|E\xaa\x9e\x85\xa1=\x02;K\xc5_B\xa3\x91\xbcG\xe8\xff\xba\x99\x8fV\xe2#\xe2\xf7+\xa5>F\f\xa7\xf7*Z\x10<\xbdk~1\xfb6\xe1ve\xfe\x10\xa2\xba\xace\xbd\x01\x95\xf0\xa6\xd9\xdb\x10i\x8a\xa4\xbb\x9f\xfa\x17-_]u\xeeR柩V>t\xb7;\xf8\xeb\xdf\xe8\x8ed\xde\"\v6kw\xf0\u05ff%\xff;\x00\xac\xd9\"ƜZ\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcXݏ۸\x11\x7f\xd7_1\xc8=l\v\xc4\xf2\xa5})\xf4Rl6-\x104\xb9]Ĺ\xf4\xe1z\xc0\xd1\xe4\xc8bM\x91*\x87\xb4\xe3\xfe\xf5\xc5P\x94?d\xf9#E\x9b\x95\x81\x85\xa8\xe1\xe873\xbf\xf9\xa0\x8a\xd9lV\x88N\x7fAO\xda\xd9\nD\xa7\xf1k@\xcbwT\xae\xffD\xa5v\xf3͛%\x06\xf1\xa6Xk\xab*x\x8a\x14\\\xfb\t\xc9E/\xf1\x1d\xd6\xdaꠝ-Z\fB\x89 \xaa\x02@X\xeb\x82\xe0e\xe2[\x00\xe9l\xf0\xce\x18\xf4\xb3\x15\xdar\x1d\x97\xb8\x8c\xda(\xf4\xe9\r\xc3\xfb\x7f\x17\xedں\xad\xfd}\x01 =&\r\x9fu\x8b\x14D\xdbU`\xa31\x05\x80\x15-V\xb0\x14r\x1d;\n\u038b\x15\x1a'\x930\x95\x1b4\xe8]\xa9]A\x1dJ~\xfbʻ\xd8Upx\xd0k\xc8\xc8z\xab\xde&e\x8b^ه\xac,=7\x9a\xc2\xdf.\xcb|\xd0\x14\x92\\g\xa2\x17\xe6\x12\xac$Bڮ\xa2\x11\xfe\x82P\x01\xd0y$\xf4\x1b\xfc\xb9w\xc3_5\x1aE\x15\xd4\xc2\x10\x16\x00$]\x87\x15\xfc$Z\xa4NHT\x05\xc0F\x18\xad\xd2\xfe\xde\x1eס}|y\xff\xe5\x8f\v\xd9`\x9b\xa2\xc1\xcb\nIz\xdd%\xb9iK@\x13\b\x18\xc0\xc0\xb6A\x8f\xf0%9\r\x18)R\x86\x9d5\x02\xb8\xe5?Q\x06*\xf3B\xe7]\x87>\xe8\xc1\xb3|\x1d\x91k\xbf6\x02\xf3\xc0h{\x19PL'$\b\r¦_C\x05\x94,\x01WCh4\x81\xc7\xe4&\x1b\x0eA\x1a.W\x83\xb0\x19W\t\vv\xa5'\xa0\xc6E\xa3\x98\x83\x1b\xf4\x01<J\xb7\xb2\xfa\xdf{\xcd\x04\xc1\xa5W\x1a\x11\x90\u0089Fm\x03z+\f\xfb9\xe2k\x10VA+v\xe0\x91m\x87h\x8f\xb4%\x11*\xe1\xa3\xf3\b\xda֮\x82&\x84\x8e\xaa\xf9|\xa5ÐNҵm\xb4:\xec\xe6))\xf42\x06\xe7i\xaep\x83fNz5\x13^6:\xa0\f\xd1\xe3\\tz\x96\x80[6\x96\xcaV\xfd\xe0s\xee\xd1\xc3\x11ҰcfP\xf0ڮ\xf6ˉ\xdb\x17\xfdά\xee\x83\xdeo\xebM<\xb8W\xdbU\xf2ʧ\xbf,>\xc3\xf0\xd2\x14\x82#\x95\x03\v\x0e\xdb\xe8\xe0xv\x94\xb65\xfa\xb4\vj\xefڤ\x11\xadꜶ!\xddH\xa3ў:\x9d\xe2\xb2Ձ#\xfd\xaf\x88\x148>%<\xa5\xa2\x02K\x84\xd8)\x11P\x95\xf0\xde\u0093h\xd1<\t\xc2\xff\xbb\xdb\xd9\xc34c\x97\xdev\xfcq-\x1c\xfex\x7f\x95\xbd\xb5_\x1ej\xd4d\x84&\xd3tѡ<\xc9\x13V\xa1k\x9dӶv\x1eDN\xdb#\xbd0\x9d\xf3C\xea^J_\xbe\x84\x94H\xf4\xd1)<]\x1f\x81}܋\x9d\xa0\xebз\x9a8\x91)a\xe3\x88\xf7e\x04r\xf9\x1b)\x050\x13\xe0\xf8\x876\xb6c\b3\xf8\x84B=[\xb3\x9b|\xf0w\xaf\xc3\xf8\x05\x93\x01\xe3_\x0fk\xb1\xb3\xf2\x05\xbdvꪹoG\xc2{\xa3\x1b\xb7\x85:\x11\xd7\x06\xb3\x83\xe0\x80vVf\xe5#\x8d\x00\x8f/\xef3%rz\xe4lʾ)\xe11g\xa5\xab\xe1GP\x9a\xc4\xd2 %\x95c\xf7ps\xe4\xa7\x15\x04\x1f\xef6Z:[\xeb\xd5\xd8T\xa1Tj\xea¼\\`\xc5U\xa5#_=\xa5wp\xa9a\x06t\xdem\xb4B?\x1b\x88˅\xb9֫\xe83\x83S\xd3\x1b[7\x99=\xfc\x93\x1e\x15g\xa90\xd5U\f{1~]\x10\xda\xf6]\xe6\xb0=\x95\x0e\xdf\xe6^h\x03Z\x95\x1a\xec\xe9\x15\\\xaa@\x84\n\xb6:4}a\x1b\x18;\x92\xbe\x94Q|\xadqw\xbe8\xc2\xfc\xb9AX\xe3\xaeo|\b\x84\xd2cH\x8cB\xc3͇\tS\x02|\x8c\x14\x18\x94`\xaa\xe8s\xc8|\xe5\xbdk܍\x1d{#\x90yں\x05\xf5\x81g\x92\x01\xa8\xc7\x1a=\xda0Y\x92y\xf4\xf3\x16\x03\xa6\xd9R9I\xdc\a%v\x81\xe6n\x83~\xa3q;\xdf:\xbf\xd6v5c\x17\xcfr~\xcc\x19\b\xcd\x7fH\xff&\xf0\x00|~~\xf7\\\xc1\xa3R\xe0B\x83\x1e\"a\x1d\xcd@\xa8\xa3Y\xe45p\x19\x7f\rQ\xab??\x14gz\xae\xfbå\xe8\bs\xd3'\\\xa9u\xbd\xe3I*\xc1a\xd7,\xfa88\x0f\xdc\xdf8\xb8m\x8e^_?\xa6\xa2ףY:gP\x8c)ƅF{<\xabU3&ν)\xa4\xb0\x16ф\xaa\xb8b̻^\x06\xb4U\xdcj\x90N\x99\xcf\xc9\xcd\xf6eU\xffm\x89\xbfljO\x82ܾ\xae\"}>\x96\x1c\x1a\x1d\xe4b3\xf4L\fA\xdb\x15\x81E\xeeZ\u008f}\x95\x12]:k9ς\x03\xb1/[\x0f\x94\xb1\fƕߐ\xf5\xcb(\xd7x\xe6\xe83\x13\xde&\xb1\xc1\xa7\xfd&\x06\x14\tS\x13\xbd\x0e\xe0&\x83;\x8f\xb5\xfez\x13\xc5K\x12\x1bPt\"4\xa0-iŕ\xe6\x1c\xd3\xc4\xc81\\\x03NxΩ\xf3\x8d\x88/\x93\xbc\x87q/χ\x10V\xc5U\xab{\xa1\xbd\xddy\xd3P\xdcN\x99]\x16wY1e\xc1\f\xdc1SO\x9e\fH\x8b\x1bVQ\x10!\x9e\xf0ljH9M\x85Eړ\x8d^愐\xd1s\xc5\xce\n\xc1\xd5G*a?PN+,\x8b\xdb\xe4\xbfs\x88|u4E\xf2\xc9\xc4B\xb4\xa9צ\x1a^\xc2?,\xbc\xe3s\x06W U1r\xee/4R\t`ݖ7\x1fiK\n\xc0Yޓ*s:ɥ\xee\xdd?\xdajc\xb8\x8bzl\xddf\xa2\x0e\xf3\x90\xe0\xd1\xec@\x10Sa\xf3\x87\xf2\xc7\xf2\xd5w\x9eP\x8d\xa0\xc0#'\xaaO\xb8\xd1\xe3S\xf5\xb97?\x9c\xc9\x0f\xac\xde\x0f\x95|\xf3\xdbp\\\x99\xfb,\xf6\xdbH-@\xad\r\x9fi'R\xe0\xd0\x03\xf8\x19C\x84\xa0[L\x92o\x17\x1f\x1e(\r\\h\xc3y\x98\xb6\xfc\x89\x81gYT\xa0m>\x84K\x13)\xa0\x9f\b\xf6>V\x9a\xc0:0ήNR\xa4\xff\xe5\xd3!\xb84\x00\xa8T0\x15\xf2\xc1\x8e\x0f\xb4\xb2\x11v\x85\x87\x13\x7f\xc6~\x84\x92\x89q\x8e\xf4\x94\x1d\a6h;M\x85;b\xc8\x1f\xb6\xae\xc6\xef\x10>\x16\x1dBw\xea\xe1=\xea\x1c\xcb!\x18\xdf\xe6\xeb\x91t?\aW\xc0\x8e\x9cq0\xff'G\r\x06\xfee\xff\xb5\xea.\xebOŧ=\xb0\xa7\xdfV\x10\xc8\x06\xe5z\"{\x99\x04b#\xb4\x11Kmt\xd8}\x1f\x8b[$\xba5\xae|\xece\xd86\x01Ml\x85\x9dy\x14\x8aOr\x80_;#lr\xd78\xbe\x0f\xe7\xd9\xd45\x82\xf05P\x94\r\x17\xa9m\xb3\x03\x1d\x1e\b\xa2͖\x9b{\xdbU\xd6u\x15\xf7\v\xbf\r\xf4y\xfbؗ\x95\x9b\xcd\xe2r\xc9|\x1c\x00\x9f=\xf9ي\v\xcf.\xd82\xd17GK\xf9Cc\x05\x9b7\x87\xbb\xd4Tg\xf9Ssz\xc0\x87(\xbfAuD\x81\\\x01\xf3ʡ\x19s\xb7\xeb\x02\xaa\x9fƟ\x99_\xbd:\xf9V\x9cn\xa5\xb3\xfda\x9b*\xf8\xe5W\xfe\xca\xcb\x1f[U>\xaaP\x05\xbf\xfcZ\xfcg\x00\xc7|\xd4L\xa9\x17\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VKoܶ\x13\xbf\xebS\f\xf2?\xe4_\xa0\xab\x85\xd1K\xa1[뤀\xd1\xd60\xec4\x97 \a.9+\xb1\xa6\x86\xec\xccp]\xf7\xd3\x17\xa4$\xef#\xbbuz\xa8\xa8\v\x87\xf3\xfc̓lV\xabUc\x92\xff\x88,>R\a&y\xfcS\x91\xcaN\xda\xc7\xef\xa5\xf5q\xbd\xbbڠ\x9a\xab\xe6ѓ\xeb\xe0:\x8b\xc6\xf1\x1e%f\xb6\xf8\x0e\xb7\x9e\xbc\xfaH͈j\x9cQ\xd35\x00\x86(\xaa)d)[\x00\x1bI9\x86\x80\xbc\xea\x91\xdaǼ\xc1M\xf6\xc1!W\v\x8b\xfd\xffgz\xa4\xf8D\xdf4\x00\x96\xb1j\xf8\xe0G\x145c\xea\x80r\b\r\x00\x99\x11;p\x18Pqc\xeccN\x8c\x7fd\x14\x95v\x87\x019\xb6>6\x92\xd0\x16\xdb=ǜ:\xd8\x1fL\xf2\xb3_SL着\x1f\xab\xaa\xfbIU=\r^\xf4\xe7K\x1c\xbf\xf8\x99+\x85\xcc&\x9cw\xa82\x88\xa7>\a\xc3gY\x1a\x80\xc4(\xc8;\xfcm\n\xfe'\x8f\xc1I\a[\x13\x04\x1b\x00\xb11a\a\xb7fDIƢk\x00v&xW\xe1\x99\xe2\x88\t釻\x9b\x8f\xdf=\xd8\x01ǚ\x83Bv(\x96}\xaa|\xe7b\x00/``\xf6\x044\xce\x0eB$\x84\xc80FF\x98\xbc\x95vV\x998&d\xf5\v\x82e\x1d\x94\xd0\v\xed\xc4\xf8\xdb\xe2\xdd\xc4\x03\xae\x14\r\n耰\x9bh\xe8@\xaa\xe7\x10\xb7\xa0\x83\x17`\xac\xb0\xd0TF\aj\xa1\xb0\x18\x82\xb8\xf9\x1d\xad\xb6\xf0P\xa0c\x01\x19b\x0e\xaeT\xda\x0eY\x81\xd1ƞ\xfc_/\x9a\xa5\xc4WL\x06\xa3K\x82\x97ϓ\"\x93\t\x05\u05cc߂!\a\xa3y\x06\xc6b\x032\x1dh\xab,\xd2¯\x05\x1cO\xdb\xd8\xc1\xa0\x9a\xa4[\xaf{\xafK\xd3\xd88\x8e\x99\xbc>\xafk\xe9\xfbM\xd6Ȳv\xb8ð\x16߯\f\xdb\xc1+Z͌k\x93\xfc\xaa:N%XiG\xf7?\x9e;L\xde\x1ex\xaaϥ\x12D\xd9S\xffB\xae5|\x11\xf7R\xbfS\x9a'\xb1)\xc4=\xbc\x9e\xfa\x9a\x88\xfb\xf7\x0f\x1f`1ZSp\xa0\x12f\xb4\xf7b\xb2\a\xbe\x00\xe5i\x8b\\\xa5`\xcbq\xac\x1a\x91\\\x8a\x9e\xb4nl\xf0HǠKތ^e)\xbf\x92\x9f\x16\xae\xeb\xe8\x80\rBN\xce(\xba\x16n\b\xae͈\xe1\xda\b\xfe\xe7\xb0\x17\x84eU }\x1d\xf8É\xb7|E\xbe\x9b\xd1z!/\xb3\xe8l\x86δ\xe5CB[rV\x80+\xb2~\xebmm\x03\xd8F\x86\xa7\xc1\xdbai\xcb\x03\xad\xb0o\xe0\xa5Y/5lY\x93\x822U\x8e\xe9\x17\x82\x85\x9a'\xcfxTk\xab\x035\xaf\xa2\xa0F\xb3\xfc+\x1c\xaaĂ\x84\xcd\xccH:\xeb\xa9S\xe0\x9c\xd0\xd7Ď̑Oh'\uef2f,e\x9c\xa8\xf1$`\xe8y\x16\x03\x1d\x8c\xc2\x132\x02\x92\x8d\xb9\xcc\x0et\xe0\xf2\t^3\x14\x03NS\xb5\xa4/q\xb4(/\xb3tY^q\xfc\u009b\x8by(\x7f\xb9\t\xcd&`\a\xca\x19O\x0e'9\xc3l\x9e\x8fN\xd2`\x04\xff1\xe8\xbb\xc2q\x0eo,p\x17\xe2+\x80\x97\x1f)\x8f\xa7VVp\x8bO_\xd0n\xe8\x8ec\xcf(\xc7e\\\xd8\xef&\xa4\xeae\xf7\x15\x98\x9c)\xb8\x13\xd2|\xd1t\xb0\xbb\xda\xef*\xe8\xab\xf9AQ\x0f\x00\xeaU\xec\x0e\x80\x15\x8dl\xfa\x05\xea}\x15\x1bk1)\xba\xdb\xd3\xe7ě7G\uf0ba\xb5\x91\\}'I\a\x9f>\x97[]#\xa3\x9b\xafD\xe9\xe0\xd3\xe7\xe6\xef\x01\x00\x969\x95'\x8f\t\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W͎\xdb6\x10\xbe\xeb)\x06\xe9!\t\x10\xc9\bz)tk7)\x10t\x1b,\xbcI.A\x0e49\x96إH\x953\xb4\xb3}\xfab(\xc9\xf6\xcaZ;=\xd4\xda\xc3jf8\xfc\xe6\x9b\x1fREY\x96\x85\xea\xed\x17\x8cd\x83\xafA\xf5\x16\xbf3zy\xa3\xea\xe1\x17\xaalX\xed\xden\x90\xd5\xdb\xe2\xc1zS\xc3M\"\x0e\xdd\x1a)\xa4\xa8\xf1\x1dn\xad\xb7l\x83/:de\x14\xab\xba\x00P\xde\aV\"&y\x05\xd0\xc1s\f\xcea,\x1b\xf4\xd5C\xda\xe0&Yg0\xe6\x1d\xa6\xfd_%\xff\xe0\xc3\u07bf.\x00t\xc4\xec\xe1\x93\xed\x90Xu}\r>9W\x00x\xd5a\r&\xec\xbd\v\xcaD\xfc;!1U;t\x18CeCA=jٷ\x89!\xf55\x1c\x15\xc3\xda\x11\xd3\x10ϻ\xd1\xcdzp\x935\xce\x12\xff\xb1\xa4\xbd\xb5\xa3E\xefRT\xee\x1cDV\x92\xf5Mr*\x9e\xa9\v\x80>\"a\xdc\xe1\xe7!\xd0\xdf-:C5l\x95#,\x00H\x87\x1ek\xf8\xa8:\xa4^i4\x05\xc0N9k2\x15\x03\xeeУ\xff\xf5\xee×\x9f\xefu\x8b]\xe6[\xc4\x06IG\xdbg\xbb9n\xb0\x04\nF\x14\xc0\xe1\x00\f\x94\a\x15\xd9n\x95f\xd8\xc6\xd0\xc1F\xe9\x87ԏ>\x01\xc2\xe6/\xd4\f\xc4!\xaa\x06\xdf\x00%݂\x12o\x83!\xb8\xd0\xc0\xd6:\xac\xc6%}\f=F\xb6\x13\xcb\xf2\x9c\x94\xd8A6\x03\xfcR\"\x1al\xc0HQ!\x01\xb7\b\xbbA\x86\x06(G\va\v\xdcZ\x82\x88\x99J?\x94ى[\x10\x13\xe5G\xe4\x15\xdc\vݑ\x80ڐ\x9c\x91J\xdcad\x88\xa8C\xe3\xed?\a\xcf$\xbcȖN\xf1T\b\xd3\xcfz\xc6蕓\\$|\x03\xca\x1b\xe8\xd4#D\xcc\xec$\x7f\xe2-\x9bP\x05\x7f\x86\x88`\xfd6\xd4\xd02\xf7T\xafV\x8d婩t\xe8\xba\xe4-?\xaerk\xd8M\xe2\x10iep\x87nE\xb6)UԭeԜ\"\xaeTo\xcb\f\xdcK\xb0Tu\xe6\xa78v \xbd<AʏR=\xc4\xd1\xfa\xe6 \xceu\xfe,\xefR\xe7Cy\fˆ\x10\x8f\xf4Z\xdf\xe4D\xac\xdf\xdf\x7f\x82iӜ\x82\x13\x97\x87:9,\xa3#\xf1B\x94\xf5[\x8cy\xd5Pe\xe2\x11\xbd\xe9\x83\xf5\x9c\xddkg\xd1?%\x9dҦ\xb3LS\xd9J~*\xb8ɣ\x056\b\xa97\x8a\xd1T\xf0\xc1Í\xea\xd0\xdd(\xc2\xff\x9dva\x98J\xa1\xf4:\xf1\xa7\x13q\xfa\xc9\xfazd\xeb \x9e\xe6\xd5b\x86f\xad|ߣ\x96|\ti\xb2\xcen\xad\xce-\x00\xdb\x10A\x1d;{\xa4m\xea\xcb\xe7zS\x1eV\xb1A~*\x9b\xa1\xf8\x94Md\xe3}\xab\x9e\x8e\x90WX5\x95\xcc\x01\x1a!\f\x93\xe1\xf5\xe9Ηv_\xaa\xd1E\fS\xa9J\xe8£4\xba\x8c\x9eS4\xf3M\xe5A\x9f\xba%\xe7%\xfc\x96\x91ކ\xa6\x98\xa9N\xb47\xc1\xb3\x14\xf4\x05\x93/\xc1\xa5\x0e\xef\xbd\xea\xa9\r\x17-\xa7s\xf3p\x90<}JX\xa3\x8cZ|\x0eҨ^#%\xc7t\xc9\xe4\xce)\xbf\xa8\xbf\xb9\xff\xf0㨟1\xbe\xc0\xc9b'L\x8f\x9c\xbeW\xd3,\x87ߔfY i\x96\xff\xe5\xd2\x10=2\xd2q\x0e\xed-\xb7\xb0o\xadn\x17\xbcB\x9e,\xb9Bd\xc0\x11\x05m\xf3\xc8\xf8o\xb0\xa5\x91lĳ\xfa,s՞\t\x05\xf2L\xb8\xd8\xf4ˎ˱\x19\x8b+\xab\x89\x15\xa7'\x8dtqhd\xeb\x89T\x9dbDϣ\x0f\xa1W\xcd\x17T\xc5\xf5\xbe\x9dZ\xee\xf3\xfa\xb6..\xe4sr\xfdy}+\xa7/+\xeb\a\x1c}Ēl\xe3р\xe8dx\x88\xf8\x8c\x80\xe1\xef\xf4\x92q5k\xf8\xbd\xb7\xf1\xe4\xce\xf4\f\xb4\xf7\a3\xe1fߢ\x1fΨ\x19\x1b\x83;\xa4|\xee녾\xda \x18t\xc8h`\xf3\x98c\xa3Gb\xec\xe6x\xb7!v\x8ak\x90\x93\xabd{V(r\xc1U\x1b\x875pL\xf8\xa3\xc1\xf6\xad\"\xbc\x18\xe7\x9dX,\xa5\xff\xd0\\\xb3\x88\xab\xe2\xfa\b-\xe1#\xee\xcfdw1h$B\xf3c\xe8\x17\x8a{&\x1ao\x805\xec\xde\x1e\xdfr\xe5\x97\xe3\x97@V\x00\xe4{\xb59\xa1n\xbc\xb4\x8e\x92c\xc7(\xad\xb1g4\x1f\xe7\xdf\x02/^<\xb9\xdc\xe7W\x1d\xbc\xc9\x1f8T\xc3\xd7orE\x97\xe9jƻ*\xd5\xf0\xf5[\xf1\xef\x00\x96\xeaؼH\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\xf1\x7f\xf7_1\xd8{\xd8\v\x10\xc9H\xbe_\x14\x85\xde.\xbbM\xb1\xed\xddf\x11\xef\xe5%\xc8\xc3X\x1c[\xecJ$ˡ\xec\xb8E\xff\xf7bHɖl\xad\u05f9åY\x01\xb1\xf8\xe3Ù\x0fg\x863\xd4,˲\x19:\xfd\x89<kk\n@\xa7\xe9k #o\x9c?\xfd\x99sm\xe7\x9b7K\n\xf8f\xf6\xa4\x8d*\xe0\xa6\xe5`\x9b\x8fĶ\xf5%\xdd\xd2J\x1b\x1d\xb45\xb3\x86\x02*\fX\xcc\x00\xd0\x18\x1bP\x9aY^\x01Jk\x82\xb7uM>[\x93ɟ\xda%-[]+\xf2q\x85~\xfd\x1f[\xf3d\xecּ\x9a\x01\x94\x9e\"£n\x88\x036\xae\x00\xd3\xd6\xf5\f\xc0`C\x058\xab6\xb6n\x1bZb\xf9\xd4:\xce7T\x93\xb7\xb9\xb63vTʺko[W\xc0\xa1#\xcd\xeddJ\xfa<X\xf5)¼\x8b0\xb1\xa7\xd6\x1c\xfe>\xd5\xfb\xb3\xe6\x10G\xb8\xba\xf5X\x9f\n\x11;Y\x9bu[\xa3?\xe9\x9e\x018OL~C\xbf&E\xdfk\xaa\x15\x17\xb0\u009ai\x06\xc0\xa5uT\xc0=6\xc4\x0eKR3\x80\r\xd6ZE*\x92\xdc֑\xf9\xe9\xe1\xee\xd3\xff-ʊ\x9aȷ4;o\x1d\xf9\xa0{\xf5\xe4o\xb0\xb7\xfb6\x00E\\z\xed\"\"\\\vT\x1a\x03Jv\x93\x18BE\xb0Im\xa4\x80\xe32`W\x10*\xcd\xe0)\xea`\xd2\xfe\x0e`A\x86\xa0\x01\xbb\xfc\a\x95!\x87\x85\xe8\xe9\x19\xb8\xb2m\xad\xc4\x046\xe4\x03x*\xed\xda\xe8\x7f\xed\x91\x19\x82\x8dK\xd6\x18\x88\xc3\bQ\x9b@\xde`-$\xb4\xf4\x1a\xd0(hp\a\x9ed\rh\xcd\x00-\x0e\xe1\x1c~\xb1\x9e@\x9b\x95-\xa0\n\xc1q1\x9f\xafu譹\xb4M\xd3\x1a\x1dv\xf3h\x93z\xd9\x06\xeby\xaehC\xf5\x9c\xf5:C_V:P\x19ZOst:\x8b\x82\x1bQ\x96\xf3F\xfd\xe0;\xd3\xe7끤a'\xdb\xc6\xc1k\xb3\xde7G\x03{\x96w10\xd0\f\xd8MK*\x1e\xe8\x95&a\xe5\xe3_\x16\x8f\xd0/\x1a\xb7`\x00\t\x1dۇi| ^\x88\xd2fE>\u0382\x95\xb7M䙌rV\x9b\x10_\xcaZ\x93\x19\x93\xce\xed\xb2\xd1Av\xfa\x9f-q\x90\xfd\xc9\xe1&\xfa4,\tZ\xa70\x90\xca\xe1\xce\xc0\r6T\xdf \xd3\x1fN\xbb0̙P\xfa2\xf1\xc3P\xd4\xff\x93\xf9E\xc7־\xb9\x0f\x14\x93;t\xe4\xfb\vG\xa5에&\xf3\xf4J\x97\xd1\x05`e=\xe0q\xa8\xc8\a\xb0S\xae)\x7f)r-\x82\xf5\xb8\xa6\x9fm9p\xf2gdz75\xa3\x97Jb\x9b\xf8\xa0\xfcN\xd0\xc0\t\xfb\b\x12\xa0\xee\xa7n+\xf2\x14\r\xc1\x13\a]\x8a!Y\xd6\xc1\xfa\x9d\xc0\xca|RC]\x9e%]\x1ec\x15\x9d\x95\xff\xde*\x9a\x12W&B\xa80\xd9\xe4\x83U2ȷƈ\x17Xs\xb1\x00Ϊ\xb3\xebw\xc8\b\x9eV\xe4ɈG\xa5\xe0\xe3l\fQ\x01\xb5\xe9=/\x1d/\x10\xec\x11\"\x88\x17\b\xc1\xa4`\xbc\xd1\xe76\xfb\xf9x<)\xe9O\x0fw}\f\xeeI\xead\x0e\xc7+\x9eeD\x9e\x95\x9c2\x0f\x18\xaa\x17W\xbd\xbe[%j\x04G\xa8Ap\x9aJ\x1a\x85vІ\x03\xa1J\x8d\x13\x90\x00⸞\xba\xf1\xafS\xfc\xe9\xc2\xdc\xe18\x10\xae\x01%\xeei\x05\x7f[|\xb8\x9f\xff\xd5&Y'1\xb1,\x89\x05\x06\x035d\xc2kඬ\x00Y\xb6X{R\x8b\x80\x81\xf2\x06\x8d^\x11\x87\xbc[\x81<\x7f~\xfbe\x8a3\x80\xf7\xd6\x03}\xc5\xc6\xd5\xf4\x1atby\x1fP{\x03\x11s\x15\"\xf6x\xb0ա\xd2ӊ\xa3\x9c\xf9\x9d\xc2ۨh\xc0'\x02\xdb)\xda\x12\xd4\xfa\x89\n\xb8\x92\x102\x10\xf1\xdf\xe2\r\xff\xb9\x9a\xc4\xfc19\xe9\x95\f\xb9J\x82\xed\xcf̡\x13\x1d\x04L\x9e\xe4\xf5zM>\xe6\x10\xa7\x7f2\x816d\xc2+\xb0^t7v\x00\x10a\xc5\xffS\xa0#u\"\xf0\xe7\xb7_\x9e\x91\xf6\x80\"<\x816\x8a\xbe\xc2[\xd0&\xb1\xe2\xacz\x95ã\xfc\xe4\x9d\t\xf8U\\\xbd\xac,\x93\x01k\xeaݴ\xb4\x16*\xdc\x10\xb0m\b\xb6T\xd7Y\xcaU\x14lq'\xfa\xf7\xdb%f\x8b\xe0Їq62\x89\xfa\xf8\xe1\xf6C\x91\xa4\x12\x13Z\x1b\x11EN\xb9\x95\x96\x9cC\x92\x8d\xd8\x19mR\xfa\xb8\x8dh\"NY\xa1\x99\b\xac\xf2DM\tV\xad\xa4\x10\xf9\xf5\xecd\xc0yo=N\x1b\xa6\x1d5\xa6\x0fǁ\xe1\x7ft\b_\xa4\x96\x98\xd4\xcbj\xdd\x0f\xec\xf9\xacZRBxC\x81\xa2fʖ,J\x95\xe4\x02\xcf\xed\x86\xfcF\xd3v\xbe\xb5\xfeI\x9bu&\x86\x98%\xc7\xe6\xb9\b\xc2\xf3\x1f\xe2\x7f\xbfI\x8b\x98\x99_\xa6J\x1c\xfa=\xf4\x91ux\xfe\xcd\xea\xf4y奧\xd2\xf5\xa2\xcb|\x8eg\x8aKl+]V}\x91p\x88\x9e\x13\x98\x00\r\xaa\x14r\xd1\xec\xfep\xb3\x15\"[/\xf2첮\x12\xcd\xd0(\xf9͚\x83\xb4\x7f3s\xad\xbe\xc0I\x7f\xbd\xbb\xfd>\xc6\xdc\xeao\xf6\xc8ɄX\x1e\xc9\x00\xef\x94з\xd2\xe4\x8b\xd9\x19\x05?\x8e\x86\xf6\x89\xddD&\xb9\x1f\x93\xcf.\x140\xe0\xfa$\x81B\xa5\xe2]\x03\xd6\x0fg\x92\xac3:\x8f\x84\x7f\xc45\x03z\x02\x84\x06\x9d\xec\xd3\x13\xed\xb2tH;\xd4^\x94\xc1З\xafK\x02t\xae\xd6\x13\xc7i\xb0\xc3t\xb1˼\x91\xa3\n\xf9\xa5\xac\xa7d\xb38'p*/\xa6\xd2\xe7ni\xb1\x8c\xee\xf0\x91D7\xd8C\xa2z\x84\v\x13\x89\xeb3\xbcI\x15(\xd9\xd5P\xb4\f\x96S\x85\xc8h\x84\xa4\xf4\xa3\x06g\x87RdGv6\xeaJ\xfa\xcc^\xa0M2\xc1vd\x00g\xeb\xb78\xbag/Ń\xd0a\b\x8f\xbf\xa9\x82+\xad\xe4\x8e\xe3k\xaas[xs:>^\x88x\x95\xc4\n\xba\x11{\xeclh\x8bܯpZ\x84\xc1\x00,͓\x92)b\x91\x8a\xa9\x9dd\x9d+\xd45\xa9\x0e\x90\xf3\xe39'\x98C\x8c%\xad$\x9dh]mQ\xf5EQ'Z\x7f\xc9\xf3(\xd5p\xbco\xb8\xe6g\x11[&\x15\xab\xe4\t\xf5\x8f\x8f\x87\x95\xf5\r\x86\x02\xe4\x8e!\x9b\x00\x94;@\\\xd6T@\xf0-]f\xc2r#\xc0\x8c\xeb\xf3\xee\xf5K\x1a#\x16\x82\xfd\x04\xc0\xa5mþ@\x1c\xb9\xf85w֓_*\x85\x9b(\xc1F\"H\x8d\xd6[読\xeb8\xa3+7\xf6)~\xbaG\x95:\x03\x96$\xdb\xf2{=\x1c\xc0U\xc8\xe7\xc9y\x90\x11Sγ\x8fAg\xbcG\x1e2ms\xbcB\x06\xf7\xb4=i\xbb3\x0fޮ=\xf1\xb1id\xbd\xf5\x9e(\x9b\xc1\xfbh\xe7\x17\xeb\xdb-p^\xe5n\x10T\xb6\xee\xdd\xd3\x06\xac\xc1\xb4͒\xbc\xe8\xbd\xdc\x05\xe2q\x10>B\x84\xae\x8a8\x906\x98\xdd_!$\x9c\xae(*\xd1H؎>\x13,(ͮ\xc6Ӫ\xc8\xf5\xd2I\xb6/.#.}\xb0\xd6\xdeM\x1d\xf9\xd8\xf5-\xb7\x14Q\x9a[kN,b\xe8\x9fڄ?\xfd\xffD\x7f2~\xb9\xb7]\x8f\x82z\xd7+\x04\xbeۅ\xa9e\x7f\x1f\xf6\xb3\a+\x1bt\\\xd9pw{v\xb7\x17\xfba\xbd\x95\xeb\xfd\xd9$\x82\xc5\xfd\xef\xb1\xfa-\x1f\x1fiÃ<\xbf\xd4\x149\xa0\x0f\xfbhx^\xc4\xd1\xd0\x17\u038d\x88+\xb7\xb4\vr\xe81\x9c\x1af\xbc\x0f\xbe9\xfe\xca\xf2\x1aXK\xde\x1es\x9f\x94\f\xa5R\x97\xe58\x91\xd4\xce\xfad\xab\xa7\x88\xa3\x83`\x14\xf8Ǣ\x7f\x8f\x98?a\x0fGM\xdd\xedZ\x01\x9b7\x87\xb7x\xbeg\xdd'\xa6\xd8ѩ\xa5\x06\x8bw\xb7\xaa]\xcb!\r\x91\x1b*\x17H\xdd\x1f\x7fd\xba\xba\x1a}5\x8a\xaf\xa55)\x9b\xe5\x02>\x7f\x91o?\U0006ed6b\xa7\xb8\x80\xcf_f\xff\x1d\x00\xb4\x9eo5\xa1\x1b\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Yߏ۸\x11~\xf7_1\xd8{\xd8\v\x10\xd9HZ\x14\x85\xde\xee\xb2M\xb1\xed\xddf\x11\xe7\xf2\x12\xe4a,\x8e,v%R\xe5\x8c\xec\xb8E\xff\xf7bHɖm\xadw\x93\xe2\xd2\xd8@,\xfe\xf8\xf8\xcdǙ\xe1\x88;˲l\x86\xad\xfdH\x81\xadw9`k鋐\xd3'\x9e?\xfc\x99\xe7\xd6/6\xafV$\xf8j\xf6`\x9d\xc9\xe1M\xc7\xe2\x9b\xf7ľ\v\x05\xddPi\x9d\x15\xebݬ!A\x83\x82\xf9\f\x00\x9d\xf3\x82\xda\xcc\xfa\bPx'\xc1\xd75\x85lMn\xfeЭh\xd5\xd9\xdaP\x88+\f\xeb\xffع\a\xe7\xb7\xee\xc5\f\xa0\b\x14\x11>؆X\xb0isp]]\xcf\x00\x1c6\x94C\xeb\xcd\xc6\xd7]C\x81X| \x9eo\xa8\xa6\xe0\xe7\xd6ϸ\xa5B\x17^\aߵ9\x1c:\xd2\xe4\x9eT2\xe8ޛ\x8f\x11\xe7}\u0089]\xb5e\xf9\xfbd\xf7/\x96%\x0ei\xeb.`=\xc1#\xf6\xb2u\xeb\xae\xc6p\xde?\x03h\x031\x85\r\xfd\x96\xac}k\xa96\x9cC\x895\xd3\f\x80\v\xdfR\x0ew\xd8\x10\xb7X\x90\x99\x01l\xb0\xb6&ꑸ\xfb\x96\xdcO\xf7\xb7\x1f\xff\xb0,*j\xa2\xe8\xda\xdc\x06\xdfR\x10;\x98\xa8\x9f\xd1\x06\xef\xdb\x00\fq\x11l\x1b\x11\xe1Z\xa1\xd2\x180\xba\xa5\xc4 \x15\xc1&\xb5\x91\x01\x8eˀ/A*\xcb\x10(\xda\xe0\xd2&\x8f`A\x87\xa0\x03\xbf\xfa\a\x152\x87\xa5\xda\x19\x18\xb8\xf2]m\xd4\x0f6\x14\x04\x02\x15~\xed\xec\xbf\xf6\xc8\f\xe2\xe3\x925\n\xb1\x1c!Z'\x14\x1c\xd6*BG/\x01\x9d\x81\x06w\x10H׀\u038d\xd0\xe2\x10\x9eï>\x10XW\xfa\x1c*\x91\x96\xf3\xc5bmep\xe9\xc27M\xe7\xac\xec\x16\xd11\xed\xaa\x13\x1fxahC\xf5\x82\xed:\xc3PTV\xa8\x90.\xd0\x02[\x9bE\xe2N\x8d\xe5yc~\b\xbd\xff\xf3\xf5\x88\xa9\xect\xdbX\x82u\xeb}st\xb2GuW\x1f\x03ˀ\xfd\xb4d\xe2A^mRU\xde\xffe\xf9\x01\x86E\xe3\x16\x8c \xa1W\xfb0\x8d\x0f«P֕\x14\xe2,(\x83o\xa2\xce\xe4L뭓\xf8PԖܱ\xe8ܭ\x1a+\xba\xd3\xff\xec\x88E\xf7g\x0eob`Ê\xa0k\r\n\x999\xdc:x\x83\r\xd5o\x90\xe9w\x97]\x15\xe6L%}Z\xf8q>\x1a\xfe\xe9\xfc\xbcWk\xdf<$\x8b\xc9\x1d:\r\xffeK\x85n\x98\xaa\xa6\x13mi\x8b\x18\x03P\xfa\x00x\x96.\xe6#\xe0\xa9\xe0\xd4\xcf\n\x8b\x87\xae]\x8a\x0f\xb8\xa6_|1\n\xf3GX\xfd<5c\xa0\xa5\x19N\xa3P\x7f'hP*\xb8\xa6\x13H\x80z\x98\xba\xad(Pt\x05ͦ\xb6PW\xf2lŇ\x9d\xc2\xea|2c[\x1e\x95]\xbf\xad7\x17\xe9\xdf\xfb\xde\xe9\x03\x95\x14ȩK\xa7\xe8o}\xcc\x11\x82\xd6\r\xae\x9f\x92<\x88?A\x04u\xc3@\xd3\xd4\x1e\x93\xfa\xf1|8I\xf4\xa7\xfb\xdb!\a\x0e\x8a\xf6\x94\xe5tŋ\x82\xe8\xb7\xd4,\x7f\x8fR=\xb9\xea\xf5m\x99\x96Q\x1cU\x06\xa1\xb5T\xd0Qj\x05\xebX\bMj\x9c\x80\x04\xd0\xc0\tԏ\x7f\x99\xe2\xbfO3\x87t\xacR\x03jޱ\x06\xfe\xb6|w\xb7\xf8\xabO\\'1\xb1(\x88\x15\x06\x85\x1ar\xf2\x12\xb8+*@\xd6\x1d\xb6\x81\xccRPhޠ\xb3%\xb1\xcc\xfb\x15(\xf0\xa7ן\xa74\x03x\xeb\x03\xd0\x17lښ^\x82M*\xef\x13\xda\xe0\x1f\xea\xdb*\xc4\x1e\x0f\xb6V*;m8\xea\xa1\xdb\x1b\xbc\x8d\x86\n>\x10\xf8\xdeЎ\xa0\xb6\x0f\x94ÕF\xf0\x88\xe2\xbf5t\xfes5\x89\xf9c\n\x91+\x1dr\x95\x88\xedϬq\xc4\x1d\bJ\x85\x02\x12\xeczM!\x9e\xe1\xe7\x1f\x9d@\x1br\xf2\x02|P\u06dd\x1f\x01DX\x8d\xbe\x94gȜ\x11\xfe\xf4\xfa\xf3#l\x0f(\xaa\x13Xg\xe8\v\xbc\x06\xeb\x92*\xad7/\xe6\xf0A\x7f\xf2\xce\t~\xd1x,*\xcf\xe4\xc0\xbbz7\xcd\xd6C\x85\x1b\x02\xf6\r\xc1\x96\xea:K\xb5\x82\x81-\xee\xd4\xfea\xbb\xd4m\x11Z\fr\\\rL\xa2~xw\xf3.O\xacԅ\xd6N\xa9\xe8)SZ=\xf3\xf5\xb0\x8f\x9d\xd1'\xb5\x8f\xbb\x88\xa6t\x8a\n\xddDZ\xd3o\xb4\x94\xa0\xec\xf4\b\x9f_\xcf\xce\x06\\\x8e\xd6\xd3c{:P\xe3\xf1}\x9a\x18\xfeO\x87\xe0\xb3\xccR\x97zڬ\xbb\x91?_4K\xeb\xf8\xe0H(Zf|\xc1jTA\xad\xf0\xc2o(l,m\x17[\x1f\x1e\xac[g\xea\x88Y\nl^(\x11^\xfc\x10\xff\xfb&+be\xfc<S\xe2\xd0\xefa\x8f\xaeË\xaf6g\xa8\xeb\x9e{*]/\xfb\xc2\xe3t\xa6\x86Ķ\xb2E5\x14\xe9\x87\xec9\x81\tРI)\x17\xdd\xeeww[\x15\xb2\v\xcag\x97\xf5\xaf\x83\x19:\xa3\xbfٲh\xfbW+\xd7\xd9g\x04\xe9o\xb77\xdfǙ;\xfb\xd5\x119Y\x90\xeaW\xeb\xaf[\xa3\xf2\x95\x96B>\xbb`\xe0\xfb\xa3\xa1C\x158Q\xc7\xed\xc7\xccg\xcf$\xc8\x0e[\xae\xbc\xdc\xde\\d\xb0\xdc\x0f\x1bV?Hޗo\x03\x92\xba腺\xedQ&\t\xe6\"\x8bTwOU\xc1=\aݳ\xfeX\xd0\n\xf4\x9b\x98\xe8됖9c&\xd9t\x05\x7f4\xa2\xf5\xe3\n ;\xd9ߣ\xae\x83\xe8G\xcdɈ\xd9\x13\xbe\xa3\x85YwT\xf4^~\x9d\x89\xc3\a\xcdR|J\x0f\xa2\xea}\xdb\vMᵘ;\xbe\xbc\xb9\xb4so\xce\xc7\xc7\x1b\x82`\x12/\xb1\rŷ\x85\xc8\x19\xb6\xc8\xc3\x12\xe7\xfb\x06#\xb441^W\x14>\x182\xb1\xd8\xd2:\xb0D[\x93\x19\x10YK!\x82x'\x13\xae\xcfs\xe5\x00\xd31\x99\xf8\x9e7A\xf8tV\xe9C\x83\x92\x83\xbe&g\npүwY\xb8\xaa)\a\t\x1d=\xcf\xf9\xf4\xa5\x96\x19ח\xe3\xe0\xd74F\t\xe30\x01p\xe5;ٿb\xf5\x01ћ\x7f\xcd\xfd\x8eϟK\xa3\xad\x90/\x93\xb8\xd7\x11S~\xb5\x0f\xcaK\x8e\xa5\x1fr]s\xbaD\x06w\xb4=k\xbbu\xf7\xc1\xaf\x03\xf1\xe9\x1ed\x83/\x9c\x95\xdf\x19\xbc\x8d\x1e\xf0l\x83\xfb\x05.\xdb\xdc\x0f\x82\xca׃\xe7z\xc1\x1a\\\u05ec(\xa8᫝\x10\x0f\n\f\x81~\x82\t}\xcd{\xd0\xed0\xbf\xdf1\x93\x80\xfa\n\xbe@\xa7\x99,z\xa7x0\x96\xdb\x1a\xcfK\xf8v\xa0\xa7\xa5\xa9:\xa7F\xc8\xc1/zhА\x8e}_\xf3N\x1d\xe9\xdcxw\xe6\x14\xe3P\xb0N\xfe\xf4ǉ\xfe\xe4fz˷>J\x85}\xafJ\xf8\xf3N\xa6\x96\xfd߰\x1f=|Y0\xc8>\xb2/\xee\xf9\xf2h\xe8SY+\x02O\xe5\xacq\xfa9O7ǋ|\x8fL3!\xcdIS\x7f-\x92\xc3\xe6\xd5\xe1)\x1e<Y\x7fA\x1f; eU3Z\xbc\xbf\x8c\xea[\x0e\a\x96^-\xb4B\xe6\xee\xf4\x86\xfe\xea\xea\xe8\xc2=>\x16ޙ\xf8w\a\xce\xe1\xd3g\xbd4\xd7\x1cb\xfaB\x98s\xf8\xf4y\xf6\xdf\x01\x00\xbb\x93\x18C\xdf\x18\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xcdn\x1b7\x10\xbe\xeb)\x06\xe9!-Е\x10\xf4R\xec\xadu\x1b hb\x04r\xeaK\x90\x03\x97\x9c\x95XsI\x963\x94\xab>}1\xdc]i\xb5^+F\x80Z>\x98\xc3\xf9\xf9\xe6\x9b\x1fS\xab\xaa\xaaV*\xda{Ld\x83\xafAE\x8b\xff0z9\xd1\xfa\xe1gZ۰9\xbci\x90՛Ճ\xf5\xa6\x86\x9bL\x1c\xba-R\xc8I\xe3o\xd8Zo\xd9\x06\xbfꐕQ\xac\xea\x15\x80\xf2>\xb0\x121\xc9\x11@\a\xcf)8\x87\xa9ڡ_?\xe4\x06\x9bl\x9d\xc1T\"\x8c\xf1\xbf\xcf\xfe\xc1\x87G\xff\xc3\n@',\x1e>\xd9\x0e\x89U\x17k\xf0ٹ\x15\x80W\x1d\u0590\x90\xd8\xea\x841\x90\xe5\x90,\xd2\xfa\x80\x0eSX۰\xa2\x88Z\"\xefRȱ\x86\xf3Eo=\xa0\xea3\xda\x16G\xdb\xd1ѱ\\9K\xfc\xc7\xe2\xf5{K\\T\xa2\xcbI\xb9% 嚬\xdfe\xa7\xd2\x13\x05\t\x10\x13\x12\xa6\x03\xfe\xd9\xe7\xfb֢3TC\xab\x1c\xe1\n\x80t\x88Xíꐢ\xd2hV\x00\a\xe5\xac)\x8c\xf4\xe0CD\xff\xcb\xc7w\xf7?\xdd\xe9=v\x85v\x11\xc7\x14\"&\xb6c\x8e\xf2\x99\x94\xf8$\x030H:\xd9X<\xc2kq\xd5뀑\xa2\"\x01\xef\x11\x0e\xbd\f\rP\t\x03\xa1\x05\xde[\x82\x84%\aߗy\xe2\x16DEy\b\xcd_\xa8y\rw\x92g\"\xa0}\xc8\xceH'\x1c01$\xd4a\xe7\xed\xbf'\xcf\x04\x1cJH\xa7\x18\x89/<ZϘ\xbcrBB\xc6\x1fAy\x03\x9d:BB\x89\x01\xd9O\xbc\x15\x15ZÇ\x90\x10\xacoC\r{\xe6H\xf5f\xb3\xb3<6\xb5\x0e]\x97\xbd\xe5㦴\xa6m2\x87D\x1b\x83\at\x1b\xb2\xbbJ%\xbd\xb7\x8c\x9as\u008d\x8a\xb6*\xc0\xbd$K\xeb\xce|\x97\x86\t\xa0\xd7\x13\xa4|\x94\xb2\x11'\xebw'q\xe9\xb2gy\x97&\x03K\xa0\x06\xb3>\xc53\xbd\"\x12V\xb6\xbf\xdf}\x821h)\xc1\xc4%\fl\x9f\xcd\xe8L\xbc\x10e}\x8b\xa9XA\x9bBWxFob\xb0\x9e\xcbA;\x8b\xfe\x92t\xcaMgY*\xfdwFb\xa9\xcf\x1an\xcahC\x83\x90\xa3Q\x8cf\r\xef<ܨ\x0eݍ\"\xfc\xdfi\x17\x86\xa9\x12J\xbfN\xfct#\x8d?b_\x0fl\x9d\xc4\xe3\xb6X\xac\xd0|\xfe\xef\"j)\x98\xb0&\x86\xb6\xb5\xba\xcc\x00\xb4!\x81z\xb2/\xd6\x13\xc7K\xc3)\x9fF\xe9\x87\x1c\xef8$\xb5\xc3\xf7AO\xc6\xfc\x19T\xbf.Y\x8c\xb0d\xc5\xc9\x14\xcaߋ\x8a3\xcf\x00\xbcW<\x99PV֟\xc6|!\x8fg)\x97\xdfNɸz\xe55\xbe-\xbd\xe3\xf5\xf1j.\x1f\x16\f$\x95}x\x84\xd02\xfa\xa9\xcb\x11e\x833\x97\x00)\xfb\x17\x83\xecw\xf2;#\xad\xd5ZLW\x01ng\xca#\xcfmvn\xd8\xee\x95\x0e]Tl\x1b\x87C8i\x87\x99S\x00\xdb\a<\xca\xfd\xb7\xf2{\b.wx\xfa\xdfp\x15\xf9\xfd\xa5\xee\xb4A\x8a\xf1\bB\xf2\x9b`\x99\xb9\x84\xb1'\bb0\x03\x80\xa1iI\xf2|!v\xe9\x06\x9b\xf0b\x1bV\xcb\xcd\x7f\xa1\xb1\xd4Q\x17\n\xf3j^\\\xce\xf8\xfa\xea2`\xc5\xf9b>\xaf\xaf\x83\xa2>\x12\xabsJ\xe8yp\"3\xf8m\v\xc1)\xe2\xc9X\xc8\x1b\xe8j\x9d\xdf?\xd5\x1f!\x89+`\xdb\xe1\xc5\x14=*Z\x9a\x976\xa4Nq\r\xb2\xda+1\x9a\xdd\xcb\vL5\x0ek\xe0\x94\xf1eU\x97EL\xa4v\xd73\xf8\xd0\xeb\bj5\x1a\x80jB\xe6g\x88\x15\xe95j\xaf\"\x8a{E\xd7\xf1|\x14\x8d\xa5\xb2\xe2K\x83\xa3\xcf\xdd<D\x05\xb7\xf8\xf8D\xb6Ee\x8eO5\x03/]<\x93\xd3B/\xcfD\xc3S\xae\x86Û\xf3\xa94z5<\xa9\xcb\x05@y\x99\x9aI\x89\xa9\x9f\xcdAr\x1e\x10\xa55FFs;\x7fR\xbfzu\xf1B.G\x1d\xbc)\xdf\x14\xa8\x86\xcf_\xe4\x91\xcb!\xa1\x19\x1e\x9dT\xc3\xe7/\xab\xff\x06\x00\x99vi\x8d\x91\f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}{o#7\x92\xf8\xff\xfa\x14\xc4$\x80f~kə_\xb0\x8b;c\x81\xc0;\xe3l\x8cd<\xc2\xd8;\x8bEv/\xa0\xbaK\x12\xcf-\xb2C\xb2ek/\xf7\xdd\x0f\xc5G?\xf4l\xb2\xe5\xf1\xccn\xab\x8dd\xdcVW\x93\xf5fU\xb18\x18\x8dF\x03\x9a\xb3\x8f \x15\x13\xfc\x82М\xc1\xa3\x06\x8e\xbf\xa9\xf1\xfd\x7f\xa81\x13\xe7\xab\xd7S\xd0\xf4\xf5\xe0\x9e\xf1\xf4\x82\xbc)\x94\x16\xcb\x0f\xa0D!\x13x\v3ƙf\x82\x0f\x96\xa0iJ5\xbd\x18\x10B9\x17\x9a\xe2m\x85\xbf\x12\x92\b\xae\xa5\xc82\x90\xa39\xf0\xf1}1\x85i\xc1\xb2\x14\xa4y\x83\x7f\xff˂\xdfs\xf1\xc0_\r\bI$\x18\bwl\tJ\xd3e~Ax\x91e\x03B8]\xc2\x05\x91\xa0\xb4\x90\xa0\xc6+\xc8@\x8a1\x13\x03\x95C\x82\xef\x9bKQ\xe4\x17\xa4\xfa\x83}ƍ\xc5\xce\xe3\x83}\xdc\xdcɘ\xd2?\xd6\xef\xfeĔ6\x7fɳBҬz\x99\xb9\xa9\x18\x9f\x17\x19\x95\xe5\xed\x01!\xb9\x04\x05r\x05\x7f\xb1\x13\xf8\x9eA\x96\xaa\v2\xa3\x99\x82\x01!*\x119\\\x90\x1b\xba\x04\x95\xd3\x04\xd2\x01!+\x9a\xb1\xd4LюK\xe4\xc0/'\xd7\x1f\xbf\xbdM\x16\xb04x\xc4\xdb)\xa8D\xb2\xdc|Ϗ\x8f0E(\xf9h懃0\xb4 zA5\x91`\x86µ\"z\x01\x84\xe6y\xc6\x12\xf3\x16\"f\x0e$)\x9fQd&Ų\x825\xa5\xc9}\x91\x13-\b%\x9a\xca9h\xf2c1\x05\xc9A\x83\"IV(\rr\xec\xc0\xe4R\xe4 5\xf3\x88ū\xc6M彍9\fq\x92\xf6;$E\xfe\x01;ԕ\xbd\a)Q\x06\x01D̈^0UM\xc9L\xa3\x06\x96\xe0W('b\xfaߐ\xe81\xb9E\nHE\xd4B\x14Y\x8aL\xb7\x02\x89(IĜ\xb3\x7f\x96\x90\x15N\x10_\x99Q\rJ7 2\xaeAr\x9a!y\n8#\x94\xa7dI\xd7D\x02\xbe\x83\x14\xbc\x06\xcd|E\x8d\xc9;C\x12>\x13\x17d\xa1u\xae.\xce\xcf\xe7L{\xf9I\xc4rYp\xa6\xd7\xe7F\nش\xd0B\xaa\xf3\x14V\x90\x9d+6\x1fQ\x99,\x98\x86D\x17\x12\xcei\xceFf\xe0\x1c'\xab\xc6\xcb\xf4\xab\x92X\xc3\xdaH\xf5\x1a\x19Ji\xc9\xf8\xbc\xbcmX{/ޑ\xc5-\xe7\xd8\xc7\xec\x14+\xf42>7\x84\xf8pu{W\xe7*\xa6j \x89\xc3v\xf5\x98\xaa\x10\x8f\x88b|\x06\xd2\x12\xce\xf0\x16B\x04\x9e\xe6\x82qm\xc0'\x19\x03\xdeD\xba*\xa6K\xa6\x91ҿ\x16\xa0\x90uŘ\xbc1Z\x84L\x81\x14yJ5\xa4cr\xcd\xc9\x1b\xba\x84\xec\rU\xf0\xe4hG\f\xab\x11\xa2\xf48\xe2\xeb\xca\xcf\x7f\xec\x17-\xb6\xca\xdb^E\xed\xa4\x90\x93\xee\xdb\x1c\x92\x86d\xe0Cl\xe6\xc5x&dC\xf8Q!x\x91\xdc'\x96xY\xd9F\x15Լ\xbf1\x88?\x95_C^A\x82\x15\x9c\xfdZ\x80Q\xa1(pxkK]T\x9a\xb0\xf9A\x16\xa8\x0fn/\x06\xf1'\xa1<\x81\xec\xe0\xe8ޘ\xaf\xd4\x18\x05u\x1e\x8e\xc8\r\x00\xd9Ei\x91\xe7\xc8.\x97\xfe\xee\x06DbT\xe5P\x91\x1bx B\x92k>\x91b.A)\xf2\xb0\x00N\x98\x1e*\xa2@\x13\xe0\xa9\"\x8c\x1b\"\xd87CJ\xf2\x05r\xdf\x06H;\xa7\xa9\x10\x19P\xde\xf8[*\xd7\x1f\n~pRo\xcdW<\x99\xc1\x8cB/@6&攚\xe0\x19*\xa3\\Ȧ\b\xe1\xf5\x80\xc8`\x9a<\x98o\xa6\xe2\x8c<0\xbd\x10\x85vƔ\xcfq\xb6K\x91\xb2\xd9\x1a\x85\x9d\xf2\xb5^\xe0?\xdc\x147\xf4{u\xdd-\x80\xe4\x19jڙ}\x89}ô\x1c]\x8a\xbcR䙠)\xa4\xa8]\x9d\x92\xc0?\xd2y\x00\xb6\xe01Ɋ\x14\xd2\xd2R\xaa\x83\x98\xbb\xda\xfa:j}M\x19G5\x87v\x1d\x87̫\xbf\"\xe1\t\xdd\xc1\x11\xa8j\x18\xb7\xd0<>\xdc\xe46G\xcf4,\xb7\x86u\x80\xad\x89q\\\xe84\x83\v\xa2e\xb1\xf9n\xfb\x1c\x95\x92\xaew\xa2\xc2\xfbZ\xed0Q~\xdbi\xfa\x8c%\x808(\xf5\xb9AƗ\x85\a\xa64\xe3s?\xb3\x89\xc8X\xb2>\x82\x8c]\x8f\xd4\x04\xac6+2\x85\x05]1!\xc9L\xc8\r\xa0\xde\xd8y\xbe\xc9$\xd0tmG\xb4)4\xe4zF`\x99\xeb\xf5\x19\xeanZdƌ\x91\x17\\px\xb1\x89:\xe0\xc5rs\x06#\x82_ݺi\x8dߠ%\x8e\x17B\xdc\x1ff\x94\x1f\xf0\x1b\x95\xf5&\x89q\xe8K,\xb8\xa9:m3\x05\x02\x8f\x90\x14\xdax\xac\xcd+-\x90¨Q\xe8L\x83<\xc4)\xfbLR\xc3\x15\xdd\xfe\xd3\xc6\xc8=5\x15\n\xb0\x9d\xe9\xbe\xc1\xa2\x06\xe5n4\xdbl\xe8ؗ\xa7l\xc5҂f\x84q\xa5Q\xbd+\x94\x14Z\x0eis\x1a\a\x98~\xd7`\x11\x13~̈\xf5\x86]\x17\x1c\x10uK\xe4\xc0\x1d\xdfU\x83\x1d/ d\xef|\xa7TAJ\x84\x93\xd7\"\x03\xe5ޔ\"[\xd74\xe0\xd9\x1e\xc0%\x19\xac˛\xd1)dDA\x06\x89\x16r\x17\"\x0eS\xb5\xad6߃\xbd\x1dz\xbd)\xbcu\x95.\xf6\xc2D\x93Ȓ\x85\xf5F\x91a\x8c\n \xa9\x00e\x14\x1d\xae\x8eֻ'w\x84\xd6Gİ\xb5\xd2;\xae\xfe\xb6\xb1\xe9\xf9$\x14\x99\xe5sۊ\xd0\xdd\xff\xb7A%\xe3\x9b\xfc\xd5\x12\x97\xd7[\x0f\x9e\x921\x91\x1f\x19\xa8\xba-a\xda\xdfEkBM\x00d\xdfU\xbd\xfb\x8b#D(O_o>wB\x9e\xeeH\x85\xf2\xd5_\f\x11\x8c\xb2\xbfu\xba\xbe%\x01~\xaa?sFج$@zFf,\xd3 7(\xb1\x17.A\xce>H\x89\xae(8n\xa9\xf0ZR\x9d,\xae\x1e1~\xa6\xaa\xd0e+ll>JX}\xfd\xd14\xa6\a\xa1\xa2?\xf4k\xc1$,md\x05W^\xf5;\xc6\xf5\xb9\xbcy\v\xe9~\xeej\xc5a[S\xb8\xdc\x18f\xfd\xb5n-\xd1n\x02\xceI)\xd7a&ʤ\xce\b%\xf7\xb0\xb6\xde\x05\xae$s\x90\x14_\x83_>\nQ\x82\t\xd5\x19Ѿ\x87\xb5\x01\xe2\xa2oG\x9emGz\x17>\x83\xade\xc5Q\xb4\xe1h\\\x9c\xc4\xe2\x0fo\x94Q\x89\x964\xf7\xb1S\xafa\x0e\xd36@E\xf8\xcbc;xz%\x99\xaap\x9f%\xe4\x10\xa3u\x99\x89H\xa9\x05\xcb[\xc05b\x8e\\dd\xc2\xc7N?b\x14\xbc\x1c\x9fu\xed\xaf\xf9\x19\xb9\x11\xfa\x9a\x9f\rZ@\xb5\xab=ex\xe2\xad\x00u#\xb4\xb9sr$\xda!\a\xa3\xd0>fD\x88[5\x8c\xf3\xaf\x87`\x8f2\xb1\xfd\xb9\x9e\x19\x9e*I\xc2\x14\x06D\x85t\xb82\x7ft/;\xa4훟e\xa14\xae$\xb8\xe0#c\xecƻ\xde\xe3Pܒ\x91\xebT\xd8\x1eV\xf9J\xfb\xbaV\x10\xef\xd0O\xb2Oۄ@\x86I\x14\xbf\x045\x01m\xaaa\xce\x12\xb2\x049\x87\xc1\x11p\xe6'G\x9d\xdd\xe6\xf5\xadti\x04?\xb51\xcd\xfe\xe3\x94q#\xba\xbf\xeb\x1a\xa1l\x1e\xfd\x8e'\xed\x91/\xee\x8c`\xc7\xcf\xc3\x18I\xe37\x1c\xc1&MS\x93S\xa4٤\xb5\xf6n\x8d\xf9\x86lֆd\x04\x94,i\x8e\xd2\xf9?h\xaa\x8c,\xfd/\xc9)\x93G%\xf4\xd2d\x053h<\xe9\"D\xf5\x97 |\xa6\bRsE\xb3\xcd<\xc8\xf6\aU&'\x90\x19\xeb\x8f#\xdb\xf44\xce\xc8\xc3B(@\xb2\x93\x19f\x1d\xc9F\xbaf\xfbzq\x0f\xeb\x17g[2\xfe⚿\xb0\xe6yKb\xbd-?\x02\xd8Ħ_\x98'_Ļ.\xad\xb8\xaeŗ\xf8\x8eL\xc7\x1e6\xa8g;\xaa4\x87sEǃ\x0e<\x97\v\xa5\x7f\xd8\x15\x93\xdb3\x92\x89\xff~Ӄ\xdc\x15!:\xbc\xb2q\x91\xa1RE\xf2ԅ\xe9ʠؑ@WK\xdd\xd7\x18\xfd\x8ea\x96\x01/ꃃ\x06\xa9\a \x12\x97\xe1:>\xb8\xf6\xde\x1db\xe3\xf076fr\xf5X\x8b\xd5Qn\xa2\xa0\x8d\t\x9c\xd2\xef\xc4T%mfn[\r\xf2\x8d}\xces\xae\x03cD\x98\xcay\x81*\xe3\x98\xc8:F\x16%\xbf`\xc2Τ\x8d\x18'\xd4'S\xc0\xc7x)\xc9E:8\b\xcb]\v\xaa\xc8\x14\xca ,\xa4\xcfki\x97\x8c_\x1b3N^\x9f\xd4.\x93\nE\x11\xe4\xf3\xc8-\tXް\x96\xa3-\xb2\x1f\x16 \xa1\xc1\x03\xdb!b\xe3\xd7aгZ\xa7\xb7\x82\xed\xc61TdƤ*\xd7uvԅjG\xd8 jሱ\xeaG\x14:\x18\xa7Wճ\xa5\xf8\xe2\f\x96\xf4\x91-\x8b%\xa1KQ\x1c5\xbaΚ͈f\xcb2\xd7\xed0\xfa@\x996\n\n\xa1\xa2&\xc3UM\"\x96y\x06[Y\x9a\xdd\xd7\x14f\xa8\x05\x13\xc1\x15KA\xfa\xaa\v\x9cu\x81^\x0f\xa1dFYVl\xa7Q:cV\xf0+)#V\x81\xef\xeds%\xeb\xa0a|h\"\xa6\x05H\x9c\xfa\x82\xae\x00\x83E\f\x93\xeb\t\xd2\x02\xe3D\xa8`\xcd\v\x1c\x12\xf8|\xbb\xecdߧ\x8d2ޗr\xdb\xf5\x19\x19\xb9d\xfc@8\xa9\xbaF\xe4{ʲ\xc1\xd1\uf151\ty\xcc1q0\xa9\xfeZ=\xfb\t\x04\xa0R\x06\a\x9d\x91\xea\u0092\x81\x82sdz'\aTk\\\b\xe2\x1d\xac#)\\V\xd5ڲ\x13K@\xfbU\x94ӣG\xbe\xd7\xcaU\xc5\x1f,\x92\xbc\x18\x04\x90\U0005acca~\x94\x1b\x00O\xe6\x7f \xf0\xd2\x18\xa9`\x96\xbbn<\x8ef\xc1\xbb\xad\b\xb82\x18\xad}\x91)\x10\x9a\xbaB\x12\xe3qx/\xd6ֈ\xedL1wt'\x1a\x13*\x17s\xf5\xea\xc9\x1a\xab\xb7\x89X\xdak-\n\xf2@\xb1\xf0Ͳv\xe9X\xe5\xa2\x15o\x87\xd1ѭ\x9e\xe5\xbc\xf5w7&>\xbc\xf4n\xa3\xaf\x90\x04\xae\xe5\xda\xd4\xee\xb5\x1bnU/\x94\x8a\xe4\x1e\x9d\x84%\x9d\xc3p\xa8țwo\xbdǀ\x06\xa0\xb5~w\xa4\xb4\t\xdb\\\x8a\x15Kљ\xf9H%\xc3\xe4\a\x910\x03\t&y\xff\xf5ˏ\x97\x1f~\xb9\xb9|w\xf5*\x004F\x1c\xe11\xa7\x1c9\xaeP\xde\x1e\x97\xf4\xc6\xc1\x03_1)\xf8\x12\xc2\xf0p\x8d\xd5\x04+?Ҥ,hĥM\xb6\x82\xf4\xcceH\xdc\f\x02 \xbb\xd0\x02\xe3y\xa1\x9d\xee#\x0f,\xcb\xd0\xe3+x\xb2\xa0|\x8eX\xba[\xb4\xf3I\xecU\xc3\x1fQk\xae\xe9#I(G\x90\xa0\x12\x9acY\x05\xd3\vB\x03@\xa6\xa2\xc0\xa9\x7f\xfd\xf5\x19apA\xbe\xae\xbdbL\xae\x1c\xd4\x12\x01!\x1caf\xcba\x05\x92L+\x02\x9e\x11\ts*\xd3\f\x8b\xf9L\xb5\x9a\xa9\xa4\v\x80\x8b\x14)I\x06>\xee\x89ܷ\xab$5\x00\xf0\x8er\xd5\xfb\xb2\xb6\x1a+VS\x91\xa8sMս:g\x1cM\xca\bKJG5%tn-\xc2\xc8Y\xa7\x91_\xe5\x8dJf=\xffʙ\xd7\x11-\xbf\xc5\xf8\x88\x8e\xd4\x02\xb2l8\xd83\xb6.\xaa3\xd8\nǭ\xb3\x82\x97ʻ\xf4\xdbU\xa9\xce\xec\xean\x8c\xb1\xf3r\x89\xd4\x1a(\xa9\x14\xb9\xc1\xebx\xa7ƻ\xba\xb9\xfb\xf0\xb7\xc9\xfb뛻\x00\xc0\x1b*r\xbf\xe2\v\x80Y\xc9WCķ\x15_\x00̃*\xb2\xa9\xf8\x02\xa0\x1eU\x91ne\x1c\x00\xb2\x85\x8a\xacc%\x00\xf2!\x15YS|!cm\xa1\"\xcd\x1c\x02`\xf6*\xf2\xdfLE\x02_E\xaaǟ\x9c\xdb^\x13\xe5\x92\xce!\xa6Y\v\x93\xe5e\xbc\xa9%:1G0\xb6\x1b3\xbb⫏\xb4\x99\xc4\xe6\xf5i\x06\xc0%\x15\xeb;`\xa8\x93h\x15\xcd\va\xf8p\xef\xbeMn\xa3\x05Bnj\x9b9b\xf1P\xc7Ř\xbcsY]J\xde\xfcr\xfd\xf6\xea\xe6\xee\xfa\xfb\xeb\xab\x0f!Ȉ\x96\x9129\xdf\t%\xc3\xd3-)\x0e.,r\t+&\x8a\xb2@7\x18n\x8d^%\xfeՖ\xb4\x85\x0f\x17\xd3\x06|Mp\x1f#K\x1alQ\xbd&\x94\x9e-\xd6@\xc1\x10w9\x04\r3\x1f\f\xf1\xa4nAk\xe7 \x18\xe6\x13\xac\xa2ڮ\xa5\x82AV\x8e\xc5\x1ew!\x18\xa2q/\xdeַV\xbc\x18\x0f\a\x81\xac\xd3I\xbd|/E\xab\x10\xf2^\x15skҢe\xf4\xb4&aъw\xe8\n\xec\x1a\xc6\xd5. \"`f\x05\xf8\x15G@uNw{\xe6\x12i36\x7fG\xf3\x1fa\xfd\x01f\xe1\x006\x91mj\xef\\\xb9\x1a\xda::\b\x06H\b\xdau;\xacp\xd5\xd7\r\x1f\x01\x15\x89Gqq\xe7\xea&\x8dg\x86h\x89\x99L'\x01\xea\xe2\xb9\xec\x9cҰ\xee\xc28\xdd\x17=\xad\xb6K\x8fD\xf0\x04r\xad\xce\xc5\n\xad$<\x9c?\by\x8f\xe1\x16\xd4\xec#\xb7[\xec\x1c'\xa9ο2\xff\x8b\x1e\xd1\xdd\xfb\xb7\xef/\xc8e\x9a\x12a\xd4h\xa1`Vd\xb6\xc8G\x8d\xa3\xc1V;\xf4\xcf\bnn>#\x05K\xbf\x1b\x0e\xa2\x80u\xe7\aa\xc8I\xb7v\xfdF\xf1\x04\xee\xb0b\xb3uĒ\xb6y!K\x95r\x8fK[L<\xa0\xfc`\xe9b4\xd4)D\xbb|ǶͶ\xfb\xb4M\x7f\xc5\x16\x16vJ\x91\xed\xba\f\xaf\x9f\xc2\x16\f+c``\xd6{a\x84|\\1\xc4\x05QE\x8e[\xb0U\xb9\xf3\x7f\x8c\xc2~6\b\x86Xk\x1e0.\xf7\xef\x9cU\xf7LQ\xb9\xea\b\xb8֏\xe5\xcc$\xf1\xc7\\\xa4p\x13=b\x03\u00ad\x13.\x13\x93\xc67\xc0\x88\xd2T\x17j\xbc\x10J_O\"a[\x10\xb9H\xaf'g\x8d\xdf\xd4x\xf8\f&xwG\x93hNt\xb0\x9cኄH|\x8b\x14\xe4G\xd3kfB\xf5\x02=\xb7\aɴ\x86\x18\xe5\xe0\xc2,\x9ch\x90K\f\fn\xecc^\xbd\xde\xda\xc5\xfcɌ\xc4\xccO\xf1$$0\xb8r\x8e\x83\x81\x1c\t\xd4\x05\xbaP\xb1\xf8UhY[\x15\r\xf2rr\xed;\xe1<\x13\xba\xbbY\x89\x92T\x9f\xdaV\xf8r\xd1\xef\x9f\xc0fx\xd8\x11 \x89\x93\xf4*0s\xe1{x\x1c\xdf\x17\xb7\xff\x93\xb1%s{^\xca^(/\xed\xcdq\x92\x17q\xaa\xd7=\xbf\x84\xa5\x90\xeb3\xff+\xe4\vX\x82\xa4\xd9\xc8u\xf3\x88\x03\xee\x87i\x86W\xfdf_\x16\x05\xb1>\xf9\xedQ\x86\x87l|\xcc.)$\xae%\xb2\xb5\xb7\xf2\x90>\x8b\xe5)9fWϞ8\x96.\x83ԝ\xd6a\x95\x8e0\xa1\x8c\x95Ȋ%\xa8\xb3җ\x8f\x06\x8bЀ\xaf0\xb8\xd1\xe8\xb9\xf4\t\xb5\x1f!\xd8\x15B\xb5+\x92\xdc\xf5\xa1|\xfd>J\xf9\xe0\xcf\xc8\r\x1f\xbb\x90\xcdAv\x84\xd2\x01\t\x1b\x8cs\xeb욭S\x16\x85\u038bp\r\xed?3!\x97T{\xbd\b\x8f\xb9\xc0xU\xa9\x0f\xe3\xd4\v^\r\x7f\xe5\xf5\x8bH89V$J~A\xfe\xeb\xe5\xdf\x7f\xf7\xdb\xe8\xd5w/_\xfe\xfc\xcd\xe8?\xff\xf1\xbb\x97\x7f\x1f\x9b\x7f\xfc\xbfW߽\xfa\xcd\xff\xf2\xbbW\xaf^\xbe\xfc\xf9\xc7w\x7f\xbe\x9b\\\xfd\x83\xbd\xfa\xedg^,\xef\xedo\xbf\xbd\xfc\x19\xae\xfe\xd1\x12ȫW\xdf}\x1d9\xe0\xc7Q\x15\xa9\x181\xaeGB\x8e,\xe9\x8fl\x8b>tyr\\\x9c\x82}\x86\x1f\xbcOQ\xc2\xed\xees\r\xbfD\xf7\xa8\xc3\xf4;yG\n\x12\t\xfa\xf3\x8a\xac\xda1y\xd7\xd9\xee1(\x97\xc0\xcf`oO\x1dl\xed\xbaĳ\xe8\xa9\xd6\x18\xb85gLL\xa25\x1a\xa8IКΣ\x1e\xfe=\x04G\xf9O$I}0\xb8\x0f\x06\x7f!\xc1\xe0[++}$\xf8y\"\xc1\x91\x8f\xc6\xccrd\x94\xd2\xe0\x89\xc7\x16U\xd5\x15\x96~\xdeY\xd9\xe5\\lt\xa2r\x91\x17\xd8T%\xb2\xfcg\x7f\xe1\xc9\xd8\x1b\xc0\x98\n\x97\xaa\xae\u058c\x94,;W\x15]f\xd8\xdfϚ<3(_\xeca\x9b\xa8BJ(\xc6Q\x02 \xc2\nKbL\x87\xc1\xc6\xc41\xfe\xaa4\x95\x9a\xf1\xf9\x98\xfcu\x11\x14\x86\xb5YjW\x1d\xc18Y\x16\x99fy\x06\x0e\x11\xaa\xd6G#\x04\xaaR\"aX\x86i*\x96]\x9b\x1a\xa5=z\r.4\xbd\x0f\xf1Rr\t\t\xa4X\x1e\x85\xc5ȦK\x80\xa33\x99\xae\t\xe5䊯\xcc\xdbB\xc6I\xd2\u0096p\x1aΩ\xc6\xd5x\x9b\xadp\b\x00\xfb,\x85\x86(\xa6\xaeУVo\x18\xea\t:\x02\x89Y\xd52\xa7\xccH\xaa\xc1\xd3;\xc5e5FĂ\xa1\x81\x91\xbbF.\xb5\xf4f\x03A\xdaN҃O\xb7 \x88uM\x9f\xca-\xfd\xbc\\\xd2'pGO\xe7\x8avrC\xbb\xb8\xa0\x87\xdc\xcf\xe8\xa5`%;\xde\x16\x86[\xd5S\xb8\x8d\x91>\x18j \x98\xb1ǋA\a\\^\xf2ri@X\n\\c,2ܣG\xafGB\x0e\xdc\xec,\x05\x9a,\x8c\xb1q\x0eL\x89\xe8p\xfe}\xe6\xdag\xbb\x92?\x85\xa2\xbe\xdd\x15s\xe8\xb5n\xafu\xffݴ\xae\x13\x84/R\xe5~\xa2\x15\xa9\xd9\xe7x1\x88\"\xd3\xf0mm\xaf\xa4\x91\xfa\xfaq.\xada\x92VRY.\xd0Թy_\x88\xf0\x99ƃ\xbe\xafZe\x84\xb01A\x96\x89\a\xb2`sd\xb3\fO\x95\t\x00k\xbdk\xb2\xa4\x9c\xceMw4T\xb9.}\x85\xf5\x86\xa8H$KCx\xb7\xb6\f5\x93ĸ::\x7fx&G\xed\xfc\xad\x90\xc9g\xec\x1e\xc8[\xc83\xb1v\x1d\xdcxJn5\xd5\xe8\xec݂\x0e)ȊP\x0f\x86X\x93\"\xcbv\x9f\xf8Жծ\x11\fɋ,#\xb9\x014&\xef\xb1\xf9\xfe\x8c\\f\x0ft\x1dT[w\x83{$\xce\xc8\xf5\xecF\xe8\x89\xdd\xfd\xd5ܓ`A\x06@d3r\x81a\x18\xa5\x89\xa6s\x13B\xf05Dg\xc8\t\xf5W\x05\x805n\xf9\x03S\xb0k\xd3\xdd'\x14\xb5\xaf\xcc;q\x01b\xa8\xa9\x9e\x94a26\x83d\x9dd\xb1Z\xe92\xc1\xff\xbb\x130p\xc9V\x93O\xb5V\x1aB\x16\xa0\xae]\x8e\tb0\xd3\x06-\x17\\\x012I%\xaa\xe5\x88\x03\x00\x9b\xf0\x93\xdaE\xd7\xc1Ӻh\xd8\xcb\xf0\x16\xe3[!\x0fmJ\xe3\xc4\x03AVOh\x96\xe1V\x95\xe5\x12R\x8cRemm\x8f\xff\xf8\xaet\x15F\x11*\x1ev\xe4\x1a\x9e\x85\xdb\xff\x05\xe5i\x06\xd2\xf4\xe0rQ\xb7\x06t,\x8fd\x9c\x86\xb5\v\xa8ʕL\x80\x10\x83\x8eI\"d\xea\xba\x1e\xf9\xbe6tǡN\x87\xafR\xa3\xa1\xbc\xd7\xf9U̚C\x0f\x84;\xcdDr\xafH\xc15˪Vg\xbeϙ;\xf3.\x10f{?\xba\x1cuퟣRVF\vl\x7fy\xfeU\xf5's\xa3\xbdj\x89\x17\x81\xb6\xbd$\x8fH\x01\xda\x1fd\aS\bhN\x82\x89M\x15\xcf\x04\xba!\xc8FN\xdfLkE\xa8c\xd3\x0e/\x02\xaa\x87\xe0ΐ4j\x11\x15\x17*\xb3\xf0uF<\xaa\xa3:~\xec\xc5\xfa\xeev\x99Qp\xd1\xd6p\xa8\xf7\xcdd\xa6\x9b_S\xe6b+\x99\x10\x88[A\x92\x94I\xd3t\x7f\xedw\rF\xc2t\xb35\x9d\x94\xa4\x10\x9a\xbc\x1c\x9e\x0f_\xb9\xe4M4L7Q\xd3\x1c2\x03k#C\xbb\x0e\xed\x1a%\xbaAl\x99g\x98\x11\x81d\x98\xe29(\x91 \xddvF\xec\xbe\xe5h䚶\x9c\x11%\x06\xc1\xe0̏\x96\xd4w\xa8\xb6\xb0\xcc\tR\xb20\x82\xa2\x06\xc1\xf0\xcc\xcf\xcb\xe1o\xc33\x02:yE\x1e\x04\x1f\xe21~\xf2~L\xee\x04\xae\xf3#a\x96S\xc5Fd\x1clK5x\xc4T\v\xd3\xd9:\x12*\x9am\x82\x1d6Q%\xe0Q\a\xae\t\xce\xd5c4\x95\xec>\x0ftʿA\x0e\xd5քcj.c+8_\x00\xcd\xf4\"v\xbc\xc8Q\xd8\xdf\xfe\x9fخ\x12\x1b\xecp\a/\\\x97Ee\x88:\xba\xb5]\x17\xea\x1d#\x03\x95\xf7\xffg\xd0\x1d\r\xdf\x0fww\x93?CՃ6</V\x8d\xc6\xd7~#K\xe7 \xb1\xaa\xf4S\xdb&\xdc\xe7t\x02\xc3\xf4\x83P\xda\x04A\xdc\u2007\x93\xc7\x7f\xb4hn\xdbq\x95u\xe4z\x12\xc7\xeb\x84\xfcM\x14\xb8^\x98\xd2i\xb6.{\x19b{\x97\x178\xec\xd8\"[\xc6M\xe8\xe6\a\xa0)6\x80E\xf5\t4`\x05sB\x91\xaa\x8d\xe3\x04\xb4\xb4磓\x85\x9bX˶\xa8\xdbW\xad\x81\x8e\xe3\xf3\xb1\x91\x1e\x1bw\x8a\xb51\x98\xfd0\x8aՍ\xef\x19\x14`\x93\xf3\xef\xee&\x16\xf7\x0e\x8b\xd3\xc8\xd08\xfeP\x7f\x96\xa5\x9d\x9c\xeb$\x8a\r'\xa3A2n\x86h\x04 zd\xddtL\xb7\xc4\xc8N\xacc\xa6\xc7\xe2\xa8\x03D\xb7+/\xb4\\\xea\xc4\xc2[k\\\xf1y\xa2'\xb4b\xe7\t\xf0ӥ\xd8/\xaa$\xae~\x8d:a\xa0\x83\xc3\xd2\xdd[\"$\x8f\xder\xda`(\xb3\xe1\x14S\x06Ibz\xee\x85\xe6\x81\xfc\a\x8d\xb9QG\xb8\xf5:\xac\xd1\xd8\xc9\x18\nk\xe6\xe2P\xd2ac\xd4)\xb6E\x9d`ST\x83\xa8\xb6\xb4G\x12^,\xa7 c\x1b\n\xf8\x96\x02R7\x18\xa4\x19G\x88#4!7vh>\x89\xe9\xdd\t\xecp\x15\t\xf15\x8e\xf2\x0f\xbf\xff\xfd\xb7\xbf\x1f[\x04xؔGB\xbc\xbe\xbc\xb9\xfc\xe5\xf6\xe3\x1b\xd3\xcdj<\xf8L\xf6?\x99\xed\xf5pѝKn\r \xc4Z\xa1`\xe7\t\xe3\xed.\xb7*p\xf1b\xe4\x0e\\{T\xb9\xa7H\xb0Z\x18\xff\xe6\x194I\xbcQ\x1a\x19q\x19|BS\xa2\x93\xfc\x16\xf3\xd5\x11\x8a\xaf\xc1\fû7\x13\v\xa8Z\x00\aCDEJ\xa8\x894a]\xb3\xc8V\xc8\x14\x94ܽ\x99\x18\xc4\xc4\xd0\x12\x9f51t\x13*[\x83\xaev>ۢ\x93\b\x98\x18\xbe\xb3\xa9\b\xdc?O\xf1H\x00\x96\x98Q\xc6$\xbd\xfc\aG9\x1c|Z\x0f\xfcD\xab\xfc\xe1{_\xe4R-\xf8\xa3\xa0\x92Z\x98`ׂ?\x12\xa8\v\x13\f?\xbd.轊ʫpބ\xf4\xe7\xd0\xf5^ſ\x8aW\xf1\xe5X\xbc\xc8\as\t\xb7Z\xe4\x17\x83h\xee\x1fN,\x88\x93\xd4\x06\xf8\xf3\x85\xf6\xa5\xefI\x1aLD\x14&nZ\xf4\xf8سh$\xddMiF LU$\v\x9f\xe7\xe0\xa0Թ)\x03(r\x1bs\xf2G\x81\x85\xa6\x12s\t\xd8\xc0\xd3\xd4u\xfa=\xe7\x06\x11X<\x8d7A'\xa1ra\xc2F\xae:\xc2e\xd5<\x91\xba\x15\x1b$\x92\xaa\x05(\\M\xc1#\xab\x8e=\xa7Jp\xf4\x99K\xa21\x11\xaa\x10\x98\"9U\xd8_»\xcdv\x02&II&\"\x1d\x0eC]\xb0\xda`\xc8\\\xd2\x04H\x0e\x92\x89\x94\x98>h\xa9x\xc0\x13S\xe6\xc7OK\xddï\x88H/\x06\xe8\xed zUyDE(\xcd>\x94\x1d|}E\x88(t\"\xaa\xfah\x87\x8fP\xfej\x90\xdbn\xd72\xcc_\xd0,[\x97(\n\x95/\xb7\xfbO\x97\xa4\xd9Fv DK\x9aO^\x1f\x83\xacljg\x02\xc1\xe2\x90\xf6\xf2\x17f\xeeq\xd3B8\x17T\xf5~}\xf9M_~ӗ\xdf\xf4\xe57}\xf9M_~ӗ\xdf\xf4\xe57}\xf9M_~ӗ\xdf\xf4\xe57}\xf9M_~ӗ\xdf\xf4\xe57}\xf9M_~ӗ\xdf\xf4\xe57}\xf9M_~ӗ\xdf\xf4\xe57}\xf9M_~ӗ\xdf\xf4\xe57}\xf9\xcdg^~\x13\xf1\x90\xaf8\x99`\xa1\xc9\xc5 J`\x86\x13\x93`g\x89+W\x11\xb3\x8a\xc3[C\xac\x862\xae\x8eQ\xaf\xf5\xe9\xf5=3\x82\x8e\xb4E\xa9\xa8Jhv\xf6K\tmb\xd1>\x83\xee\x1b/\xa9\xf3\\\xd8\xffT\xf9\xf3Z\xe2܌/ s\x1egH\xc33\xe6m\xb2\xe5U\xee;\b4ٟ)\x8f\xf6ʺf\xc9\xe3\xfd\x13\x970\r}\xec\xa92\xe3O\x95\x15?\x98\x11\xf7\xe3\xc5b\xab\b\xd8[\xd9\xf0j\xa8Ͷ\x12\x11\xb0\xef\x16p\xea\x9c\xf6\xc1|v=3\x1d\x01{;\x97\xbd\x95\x95\x8e\x80Z\xcfc\xef\xccHG\xc0\xacr\xd8\xfb\xb2\xd1\x11@1\x7f\xfdt\x99\xe8\x13f\xa1\xa3\x130\x9d\x9c\xd5\xd8Xj\x94;A|\xe1\xe9\xddB\x82Z\x88,\xed`A\xde1Ζ\xc5\x12\x05[\xa1bb\xab\xb2\xae5Tcx\x9dc,\xa7K1!X\x96\x829\x8e\x8e\xb2,8\xdfd\x9b\x88-\xa8Yɫ\"I\x00RH\xab\xe0N\xb8\x88|;.\xe7\\\x9e\xa9\xff:\x8cϰ\x9d\x05\xd5f\xcb\xe3\xb7\xff?\xe8\xc9\xd8UUT\x89\xc1\xf1\xf2\x02Sq8\x88:+2\xba\xb4 ޠ\xc7\x05\x1b\x9e\xa2\x9c\xe0@)\x01\x16\x05D@<PF\xb0Q\x10\x10\x01<\xba\x84\xa0\x83N\xecT:p\xb8l\x00q\x13\f\x92\x1c*\x19(\x93\xff\x11`\xa3\xcb\x05\xa2-\xd5Ӕ\t\xec/\x11 ,.\xd6Э< ^Ot/\vؓ\xf3\xeex\"u\x97\xa8f\x17\xe7\xa4s\x19\xc0Ӡ\xa3{\xf2;\x1a\x1f\xf1\xf1\xa6\x0e)\xff\xf8t\x7f\xa4\x97\xd8\xcd5\x8dM\xf1\x1fN\xefG\x06\xe1;\xa5\xf6;0K\\\xf0=2\xf0\xde5\xe8\xde1\xe0~8\x85\x1fI\xb8'\b\xb4\x1f\b\xb2\x93\xd7qK\xe6\xdd\x01\xf6\xae\xa1\xf2\x13\x87\xc9c\x13\uf1d3\xee\xde\v\x8e\xe1\x18\xb2;\xe1\x1e\x9f:\x8f\xe6\xdf8\x85\x1e\x91<\x88TŌ3\xcdh\xf6\x162\xba\xbe\x85D\xf04Ыi\x10q\xe8D\x00\x0f\r\xb4\xc0\xec:\xb9\xd3>\xc1\x05u'\xe4A\xea\xb7;\xfa\xc8\x7f \\\\ˀ2\xc7\xf5\xdbyo\xf4\xb5\x7f\xce(\xfd\xf3,\xdf\xed&\xc1\xee\x84\xffA<\x101\xd3\xc0\xc9K\xc6=\xed_\x85\xeb<\xb7p\xaf\xa25\xa5\xf0\xa2\xec\xbe\xfeƃ\x0e\x95\xe0//\xb0bBJJ=U$́?u(́\x9d\x15Y\x97p\x1a\x86\xf96bi\xa1\x04\xab\x8e\xd7zm\xc6\xec5\x86IJ\xb9\xcd\xf2\xff\xfaL\x14Y\x04u\xb4\x00\xaa*g\n\x82Kv\x17?5K\x99\x02!\xee(|\xda]\xc6\x14\b\xb7Q\xf4\x14Q\xc2\xf4\xac\xd1\xc4\x13\x95-\x1d.Y\xc2=J\x11@\xa3ʕ\xfa\x95R\xc4Ji\xb3,\xa9_)=\xefJ\xe9s_\vh\xb6\x04Q\xe8\xcff\x19\xf0\xb0`ɢ\xeem\xb0%\xf6{)\xe2K\xa8чtCڙl{\xda\x03j\xfe\x85V\x0e\x11\x1c\x16\x16\xf6nj\xb2\xdaќ%\x9eJo$\xc4\b\xe1\xa9\xed\xe4\xed\xcd\xed/?]\xfe\xe9\xea\xa71\xb9\xc2\xe3\\+\x90\xe6\x10\xf90\xb3f\xa22\v\xba\u0092\x8e\x82\xb3_\v\xb0\xea\xf6e\xf9\x96W\xbe\x8a,\x00j\xcc\xf9\\\x11\x96\x035\x8b\x8a$\xcaOL\x99\x03\xa3\f\f\xf4\xd0\xe11\x17\x18\xba\t;\xfc\xb5iK\xc8\x15\x02\xc1\x94:\xb5vg\x01\x12Ȝ\xad\x82\x16*\b\xd3\xf6\xb5 4-\x9b>\xa0\xa0\xa2\x03\x8e}Q\xe8T\x14!\xf4@\x88\x1c4Jp\x19\x97\xc2C\xdf\xea}\xc2\n\x05A\xc7\x02N\v\x8d%%\xb9dK*Y\xb6\xae\x0f\x90fcr#\xbcǽnOQ\xbc\xea\xa8{\xfb\xfe\xea\x96ܼ\xbf\xc33\x8c\xb1Ւ=z\xc5\xfc=\x90PS@\xb2X\"\xa7cr\xc9\xd7\xf65VK3\xecE\xa64\xf0\xb0\xa1:g\xc2y\x96\xe4\xc57cs\xbd@\xbaI\xf46l1Z\x00\xc4:E|1\xa8\x8d\xf1\xb2if\xb93\xd0\x0frt\xdfU\v:x\xb2\x94jC\xd4\xca\xf2\xd6\t\"\\BnOvT\x84\x06@,'b\xc9fT\x9db|\x9e\xd5\xe5o\xf0\xf4\v\x9c\xf2e\x93\bǼ\x81\x96\xca\xcb\xf0.\xaa\xe5\xce@\x98%\x17\xe6\"\x1d*r=\xf1̇Mq\x982\xded0H\xf4>1\xad\xc6R\x8bn\xdb\xf0\xfb\x8c|C\xfeH\x1e\xc9\x1f\x8d\xbb\xfa\x87\x10tw\xb3\xf2\xb1vޯG\xaf'\x9d(\xf5WT:\b\a\xb1\x8b\xf9{\xc6\xd3@)\xf4%\x84\x1a$\x9e\xa5\xeb(\x1e\x8a\xc1\xe8\xd5\x15\x0e\xfe\xb3cX\x1c\x949\xb0\xb2t\x85\xf0\xe8\xc9ϊe\t\x0e\x0f\xab\x85n\x9c\xf2i\x9eU\x8b\xa3\r\x86\x88\x02I\x96T'\x8b\xaa\xf0\x1fi\x83\xe7K*]i\xb3pȩ\xc0\b\x94+q]0\xf5e\bhLAI\x83/O\xc9A\x1bKn\x13ou~\xb1m\xd4\x18\fթf\xe7\xac\xe3d\x1d\x83Fx\xeb\a}v\x17=\x88\xd9\xf0[m\xddBM\x97P\xec\xe6I$\xcc@bT\x1c5^h\x8d\x03v\x93\x91+\x96\x80\xfad:.\x97B\x8bDd\x9dxi†,\xb8\xf0\xee\xbbH^\xfa\xcb\xdb\xc9\x19Ɔַ͑o\xee&\x8d\x8c@0\xc4\x17wo&/>\x112cB=\xa3JsM\xc2\">Q\xf1\x9e\x98\xf2\x9bF8\f\xfd\xfdђ\xe6\xa3{X\a\xf8\x80\xb1\xd3\x1c\x95\xfc\xd9a\xb8v\xd2K\x9a\xb7\x84!\x81\xa6\xec3\xd9\xee\xe6\xf4A5\xa6\xdd\xfbޖb\x15T.jVD\x1e6\xf04\x17\f\x97\x16l\xb6\xb5\x19.\x00\xe8\x9ems\xcf\x1f,\xeb7\xc3\xf5\x9b\xe1\xfa\xcdp\xfdf\xb8~3\\\xbf\x19\xae\xdf\f\xd7o\x86\xeb7\xc3\xf5\x9b\xe1\xfa\xcdp\xfdf\xb8~3\\\xbf\x19\xae\xdf\f\xd7o\x86\xeb7\xc3\xf5\x9b\xe1\xfa\xcdp\a6\xc3\xfd\x1f{\xd7\xfaܶ\x8d\xed\xbf\xeb\xaf\xc0dv\xc6\xf6\xad\xa5$\x9d\xceή\xbft\xbcyt<mR\x8f\x9d&w'\xdbہHH\xc25\t\xf0\x12\xa4\x1c\xdd\xdb\xfb\xbf\xef\xfc\x0e\x00>DJ\x16\xa88\xedv\xb9\xf9\xb0\xb5M\x1e\x02\a\xe7\x8d\xf38\xf4?\xfe=S<\xc7b\xb8\xb1\x18n,\x86\x1b\x8b\xe1\xc6b\xb8\xb1\x18n,\x86\x1b\x8b\xe1\xc6b\xb8\xb1\x18n,\x86\x1b\x8b\xe1\xc6b\xb8\xb1\x18n,\x86\x1b\x8b\xe1|1\x9c\x9f\xae\x1f@Xm\xa2z\xa1\xd3\f\xf9)7\x1eP\xc5Pa\xa9\xa6\x94\xec[\x8b\xaf]\x89[\x93\xc7 \x81H\xab\x85\\\x969\x95d=\xb5c֧\x91\xddش\xc2дZ\xddӓ\xc9\xe3\x1a\x1c\x89LeH=\x1c\xfe\xd5\x05f׃\x8d\x9cA\xfa\xf58\xedz\x94n\xcdx\x812\x8c\v\xf6_\xa7\xff\xf8\xea\xd7\xe9ٷ\xa7\xa7\x1f\x9fM\xff\xfa\xf3W\xa7\xff\x98\xd1\x7f\xfc\xc7ٷg\xbf\xfa\x1f\xbe:;;=\xfd\xf8\xfd\x9b\xef\xde]\xbf\xfaY\x9e\xfd\xfaQ\x95\xe9\x9d\xfd\xe9\xd7ӏ\xe2\xd5\xcf\a\x029;\xfb\xf6O\x93\xdfPc\xb5\x19\xf0\a\xa2\x15\xf7˹\xbb\xa8O\xf9'H\xd1\xc0U\xf2T\x97\x8aj)\x1d\xf1\xd7\xe2\xc1\xb6\x01\x15q\xb0w\x16\x16\xc6yDN\x1c( \xbd\x89 \xccȐ#C\x1e\u00907\x8eZ\xb6Y\xd2\x1a6\x9f\x91%\xbd\xa2\r\xe5ɫ\x05\xab\xd6(\rө,\x90\x97\x87\x80\f\x1f\x9e\\*\x8b\x96+\xea\xc4\x12eos\xaa/\x1e<9\xbeQ\x12\xa4\x8b\x95\xc8率 \x17WuL\x81\x04\xc64\x16\v\xa9\x82{\x14S\xe4h\xf6G\x10U\x03^B\x16_.\x8b\r2\xf8ŧ\x00\x9f\xbcM\xf4\xb7\x0e\f\xd3\xf4\x1b\xe3C\x11.E\xfc`\xa8\x8cfS\xa0@+\xf8@2\x9d\xc8h\xf3\xd4o\x88\x94\x84\xf8T<\r\xf8\xf6a_,\xb8\xb9\xab\xcf_LQ\x12P\x1fs\xe7\xfb\x8fm,\x92f\xbe\xce\xe5Z&b)^\x99\x88'\xc4\r\x17GȰ\xcb\x1d0\x83@b\xc0\x8c*r\x9d\x18v\xbf\x12\xe0\\\x94\xc9\xe5\x1a\xb1h*M[\xf2\xe0*\xbc\x14'\x94\xf9\x85\x81\xcc \x05\n\xc32\x9e\xa3\xab\x80\x03\x1f*\x12\xa9\xbez\xaeu\xe2\x06\xc4$\x9bz\xed\xae\x00E\xe9_\x94\xb8\xff\x05\xdf\x0e\x0e\xcf'|Y\x15\xc6`6\xfbv\xb4f\xe8\xb2w\x1d\x13\xc4-\xfa\xa72\x9e\xdc\xf3M\xe8r\xefWb{}\xd2\\\xb0\xe7gěܰꋡ\x92\xf6\xeb3\xba7|qy\xfd\xcb\xed\xdfo\x7f\xb9|\xf9\xe6\xea\xed\x10\xb1\x88\x93\x12A\xf3\xdd\"\x9e\xf1\xb9Ld\xb8\x11\xd6b\fd35A\x91\x1a\x8a\xe3\xa7q\xaeC\x13c\t\xcby\xa9Ш\xa2ƴiݯ\x04\x82lv\xb0 2[\xb4\x17\xbb̹\n\xcfZ\x9co\xb6\x88!/\x15\x82>a\xc4:L\xb69;:\xf4\x95\xadS\xbb\x8cc\x11\xb7P\xf1\x1b\x8d\"xᗰ\xa9\x9bg\f\x80\xc9\xd8\xf5\x8f\xb7W\xff\xd9>\\p\xc6\x00XG\x18\xfb\xc7$\x8b\x81a\x8e<\xd5\x1b[a8\x9e\xeb\xef\xe7\\\a\x19\xad\xac\xd6\xe7\xc7ܧߔ\xaa!\xa3\xa4j@\r\x02\xcaX\xaac1c\xd7V%\vӆU\x7f#\x94ؐ\xe0\x82\xcb}\x85>\xd7Ɇ\xc1{[\xf3\x04VK\xa1m\xed\\\xb0\x81՟M\xb5\xe0\x89\x11\xb3/\xa2Wa\xb8\xbcA\xd4舓\xab`\xb0X(]8\x7fy\x00ݣ\x9fI\xae#f}\xe6F\xd2ZK\x7f\x05[Y\xef\x1ajU\x1a\x8f\xe9\xebj\xd5t#\x12\b\x13=\xba\xfaժ\xffT(y\xc1}GE6\xd5\xf6\"\x17\xd7fU\xa4\xdc܉\x98&U\fظ\xac\xa2\f\xf6P\xaaM\xbf\xdbd\x82-\x04/\xca\xe0\xab\x19\xb2\x86m\x8e\x8aP|\x9e\x84\x060\x06J6\xe0\xe6G\x95ln\xb4.^Ws\x19\x8f \xdb\x0fΧi\xdf\\\xc0\xc0\r\x82\x89R\n\xacmJ\aGb\xa0Q)\xeb\xa9-\x10\xa44_R\b䥺4\xdf\xe5\xba̎@'\xb8컫\x97\x90_p3@mB\x15\xf9\x86\xda\x00\x04\x81eL/v\xf8W\xec'\xf0\x9d\xe3\xb4@\xa0\x95\bX\xb0R\x19\x81~\"|\xc3xb\xb4w낽\xd9kjyߌ\xbf\xcc(<\a\xe3]*6\xd7\xc5*\x10\xe2\x168\x12\x01ݯ\x84\xc6\xf6\x80L\x8a\x92U\xc9F1\xb4\xe2\x16\xd4P\xa0\xfcN\xa0레D,T$fC\xefV\xff\xfcMЛC\x83\xe3D\xe5o\xb5\x82\x009\x82ίT,#n\xb5\x1c/\xdat:\x19\xd0>\xc8\xf9\xe4\x9c*\xa2I|\x94F\xe4ԍ\v!\x80!G\xfd}9\x17\x89(lȂz\xc7\xf1B\xd0Jeʃ\a\xb5\xf3\xa2Rmh4\xa6L\x99\v\x17\x14.X\xacŐ\xfc2\xb7韮^\xb2g\xec\x14\xbb>#RG\xa53$\b5\xd6\x0f\x84ٖ\x18r\xe1\x97G\xa8$\x8eg\xc1\r\x99H\b\x9f3\xa5\x91\x83\xb9\xf2\xb8Dw\v\x1f\x0er\xb9\xb5\xe1Q\xfc\xae\xf0\xd9%N\x02\x017\x84Ͽ\x8f89J\xf5\xfddD~\xa4\xe6\xfb\xe9\xd15\xdf\xf0\xb0\x12\xe4I\xfb\xa4H\f\xb0T\x14<\xe6\x05\x0f\x9bl\x8f\x7f\xa5\xaa\xc0\xcdFB\xfe\xac\x84\xfc\xe5\xf5\xa2\x11?HU~\xb2\x93\x1ȇ|p\xfb\x8a\x801wy\x02Y>\x0fV8Y\x96H\xdb\xed\xae\xc5\v^\x90\xfb\xa3\x1ar\xda5cy\x9dF\x82\x1cw0P\xea\xa1+e9W\xb1N;ۆ3'Z-\xc1g$\xf1C\xe1\x8fl\xf5\x99\xd8jx\xf8:\x11k\x11\xdc\xc9p\x8b3~\x00\f\\\xeax:!\xa0\xc10\x19K\xf8\\$\xd6\xf8\xb2\\R\xa5\x8dׄ6\xf9\x82\xa1\xc6\\'ǖ(\xde\xe8\x84\xca>x\x85\x1c\x00\xfd\x03\xe0\x86^=\x0e7\xef6\xd9\x16n\x06F\x93\x7fo\xb8)\x83-\xae\x0en`\xb4\xb5q\x03\xa0\xff\xf2\xb8\x19\x18\x82\xbf\x97*\xd6\xf7\xe6\xf3(\xf1\x0f\x16\x98\x97\xde\x11\xf4O!\xd5\xd2\fW\xe4<Ijt\x9aϡ\xc9}\xa2\x8ao\xc4ߣ\xb7\x02\xa1z\x97\x0eMPf[a\x9c#\x95\xd7\x0e\xbdڧ)\x03!w\xf5\xeao\xa6)\x97\xa9\xe1/r\x18\xbd\x85\xe4\xc9m&\xa2#Y\xfc\xbb7\xb7\x97m\x80\xc3\xfa\x1a\xde\xd3\xf0\x0f\xe0\x1a\x10\x19\x8fSi\f9\xf1b\x8e\x81l\x03@\x9e\xfalإ,V\xe5|\x16鴑j45ri\x9e:\x9e\x9c\x02/g\x03\xbe!\x15\x9aH\xd6\xd7\f\x02\xedT\x9d\x83\x88\x8d\f\x00\x19U\xd8$\x82\xa3\x1a\xa6\xd8g\bt\xd1\xfdvX\x85\x1b\xf5\xcdqC\x0f\xe8\xbfIN'يO\x87\x1a>\xaem$\xc5\xd8WZi[\x9f\xe0\x06f\x03E<\x94%\xf1\xcf\xde_\xb0\xa2\x96y\xc0\x81\xbf\x17\xa1\x9b\x8e/(\xfa\xfb8\xe8퀖\xec\x0fr\xd1\xc0cER\xd2\xcaM%j\x90a\x83\xa8\x06\x00%2\xb4W}#ńSL\x15\xbf\xfa\f\x84\x02\xd5\xefAA\xef\xb9\r\x06\x03e\xfd\x910O3\x95\x190\x00p_4\x8c>ӎq\r\x80\xdc\x17\x15k\x9a(\xe1\xa7zh\x88w\x00\xe0\xfd\xb6\t\x1bֱ\xf8q\xec\x93G\xb1Q\x1a\xd7ە\xb4\x98\x8b\xc2\t\vwo\x1e\x9a\xd2\xe4\x86\x05\xc4Ҁ\xc5cJbn2\xfaM\x93\xd5\x06\xc0\xfe\x8dd\xc4 \x87b\xc0K\xae\xfd\xc3Q\xfd\xedo\x1b0\x98l]\xb4\x1d\f\x91yg\x007\xf9\x8d\xd6\x194\x17\r\xedi\x12\xf9\xbf־\x0f\x00YQ?\xdd\x05Q\x15C\xb3\xef\x8dk\xf2\x1d\xc2\x1b\x88>&\xbej\x12U\x10\x85h\xaf\x16+\f\x1dk\xd3h\xb2\x7f^\xa1\xc1\xbb5\xb9p\xfd~B\xbc\xad\xff\xc6\x15%\xaf\x92\xa8}Ï\xeb\xeaC@廰U\xba\xa9&p\xb3\xa0)\xb2\\\xafe,X,\x17\v\xe1\x93\xc0\xe7\x02\x19\xe1<\x15EX\xa2\x96\xbb\x91\x9d\x8b\xa5\xb4\x99\xb9z\xc18\xa4\xeeɉ\xa9;O\x84`\x80\xf2|e\xc1R\xb9\\Y\xb9\xc58K\xb4Z2\x7f%\x8a\xeac\x86\x8b\x94\x00\xa8:g\xf7<O\x19g\x11\x8fV\x02\xa7\xc5\x15\x8bK\xb07\xa3\xf6\xad\x9b\xa9)\xc2\"҈p\xd2\xe5\xa43\xa4\xa2n\tn\xe0I\xc1lW\xd6\x0e\xabL\x1c\x97\xf3㝆&\xcbN\x06\n\xc3\xdfI\xb3\xa8q\xa4\xc38\xd2a\x1c\xe90\x8et\x18G:\x8c#\x1dƑ\x0e\xe3H\x87q\xa4\xc38\xd2a\x1c\xe90\x8et\x18G:\x8c#\x1dƑ\x0e\xe3H\x87q\xa4\xc38\xd2a\x1c\xe90\x8et\x18G:\x8c#\x1dƑ\x0e\xe3H\x87q\xa4\xc38\xd2a\x1c\xe90\x8et\x18G:\x8c#\x1dƑ\x0e\xe3H\x87q\xa4\xc38\xd2a\x1c\xe90\x8et8r\xa4\x83)b\xa9.&\x83\bjGO\xa3\xe0&\xbe\xbe\x1e\x9aq6/\x91\x96\a\x9b̮\xcc\v\xa1\nz\x00XWs]\xa56\xfa|\x0f#\x8as̔\x8am9W\x00\xc4\xfe%\xf9\xa2n4OE\xc3\xed\xb0\x06LR\xb1W?\xbe\xaexg@3\xa6!\xdd(h'?\xaaH\x1c}\xf4=U\xee\x93\xe0\x04\xb2(\xd1\xe8ҽ\x12\xeeԣ\x15WJ$\xce\xff\bJ\xeeA\\b.\x84b:\x13\xca\xd6\xedpf\xa4Z&\x82\xf1\xa2\xe0\xd1j\xc6>\xac\x84\n?v\xd7%\xb7^\xa5AFKj\x8f?\x17iX\x7fb,\x8f\xf1(\xd7ư\xb4L\n\x99U\vdFPŘ\t\xcd\x1b\xf6\x87\n\"B\t\x00,Bt\xf5\xa9w\x80\xaf\x06][\xeaf\x9fD\xf2\xd0\xce\x01G\xa4Y\xb1\xa9Ҋ\x05[\xc8܄\x9cR\x94Hr\x04h\xbfH.@\x17\x9eX\xaasJO,\x90\x05k1\x1a\xa2K\xb09z\x1f6QV\x18J\x93m,\xd2}4\x96\xc6\xd9\xcf&$\x81\x8e\xbb\xde}\xa4\xf0j\x8c\x12\xe9\xc6\xf4\xd9\xf0\x15\xbb\x97\x1bK\xacp-M\x9dC\x1db!ya\x87\xc4\xffJ\x98\x9c3\xde\xed\xf2\x12\x14e\xa0t\xb0Zh\xba\xfd\x13\xe9+\xb1FCB\x11\t\xb9\x0eQ\xd3|\x87\xe4{T\xc1W\x88<\x95\x8a\x12\x97\xdf\bc\xf8R\\\a][\xedr\xe8\x00\xa5A\"A&=\x12#\xc1\x01ջ\xf5Y!\x91\xbc\xb1\xe4\x00\xa0\xa9\xdd]\x95\x90\x7f\x9fcp\x03\x891\xeaxI\xf7\xf4A6}ga\xcd\u0383\x0e\x99\xfe3\x01`%z\xa6\x16B\xa1\xeb\xb2M\"\x98\xe7R,\xd8B*\x9e\xb8\x1c\xc2sD\xc6B\xbaۡ\xc7\x19\x9a~\x198\xfbZ\xf9\x145\x8f\x95\x19\xfb`\xd1\x12\x00\xb2\xc8K\x05+\xa5\xaa`U:\x16(UX\xe6\xc8\x05\x81.\xe4\x8a}\xf3\xec\xaf\x7f\x0e\x00:\xdf\xc0&\xa5\x9c\x81B\x17<\xf1\vd\x89PKP\x94U\x10<\t\x89\xdcU\x87d\xaaӧ\x19Q\x16\xc1Ͽ\xbe\x9bWL\x17$\x024{\x1a\x8b\xf5\xd3\x06=N\x13\xbd웾u2y\xc4\x10B\x0f\v\xd30\x87\x8b\xc9Q-\xf6\xd8J\xdfӹ6\xe0\x0f\xe07gѠ\xa4Dge\x02\x82\x991\xb4\x10\xb5gQ\x1a1\x80\xe5\xaab\xec\xee\xd6!w\x82\xd8\xd8/\xab-h|\xb2\xae\xdfF\xd0ީ.\xd0\x05\x99I\x13:v\x9b\xb1\xd7<I\xe6<\xba{\xa7\x7f\xd0K\xf3\xa3z\x95\xe7Am\xf1<\xceh\xb1\t7\x05\x8bV\xa5\xba\x03.\xea\xa5':$&\xa3\xcb\"+\v_c\xd48\xecj\xef\x90ka\t\xf0\xd6\x1cr\xa6Kce\xe2\x93,|q\x1fWL`\xf7!\xca\x1cr!\xd1\xcbjͦ\xc9\xc8_?\xfb\xe6/V\x80\x04@\xd49\xfb\xcb3*.0\xe7֞!\xed\r\x831\xe5I\"\xf2\xa1\xa2\x01$\xde'\n\x1eU\x12\x14\x9b\xa3\xfd\x97\xcf溾{\xf7w\xf2[eaD\xb28\xb7\xed\xb4\\p)\x04\x97'dZ\x9d8]\b\x97\xa3k\"\xcd\x1e\xd5FZ\xeb\xa4L\xc5K\xb1\x96\xc3G=\xb6`\xf8j\x18Lqf:ĥ\x99':\xbac\xb1\x03\xd3\xc81t:\xb8:\xba\xd9$\xb0\x94\x17\xd5fT\xc6\xeb\xab\xc3f\x93G\xcb\xc4܉\x19\x873\xaa\xecd)ϲ\xc3i߱3\n\x0es~\xdfB\x14\x15\x13K\xc5\xf80\xf4\f\xbd#\xb1\xa7\x14fN\xf7\xe0\xa7\x06\xe3\xc9\x06\x89e\x81\x10\x99\xaf\xe8ы6\x9d\xd4}t\xedw\x82\xe1z\x8b\n\xa7E\x06U\bj\aʹ\xe1\x19\xaa-̪*\n\x9f\xf2\xc2y\x1a\x83\ue828\xcc5\x13\xb9\x91\xa6\x10\xaaxO\x14\xfd\"\xe12u\xc1\xb1`\x88\xe1\x97V\x03\xd18$\xda?m\x90v\xd0k\x81\xc8\x1dtA\x10\x9e\xafiE35\xe6\x0f\xe0\xf0\x16%\xa1\xd2ۂ\xa1\xd0\r9\x94\xf0\xe2t\xe0\xe1Wl\xb9\xe5M\x1eaF\x1c'\x9c\xdf\u05f8i\xcbf\xec0\x94a\x89M,\xc4\xdfH$\xd3\xc1\x1c-\x91\x01\xc0o\xa0%L\x03\x816chhEf1S;L..\x81\xe6\xa5eP4\xd1\xc9G]\xf8\xa5\xb1\x93\x8b\x93\x10\xfc\x1e!P<\x92s\x9d\xf1\xe5\x80Qz[\xb8\xde\x06\xc6b4%Ha\xaf\a\x82E\xca½]\x9c\xed\x1b\x919\xa8\"\xae\xda\xd8\r\x00i\n\x97\x80\xe0\xf4\xa9wzl\x9b\x8a\xfb\xe0\xacq\x8c\xba\xd1%n\xfe\x10\x95\xaf/h\xdel!\xe2\xadV\"\xdc\b0\xae\xbf^\xb7{\v4\xd5\xf3\xd9\xf3g\xff:\xea\x9b\xf6\xb0\xa5\xbe\au\x87iȥ/\xb6{?P\xe5(\f\xbcq\x81\xcbz\x02\x8a\x1c6\xb7\x00%\x1d<\x9e\"X\xe9(\x97\xc6ĞR\xfc\x19\xb9\x19\x8d^Lg\xa18bǎW\x1a浹;\xa0r\xfe\xd9\xe5\xbd\xd5\xf4\x81\x10\x99\x152}1m3\x14b\x8f\xaah\xa2\xfaɓ`\x88\xa7v%'\x86Fj\x9d}1vp\xc7\xf4\xeaS\x96\x1fuT\xaf>e\x9c\"\xe7Y\xfb\xcc\x02az\xa3pϙ\r\x85\xd8sf\x7f\x13+\xbe\x1e\xa0όLe\xc2\xf3d\x83þ\xb5\x18d\xf3\xb2`B\xade\xaeU:d\x90ޚ\xe7\x12=fX.\xa8!\x10\xc2\x15\x7f:}\x7fyC\xb9IgМ\xc10\x85?\x95\x12\x17\xcf\x1d\xeao,\xf78\xd9\xf2\xe4I\x87\x80=^@Y\xc1\xb0\xa1\xcb=^a1\xa4eQ\xda\xe9s\x9f\xa2\xa44r-\xbe\x10\x83\f\xf3\xd2*k\xf7\x0fह\x16-/e\x80|hI\x86\x17\r\x82\xeb\xf4{\t9ƫ\x855ʼ><\xefO\xfa\b\x92\x10.g\xb5\xba\x9e\x82\x91\xe6\xc2Ѯ\xf5՜>A3Ӄ\xf2\x15\xb6]\x14\xdbg\xf1\xcb\x06\xa6è7\x80\x02\x03i\xefp\xaa;\x10\xf0A\x8f=\xf4\xd5\xfd\xd8ك\x8d\a\xbe\xae\xca$\x81 ߙ\x02\xba{a;!K\x15%e,^$\xa5)D~\xe3\xe7\xf4_L\xf60\xdeU\xff;\x15\x03\xd5\xf3\xcd!S\v\x91OM\xa4\xb3\x1e\"\xcf\xebW+\x1d\xea\x16\x14\xfbR<\xc48s7\xc5ۥ\xeb\nS\xe8\\\xf4\xa6\x0e\x01E[\t\xe3\xb8^\x98\x04 r\xb7e\xea\x97\x06\x97\xc4d\xfc@45\x1e\x87gƙI\x10\xc1\xd6\v\xa2\x03\x82c\xff\v\xabu\x9f\xd8\x02\xcb\xdc\xc9\xd9\xcc\x14l\xdc\xde\xc7\xe1\n&\xa9\xc1\xf8\n3\x02\xd1a\xff\x1da\xa3=\xbc\x7f\x00\x9a\xba\xb4\xe6?\x1fDJ\xf5\xd3[(\xf2\x14\xf20\x86\x9cXl\x10G\x13G5\xa5\xb9\xe7pe[f\xbf\v\x84\x15\"\xfd\xa0\xf3;\x91?\x80\xaa\xfa9\x7fmP\xd7\"\xd3ạ\vy\x85\x85\x18\f\x14\x959\xba@&=\xad\xa3\xae\xe8-\xe0\x80\x1a\x81\xf7\xbeE&\xb7\x83\xdf8\x90\x1d]D\xcd\nЊ\x95\x909\x86\x04k4\xe6\xf5\xd3\t\x84k\x80k\xab\xd6O\x8cg\xd3*\x86\xb1\x05-\xb5eO\x17\xec\xd9\xe4\xd0\xd4a\x9a\xcbq+\x12\xb2\x01\xf6b\xf3\x87\xe6\x93X\x01\xa7N\xc5\xeb\xe7\xb3\xf6_\xe0\xdf\xca\x04\xc9/\xc0ä\xb7\x9b\xa5\xc5\x1b\xcc\x0f\xf4X]˸\xe4I\x8bc\x1b\x14W\x13&\x9cp%\x93\xaecϓ\xfa\xed\x16}2\x9f\x8c5\v\xa1\xbb}\x91U\xba%\x81!\xed\xd21\xbbOl\xa1m\xfb\x05\x8b9w\xeb\xe9F\x7f\x18\x8f;\xa7\a\xe1\xb4\xec(\x9c|\xb7\x12\xad\xa7\x88\x1f/߾\xec\x12\xc3\x1e\x86\xec,\xf2r\xcfB\x9c|\xf1\x7f\x01\x11{Sj\x97yGy\xfa\x06\t\x86wbc\xd37\xb9r\xddA=\x88\\$\xae\xb5\xae`w\xc2&J\xd8\xf7f\x93a\xe1\xee;\xb1'\x92\xd4\xda.\xbe\xe7\x04\x82\xdd7~Q]\x02VH\xb0\xd3C\xf6ٰ\xfbn\xfa\xf6H=\xff\xcfc\xe4\xc0eW\b\xacF\xc4\xe3d\xee\xc4\x06\xa1\n\xa0\x13\xf4\xb5\x92\x19\x84\xfe\xbeV\xb0H\x03\xd6\v\x8fm\xf6\x1e3%\xab\xb5X\x0e\xbaR\xe7\xec\xad.\xf0\x7f\xaf>IS\x98\aZz\xbf\xd4¼\xd5\x05={\x14J\xec\xa2\x0eD\x88}\xd87\x89\x85b\x05OY\xf8\xd5\xf6(\xf9UT\xfb\xdb\t\x99\"\xc3W\nB\xc6\xed\xbc\xea=n\x1cp_\xad\x84>\x83\xa4*=\xf4=@\xfdw\x01ݡR\xe7-|\xed\xf8\xd0\x1e\x98s\xc1\xdc\xe7)\xfek\x17G\xc9\xc1Y\xc2#\x11\xfb6\xbe\x1c\x1e\n/\xc4RF,\x15\xf9\xde\xc1\xab\x19\xe4\xd4\xee\xa3\xdb#I\x0e>\xdb\xdd\x1a\xdd\xff\xef!?\xe0N\xf4\xbf7\xdd\x7f\xbc\x0fx\t\xfbVE\xe2\x9b\x14\\\xef\xeey\xec\xfb\x81^? \x9f\x1e\xc0O\x8b\xae\x1b\x1fu\x8a\x96g\xa0\xec\xff\x838%B\xf9\x7f\x96q\x99\x9b\x19\xbbtu\f\xbd\xdfl>טּ&\xe8\x94g\x00\x0f\x9c\xafy\x02Q\x0f\xc1\xa1\x98H\xc4ΰ\x99^tT \x9ct\x94j@\x88V\xd7)O\xee\xc4\xe6\xc9y\x8b\xf3v\xa5\xcf=\xb9RO\xaa\x1c\xff6\x1fx=c\x9b\x13?\xa1\xbf=\x99u\x94`/ؽ\x8aq\x0fE\xec\xfcS\xe55\xbc\xb1I9\x17\x93!\xb4\xb0\x87\x0eZ4\xf0v\xebk-Bh\x9a\xf8-w\xa8\xfb9\x9e/E\xd1\xf3\xa4\xb7xa\x15\xea\x19\xbbT\x9b\x0e\xd4\xfe\"oo\\\xd5\x14\x95U1\x9b\xca\x1e\x06\xd0& \x97rc\x90m\x82_\xcf\x0eE:\xeed6\xefl\xb9\xe3\xc5>|\xdd4\x1e\x04\xaep\xd1i\x9b\xa4kv\xcfeQ\xf5C\xa8\xd7\b\x91\xb6\x05\x12*\x9d\xcdE\xa4SP\x18\x8f7\xd6^\xfd\xc0e\xf1Z\xe7\xf4\rWHӴћ\xde\xf3\xf3mۛ\xb1T\xaa\xb2\xe8\xda5;)\xc1{\fot\x8cۦ\xfdf\xf9\xcd\xd6\xc3X\x1e\xaf\x03\xd7X\x12g/\xb4Z\xc8\xe5\x1b\x9e\x9d\xbbSۂ\xc8\xd8{\x91\x88\\מFuv\xe7\x9e\t\xa1P\xf22\x11\x860\x99bm\x1b\x1f\xc9\xf68\xed\x80\xf5V\xb9\xeb\x06S\xac\xc4\xe6$\x17\x18\xd8\xd3\x17o\x1bl\x95\xf3L~\x97\xeb2\xeb\xfee\v[\x97\xd7W\xf4\xa07\xff\x96\xf4C\x834,\v\xcc\x05vV!\xb1W\x84P\x14\xb4\t\xaf'\"Z\xfdȾ\x97*\xae\xec\x87=\xf71\x11\x1a\xd1_^_ٕ\xcd\xd8kءj\xe3\xaeҋ\x95\xcc\xe3i\xc6\xf3bC\xbaԜ\xb7V\xe0\xd5g\xdfr\xf7\xc8\x1d\xc6\ue90a\x1f\xc4\x1dm\xc1\xe1\r\xd0Zцm\x8c\x85\xae`\xd7Mxk\x05\x90\x89\xdbC\xa0>\xd3\n<\xea\xb6\xd70%\xdcL\x0e\bH\xee\x11a\xc4\x1c\xd7\xef;\x84\xdb\xda\xdcM\xf5XOX\xb0!\xaf\xe1\xecV2\xf8\xfa}W\x82!\xe2Ō\xe2\x99Y\xa1\xf5\xfaZrW\xe9\xa6\xcb؍\xba\xc8ςXow\x80\xcfD+\x11\x97\x89\xe8\x1b\xfe\xd4\xda\xddm\xe3A\x7f\x84\xa5\x92\xffS\xb6\xc7yy1\xe4\x9eނȚz\xab\n\xecULf\x8d\x88\xbfQ\xd4\xc0\x7f\xc7E\xb4\x1c\\\xe8\xa9\x0e\xcc&@\xc2T\x8a\x0e\xbf\x18\f\xa4\x8aF\x9b\x1c\x17\x8e\xf0\xb2\xcb?.M\xb5\xda\xd9\xe4@r\xbbo\xa8\x93\xbdXk\xe9\x9d\x1e\xaa\xa8E\xaf\x8f\a\x03t7@\x82\xb9\x15\x14\xf9$3\x1e\xcd{ \xe3\xaa@\f.Z:\xea\xf1\xa5\xc8\x12\xbd\x81!h\xce\xd9-&\x81,\xca\xe4V\xf4\x15H\xbe\xe4\"Պ\xfeƮ\xfbR@\x8d\x9f\xebl\xb6\xf5\xeb\x8c]\xf5\xaba\x17\x98S'ES\x11\xb7\xb4\xbcHxf\\\f7\x17\xae\xaf\f7\x98k\x82\xc9+fv \x19\xf7\xf1\xfe\xd4\x1d\xf7V2M/\x93\xdb2\x99\x8bɎCt\x8c\r\x14\x96\x86E<Cf\xb9\x1b\x05a#\x8d\x8d\xae\xf8ܳ\x80;\x87\xc9\xc3\n\xd0M\xef\x91Z\xc1\xfc1\x05O\xb3\xbdD\xf5\xa2\xfb<*5u\x1e;َZ\xd6\x06)8\x03\xbe\xaf\xf4\xe9\x9e\xd7Ã\xe2Y\x03\xb2-\x88%\x974\xd29\xae\xbd\xc5\x1a\xf5\xd7\xcau\x8c\U000b0dcf\b\x89\xf8\x8dx\xa7\x87\x82`'\x99\x1d=k7\x93\xfe\x06\v\xb8ћ\xf6\x94\x9e\x1f \xe9zX\x96\xcat\xcc^\xbcR\x1d\x93\x8b\xefE\x94-\x8a\xf3L\x12[\xe2\xe3+\x89\x80c\xa4<\x8a\\\xb0\xa5Pp\x8dzt\x95s\xe01ʢ\x04t/\x1f=\xda\bM<\xc2U\xbc\x05o\xb9\xc0[\xb6\xaaO\x0f\xe1\x1f\x1e@\xad\xe3\xc1\xf1aW\xb5u#\xb8\xd1j\xef\xf6_7\x9ft1\x19Z\x9a\v\x19r:D7\x91O\xd6!\xf7-\x98$\xe3\xf1\xd5١G\x93\xad\xb8ٯ|\xae\xf1\x84\xd7:.NO<W\xe9\x1dǣ[@\x84*\xd3m\xc0S\xf6V\xdcw~\x87͋\x98\"i}\x9c2eW\xea:\xd7˼\xdb\tq깦C\x05Sv\xcds\xb4|L6\xaf\xfb\xe6\x1eLَ_\xbf\xe0*\x12\xdd?\xecF\xa0[\xd9~\x1c\xba\x87j\x9f\x1c\xe3\x92\xc0j O>\x87\xdbՠ\xd0\x13S\x13\xef\x16\xd8\xfa\x833\xc4\x18\x85\x8f\xbc\xca6H\x9a\xa8g\x8a\xa9X,t^\xd8\b\xc0t\x8a\x1a=+!;PA4\xa4_\xecm>\x93E\x1d\as\xab\"\x19\xc2\xd5\x06*\xc5h\x85\x191\x98Ӈ\x98\x9eT<\x8aJp\xe3SS\xf0D\x04\x19H\xfb|\x13R\xb9\x8e\xbe:\xe6e\a\xcdWͧ\xf7\xdeZUJ|\xb2gN\xa5\x88\x99\xd1l\xc1s\xa7g-\xae\x81)WK\xe6\x10\x13\xb7\xeeM\xba\x1a\xdeg\x02\xb5T\xad\v\x008\x108q\xb0{G\xed\xee\x170\xf8Ge\xceW\xbb\"\x8c-\xfc\xbc\xab\x1e\xf5ȡ\x97\xbb(j\xedn6\xd9Y\xf3\xe5^\x04\x15\xa0\xc3\xc8\x12\xe4\x98\xebr\xb9\xf2\x04\xbdK\x06\xf7\x82Ġ\xba\xea\x8aP|rV\xfa|\xd3|\xf1\xc4lݶ\x84\"l\xa7\xa7A\xbd8*\xc5x1ك\xc7\xdb֣\a\xea\x7fv\xcfͤw\xec\"\xee\xda\xf6k\xee\xf6\a\xbf\x8c\xd2.\x15\x99\x8e\xbd\x94\xd5\xc2\xc6O\x8d\a\xbbL\u05ce\x18\xd5\xfa\xbbo^S\xc3Vm\xa2p!\x954+\x94\xfdr\x89>\xc9\xde\xdcNg\f\x19\xd0Ho\x87d\x83\x89?kz\a\x1d\xf8\xd2\xf4\xe6g\xec&\x97u\xa5\x93^=l\xc2\xd4\n\xaci\xccT\xb9\x05\xf0 jx\xde\xf08\x95ݬ\x12\xba:\x8bpbg\x93\x83.\x12v\x9e\xe1Agߍ\xdd{\x1f`\xefv?\xb8\x87zl6\xf7\xfe\xe3Ym~\x81m\xbb\xad\x03\xd2ry\xa8\xdd\xd6#!\xb6~\x85\x11Ԅ\x83\xf5\xf3\xfa':\x17\x9bm\xe5\xfe\x80\xcb\xc2|-\xe2\x06\xee\xddR\xdcoj\xdf\xc76\xd8q\xb9>\x17\x93*\xac\xe4S\xb0\xb3\xa4\xcc\xd1\x15\x85~\x8c\xb4\xb2\xc1rs\xc1>\xfe<a\x0e\x03\xef\xfd:\xd8ǟ'\xff\x1c\x00\x14\xf6\x92i\x9f\xbf\x01\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\\[o\xe46\xb2~ׯ(\xf8<8\x01\xdc=gp^\x0e\xfam\xd63A\x8cL&\xc6\xd8q\xb0\b\xf2\xc0\x96\xaa\xbb\xb9\x96H\x85\xa4\xdaӻ\xd8\xff\xbe(^tk]\xd8=60\x1b\xb85@b\x89,\x16\xbf*\x16\xab\x8a%%\x8b\xc5\"a%\x7f@\xa5\xb9\x14+`%\xc7/\x06\x05\xfd\xa5\x97\x8f\xff\xaf\x97\\\xbeٿ]\xa3ao\x93G.\xb2\x15\\W\xda\xc8\xe23jY\xa9\x14\xdf\xe3\x86\vn\xb8\x14I\x81\x86ḛU\x02\xc0\x84\x90\x86\xd1mM\x7f\x02\xa4R\x18%\xf3\x1c\xd5b\x8bb\xf9X\xadq]\xf1<CeG\b\xe3\x7fW\x89G!\x9f\xc4\xf7\t@\xaa\xd0R\xb8\xe7\x05jÊr\x05\xa2\xca\xf3\x04@\xb0\x02W\xa0\xd3\x1dfU\x8ez\xb9\xc7\x1c\x95\\r\x99\xe8\x12S\x1ap\xabdU\xae\xa0y\xe0:yf\xdcD\xee|\x7f{+\xe7\xda\xfcԹ\xfd\x91kc\x1f\x95y\xa5X\xde\x1a\xcf\xde\xd5\\l\xab\x9c\xa9\xe6~\x02P*Ԩ\xf6\xf8\xab\x9b\xc5\x0f\x1c\xf3L\xaf`\xc3r\x8d\t\x80Ne\x89+\xf8\xc4\n\xd4%K1K\x00\xf6,癝\xa7\xe3M\x96(\xde\xdd\xde<\xfc\x1f\xb1WX0\xe9v\x86:U\xbc\xb4\xedj\x16\x81k`\xf0`'\t\xcaK\x04̎\x19Phy\x11\x86Z\x94\n\x17\x81\xcb\f\xa4\xf24\x01JT\\f<\x85\xbf\xb1\xf4\xb1*]W\xbd\x93U\x9e\xc1\x1aAUb\xe9ۖJ\x96\xa8\f\x0f\x10\xd2\xd5R\x9c\xfa^\x8f\xd3K\x9a\x8ak\x03\x19\xa9\nj0;\x84\xbd\xbb\x87\x99E\xaf` 7`v\\7|[HZd\x81\x9a0\x01r\xfd\x0fL\xcd\x12\xee\bg\xa5\x03\xb7\xa9\x14{T4\xefTn\x05\xffgMY\x83\x91vȜ\x19ԦC\x91\v\x83J\xb0\x9c\x84P\xe1\x150\x91A\xc1\x0e\xa0\x90ƀJ\xb4\xa8\xd9&z\t?K\x85\xc0\xc5F\xae`gL\xa9Wo\xdel\xb9\tK%\x95EQ\tn\x0eo\xac\xc2\xf3ue\xa4\xd2o2\xdcc\xfeF\xf3킩t\xc7\r\xa6\xa6R\xf8\x86\x95|a\x19\x174Y\xbd,\xb2\xff\tRԗ-Ń\xd4F\x1b\xc5Ŷ\xbem\x95x\x14w\xd2e\xa7\x1e\xae\x9b\x9bb\x03/\x17[\x8b\xca\xe7\x0fw\xf7m\xd5\xe1\xbaE\x12<\xdaM7\xdd\x00O@q\xb1A\xe5\x04\xb7Q\xb2\xb0\x14Qd\xa5\xe4\xc2\xd8?Ҝ\xa3肮\xabu\xc1\rI\xfa\xcf\n\xb5!\xf9,\xe1\xda\x1a\fҹ\xaa̘\xc1l\t7\x02\xaeY\x81\xf95\xd3\xf8\xe2\xb0\x13\xc2zA\x90\xce\x03߶s\xe1G\xfdW\x1e\xad\xfav0F\x83\x12\nk\xf8\xaeĴ\xb34\xa8\x17\xdf\xf0\xd4.\x00\xd8H\xd5,\xf1\x96\xa5\x01\x18_\x97t\x95\xac\xd2\xd8я#\x0enm\x930\x1ejxڡ\xd9Yyb=\x14鐣\xb5\x84w\xfe\xffzD\xa1i\x9cI\xd4@\x824\x8ao\xb7\xa8\x80\x89\x83\xb7-\x1a*ax\x0e\xdc\x10\xc9Jx\xa2=Z\x0eǵ\x9492\xd1y\xa6\xd08yM\xce\xe9shE\x830\xd8*&\xb2\r\xa3Y-\xfc\x7f\xb4\x14\r-(e\xce\xd3C\x8f\"X\xd4\xdb(\\jX\xbbY,\xe1\xaeJS\xd4zS\xe5\xe1\x9e3\x9bL\xa1\xb84\xf0\x88\xa5\x81\xf51IBBn\xc0j>mZ\xc0\x14B\x869:e\xdf@%4\x9a\xab\x9a&=\x96\"?\x846$\x9d.\"t\x99\x1dr\x05\xf7\xf7\x1f\x01\xbf\x94\\\xa1^&\x9d\xe7v\xc7d\xeb\x1cW`T\xd5V\x9c)\xe5\xa1\xeb\x11\xb1|\xcfx~8~\xd4\xc3\xfc\xa7\xd0\xd2\xf6q\xf6]\xe0\x13j\xe3'C\x96\x1bY\xba\x83\x8c\x1d\xae\b\xdb\x01\x8a4\x15NF\xc5\xc1D4\n\xa9\xad=Ga\xa8\xa7\x87y\xc7\xf6\b\xccS^\x82u\x0e\b\xcbA\x9a\\\xc0\xaf\xf7\xd7}L\xe8*\xb8\xe0EU\xac\xe0\x7f\a\x1e:-\xa4\xdda\x8b\xc7\xcc\xd2,\x7f\x94\x95\x8a\x84\xc65\x9d\xc5f'+u&8\xd4\xf5\x9bB\xe7#\xd3&\n\x1bjH+\x95\xc4-\xaab\x8d\x8a\xf0hϭ^b\xd2R~\x01f\x7f\x96\xc2\xec\"e\xe9\xdb\xce\n\xb3\xa0vW\x03\x14\x83i\x99\x90\xa6\xed;!\xceA\xaad-^L\x9c\xbf!>F\x02\xe4\x9a\xce\xe2ss\xf7\v<!>\x9e\tQ\xe8>\x88\xd2 \xc9z!\xbc\x1cJ\x7fG\x16k\x12\\\xd3Y\x94\x0e\xc8\xce5\t\xd4\xf5\x1b1\t\x83\xaeQ\xdbkX%\x13puC\x9dk%\x05\xedt\nu\x13R\x90+K\x9b#9\xfa\xaa\x12\x04h\x8f\"x\x1f\xa4?\xafA\xf7\x8e\xfe\x19,J\x8a\x17&Y\xbb\xf7\x8d\x82\x01\xcb\xeaP8H$DU\xd2\aS \x87\xb9+\x95\xdc\xf3\f\xb3!\aon\x9fN\x99H1?\xbe\xdfc\xf6\xda6k9\xdd\xe4\xb2\x10\x8f^\xe7\xd6\b\xdaȲ$g䝿9@\x93v\"f.5|\xc2'\x90\nnĭ\x92[\x12\x87\xf5O\x80\xd33\x8d\x06Pd\x9a\xd6\x1a\r\xe1\xc6\xc6\f\xca\x1d\xf9\xf2\x03d\xc7\xdd>\xba2ܰ*7\x0f2\xaf\n\xd4\xf7\xf23j\xc3;\x9e\xf5\xe0\x94\xdf\x0fv\x1b\xf0w\x95\x7f`#\xc9\x01\xaa@\xe2#o\x95$i\xd8c\xb3\x9cH\xd0,ϡ\x94\x19\xec\x1d{\xb0>\x04\x86\x87f:\xe9\x8f\xcdC\x81_Ҽ\xca0\xabS\bz\x16\x86\x0fG]l2\x86qA+\x8a\xf2\x1e4\v\xd1<%\t\x0f\x10\x05\xf2\xb2\xacsυ\xa3\x18\xe4;nx\xb9\xc1b\x90É\xb5w\x12NL)v\x18E)$\xa9\xe2A\xaa{\xf8\xd89\xe7)\x12<u\x84lq\xfa\v@\xb4\x93\xf2q\x1e\x96\x1f\xa9U\x13\xfdCjs\x7f\xb0\xc6\x1d\xdbs\xa9t?a\x84_0\xad\xcc@\x84H\xff\x98\x81\x8co6\xa8h\x83\xb2\xa6@\aC9\x0eϔ\xe9\xa3+\bf\xe4qo>\x8dxIP\x16\x83\xb1)\f\xc7[\xe1G\fӾS\x95\xc0E\xc6\xf7<\xabX\x0e\\hC\xb6\xce\u038bռ\r\xcdkF\xf4G\x9c\xbb\xad$\xf0Or\xe9$\x0e\xa4@2\xc7\x05%\xa7\x8e\x9b\xead\x80\xbc\xbfƦ\xbffd\xf0܆\xe5#V\xbb\xc1aF\x1eI\xcb^\f\xfbp=\xe9\xb8\xdcZ\xce֘\x83\xc6\x1cS#\xd5\x18,\xf3B?\xc5\x16\x8e\xe09`\x15\x9b\x8d\x81T\xb2\x99\xe0$Q\xa0=\xe1i\xc7ӝs\xc8H\xa7\xec\x16\xd3\xe4BXY\xe6\x87\xf1\xc9FhB\x9498\xc10ę\x88c\xa4\x83N\x9d\x03tݷ\xb5\x01\x13ε\x8a\xbc\xc2\xccE_'O\xc0\xf9\xe6\xa8\xf3s+4\x01\xccQ\xdbd\x15\x16\xa59\\Q2\xcfߝ\xa7I~R\xc3\xc3_BP笇\x9b~\xdfg^\x0f\xcf \xa5\x9a\x85\xffj!\xd9\xcd\xe6\xce\xef5'\b\xe8c\xbb\xdf\x15\xf0M-\xa0\xec\n6<7t\xf81\x14\xc5u\x7f5\x88\xb3\x92z.X\xe2vM\xba\nf\xd2݇:\x8c\x9em\xdfC\xa8\xdf\x1dx;\x92\xe8n\xf2\xb3\x94\t\xa9?+\xae\xb0p\xc7K\xf7;\xecܱQǻO\xef\x8f\xcf\n\xce\xd4ȣ\xe9\xbc\xeb\xb1\xdc\x1eއ\x01\xf1\x93\xf1\x0eU\x1da\xd9c7}\x05\f\x1e\xf1\xe0\xbc :\xc4,Q1\x1aj4\x90\xe8_\n)\x1fa\x15\x8f(YB\xfeH2\xa2\x7f\xbcj\x84|\xd6`\"k\x16J\xe2\xccgC\x1c\xa6t\xa3N5\x9c\xa0\x13>bp+\x84N\b#\xfbD\x9b\x9bp\x05I\x9c5\xddZ\x8cu\x84D\xda\xf2\x88\x87K:\xde\xcc\xed\t\x9e\xde\xf12\x92\xb63\xc06}\"7\xf5\x81\xf3\x03\x15\b\xd4|\xba\xc8\xe5F\\%\x91$\xe1\x9347\xe2\n>|\xe1\x94\xf7!\xbdy/Q\x7f\x92\xc6\xdey1`\x1d\xfbg\xc1\xea\xbaڥ'\x9c\x99'\xbb\xd2>ǎRz\xf7\xeffcu\xaf\x16\x15\xd7t\xb2,U\xc0\x85\x1e\xba\x01\xa3I:\x96\x8a\x8a\x0e\xb8(\xdc\x17\v\xbb\xd1.\aƊ\xa6\xe9\xc5#UG:m\xf6<\x124l4U\n\xe8\x1ck\xf7\xe4\xcb9\n\xae\xca\"\xa7\xfa\x13\xc8*\v*\x8b\xa6\xa8\x8db\x06\xb7<\x85\x02\xd5\x16\xa1\xa4\xbd V\x1a\xd1\xf6\xf9L\x9d\x8bu\r\xc2\xcf\x1b\xfa\xa3c\xf2\xa1kAf7\xaa]\x10\x7fD\xe3\xd1\xdc\xf8\xd7\xcd\xcdn\xd0֏\x89@\x9be\x99\xad\xdfb\xf9\xedI\xbb\xc4I\xd2\xe9\xac\xef\x16{v\x91C\xc1JZ\xe1\xff\xa2-\xd2*\xfb\xbf\xa1d\\E\xad\xf2w\xb6\x12+\xc7No\x9fuk\x0fDcp\r$\xf1=\xcb\xfbE)\xc3?2\xc7\x020\xb7\xbe\tq\xd8\xf7|\xae\xe0i'5\x92j\xc0\x86\x8a\xbd\"\x88r\r\x17\x8fx\xb8\xb8:\xb2K\x177\xe2¹\b\xfdU\x1fA\xb6\xf68l\xd1\xc0\x85\xed}\xf1u\xeeT\xb4vF6\xa4\xe8o\x95D\xab\t\x85\xc1\xc1\x9b\xa0\xaeu\x8d\x18\x85\xa4\xcb\xe4\x19t\xb3\x94\xc3\xe7\xd4#\f\xddJml:\xad\xeb\xf0\x9e\x96o\xf3z\xe5\xf3l\xc06\x06\x15\x1d\xbd\xa8P\x91EF\xb2\x976&)김\x83\xa9V\xf6Α\xa5\x90\xfb\xa2Y\xdf.\xffq\xe1J\xb5\xe8\xff\xe7(\xa6ԏ\xb6\r\xa4\x94\x1cU\xbd̩M\x94\x85\xef\x80z\x8c^\x9d\xd4d.X\xa2t\xe3\xfc\x06\x15\xe2\xade\xf2|\xae0\xc19ߪ7\xa1\x0f_ZyYFǕ\x98F\xa8\xec\xe9\xdc\xd1E\x85o\xac[\a\x18\xcd\xe8\xb5\xeb\x1b\x96\x98'e\xed\x0fSۊl^\xbc\xffҨ\xf4\xb7\xe3\f\x14\\\xdcX}\x84\xb7/\xe2>@8H\xc3\xf3\u0087\xebл\x11A}c\xf8\xa0x\xecG\xe7\x8fO;Tؑ\xe4qV?V6\xd6m\xa6\xa4j+\xf5A\x94K\x99]j\xd8p\xa5\xeb\x10w\xa0\x1ab좺\xbfY\v\xf2\x15\x12\x97\xe2\x83Rg\x86r\xbf\xb8\xbe\xf5\x84)\xf1\xf9T\xd7]\x8e\x9f\f\x0f\xfd\xec\xf1\x18R\xe6\x88\xd3Ax*+\xaa3\xb6\xd1\f\xdaA\x9c8\xe2\x15\x19b\xf7\xbd\xe6BQ\x15\xb1@,\xac&r1\x93_j\xae\x05\xfc\xc0x\xfeRb4\xbc@Y\x99UT\xe3\x9e\x18\xa9\xbaEV\xa6\xb6\xbf\xa4\xb4\x05\xfbBu=\xc0\n\x12D$U\xa0\x9d\x9d8\xe9\xea\x00<1n|\x81\x92;@\x04#\xa3I\xa6\xb2(\xa9\xe0\x13ָ\xa1\x93\xbaT\n\xcd3\xac\xb7~\xaf\x17\xbd\xba\xf7\xa9\x8b\xc1\x86\xf1\xbcR\xb8|\x19i\x9c\x16!y\xc3\x13\xd16ڵ\x8cgaa7\xa0\xe4\x99ƍ\xdb\tJu\x8aC{\xab\xf0\xb9\xdd\xc7Rq\xd2E9\xe7A\xceP\xb4\xfee׃\xf4*J\x15x#.\xe4\fMj\xf9\xeaB\xbe\xba\x90\xaf.\xe4\xab\v\xf9\xeaB\xbe\xba\x90\xaf.\xe4\xab\v\xf9\xeaB\xf6\\\xc8y\xce\x16\xb6p'\xf9\nn\xa2J\b\xa6\x99\x9d\x1c\xc5W\xc3\\\xe7\x956\xa8\x82\x1b6\xb8/\x0fU\xc2\xf4\xfb\r\x14h\xa7\xae\xc9¾?\x9d%S\xbe[\xfdB\xf0\x1a\xeb2\x1d\x1b\xaf\x85\x85b\x0fe\xe7\xbd㯬\xd3\xe6G\xd5X\xab\xe4\xf4\x02\xaen\rr]<\x15\x8a\x90\x87\xad\x86\x1f\xdaK˽\x98ۮ\x06\xea\xd6a\xf9wbl\x97\xc1mu\xc2ǚ1\x04\x91\x10\x0e\xeb\\`\xe9du\x8a.\xe1\x96a\x8c\x01\xc2\xd0S\x90\x1e|\x8d\xb2}\xab\xe8\x19,~\x93\xea\x11U\x04nM\xdb\xe3W\xf1,\xff\x04\x86}!)\xcc;\x19\xad{!<hQa\x06UI\xfe]Z)\xaa\xf7\xce\x0f\xedw\\i\f\xfb\x9d\x04u\xa9\xc3\xcb\x12ɉ\xee\xdeW\xbc\xad5[\x196^\x0fF\x1c1\xfb\x06\xf8\xfe\xed\xb2\xfb\xc4H_\x1d\x06O\xdc\xec\x06\xa8\x02\xd93\x01\x14L\x8bm\xbbl<\xacT#\au\x8e\n\xbb\x05χ+>X\xde\xf4\xef(#\xfcb\xf9g\xf9\xf2\x1c\xe5\x9a\v\"\xfb\a\xa1ízH\xf6;MՍ\x85=۞B,\x93\x89\xc4ŉǛ\x13+\xf2+*\xc3\xe6\n\xb9N\xa9\ak\xd7zM\x90\x8c\xad\x02\x8b\xcb\a\xccV|\x9dQ\xe7\x15\xea\xb7&\xe9\xc2lu\u05cc\xa1\fW\xc0\xf0\x84i<S\xfd\xd6\tU[\xddj\xac\x19\xba\xa7\xd5jE\xc2\x14S\x97\xd5\x01)\xa6\x1a\xcbW>%q\xb5v\x135X\xa3\xb5U\xc9\xc9U^\xf3\x15U34\xbb\xac<K\x1d\xd5\x19\xd5S3\xf6\xea$\xd9O;\r\xe1\x17\x13\x93L\xd5BET@M\xc6\x13q\x9c\xb6j{\xc6\x18=\xad\xb2)\x02\xc3κ\x88\xafb\xaak\x94F\xc7>\xb5v\xa9[\x994J6\xa6bi\xa4\x1ei\x94\xe6d\x9dRl\x15\xd2(\xf5\xd9\xed{Fs&\x1fk\xc1J\xbd\x93\xe1\x8d\xe0U2#\xe1\xbbn\xfb\x81\xc04\xbc\x0f\x9c\xe6\xb2\xcaj\xfa\xc3ӣW\x02\xc5\x01n\x1flq\xb0}\r2m^\x10\xf5\xdbGp\xe5\x82\x1b\x17\x1e\x0f\xbf\xbf\xfe\f\x81*\x9d\x1b\xb1-~\x94i\xeb\x1bdS\x98t\xdb{/\xc8\x061A\xf8!\x15\xe5k\xb6\x06(R\xd2\xc9ͨO\xae)b\xf0/X7\xd1<q:\xac\x17\x93+ט\xf9\x97\xe3\xe9\xfb9v\"\x94\xad[\xbe\xaf\x94efQ2\xa5\x91\xb0\r\x13tH\xac\x87\x86\xa1\x8b*\x06r)\xb6\xedw\xff\x1b\xfe\x15\x128.\x1bq\xf2,\xdc\xcb\xe5A!\x03\\\xf3*\xfc0ܯ\xe5y\xb7\x84F\x02\x1b\xd5\xdd1JLk\x99r\xfad\x97\x8d{\\\xa5\x82\x0fa\x92\x93\xb6\xb3I\x00\xa66\x84\x91E?\xb4\x8f-\x86>\xb1\xb0\xa8\xbf\xf7\x90\xcc\x10Ն\x99\xaa\xc3\xfe\xe0\xc7*\xeel3HYI\x9fy\xf3\a3.\x10\xb6$l>✯z\xe5L\x1b\xb7pVɄ\xd4?\xd6\xcd\x1a/]\x1b\xab\xdd\xf5ʃ'F_\xa2\x12>\x13\xcdu\xcd\xfd\xe8\xe7\xbdz\x0f6R\x15̬\x80\xbe\u05f6 \xda\xc9\t\x96iT\xd8\xf6\xc5\xf0\xc9\xd9\xddR\x8b01\x9f_p\x9f\x96\b\xaf\x93\x8f\xccd\xe8@cA_\xb28\xba\xf7Aв\xefg\x1a\x17p;\xf4\xfd3w\x94\x81\xd9C\xfd%\xc7ع6\xdf~\xb4\xc5Gzr\xda\ry\u05f8\x97ޢD@Cϝ\x12i\xf8\x8eo\x92\xc1\xb7jR\x9a\xe0\xf7I\xd4\xe2\x1c\xe5\x7flQ\x0e\xac\x9d\xde-\xff\xfd\xc7\x15\xec\xdf6\x7f١\x17\xfe\xeb\x9e\xf6\x01\xb84Q\xd6R!\xbfa\xf9;͂di\x8a\xa5\xf1\xe9\xd3\xf6g>/.:_\xf1\xb4\x7f\xa6R8\xd7P\xaf\xe0\xf7?\xe8˜vs\xf1_\xaa\xd4+\xf8\xfd\x8f\xe4?\x03\x00\xb0\xd1\xe8x\x1cU\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VMo\xe36\x13\xbe\xebW\f\xf6=\xec[\xa0\x92\x11\xf4R\xe8V\xa4-\x10\xb4\r\x82x\x9b\xcbb\x0f45\xb2\xa7\xa1Huf\xe8\xd4\xfd\xf5\x05))\xb6e\xb9I\v\xd4\xf2E\xe4|<\xf3̇\xa6(˲0==!\v\x05_\x83\xe9\t\xffP\xf4\xe9M\xaa\xe7o\xa5\xa2\xb0\xda\xdflP\xcdM\xf1L\xbe\xa9\xe16\x8a\x86\xee\x11%D\xb6\xf8=\xb6\xe4I)\xf8\xa2C5\x8dQS\x17\x00\xc6\xfb\xa0&\x1dKz\x05\xb0\xc1+\a\xe7\x90\xcb-\xfa\xea9np\x13\xc95\xc8\xd9\xc3\xe4\xff\xff\xd1?\xfb\xf0\xe2\xbf*\x00,c\xb6\xf0\x89:\x145]_\x83\x8f\xce\x15\x00\xdetX\x83 \xef\x91E\x8dFa\xfc=\xa2\xa8T{tȡ\xa2PH\x8f6\xf9\xder\x88}\rǋA\x7f\xc45ĴΦ\xd6\xd9\xd4\xe3`*\xdf:\x12\xfd\xe9\x9a\xc4\xcf4J\xf5.\xb2qˀ\xb2\x80\x90\xdfFgxQ\xa4\x00\xe8\x19\xf3ůC\xf0?\x12\xbaFjh\x8d\x13,\x00Ć\x1ek\xb87\x1dJo,6\x05\xc0\xde8j2=C\x1c\xa1G\xff\xdd\xc3\xdd\xd37k\xbb\xc3.\xe7 \x1d7(\x96\xa9\xcfrK1\x00\t\x18\x18\x91\x80\x060֢\b\xd8Ȍ^a@\n\xe4\xdb\xc0]v7\x1a\x060\x9b\x10\x15t\x87\xf0\x94\xa9\x1dc\xabF\x81\x9eC\x8f\xac4\x11\x9d\x9e\x93J{=\x9ba\xfc\x98\x82\x18d\xa0I\xb5\x85\x92}\xa4LS\xf0\u0600\xe4\x00!\xb4\xa0;\x12`\xcc\xecy=G\x97\xfe\xa1\x05\xe3!l~C\xab\xd5\x18\xbd\x80\xecBtM*\xc8=\xb2\x02\xa3\r[O\x7f\xbeZ\x96DCr\xe9\x8cNu0\xfd\xc8+\xb27.\xd1\x1f\xf1k0\xbe\x81\xce\x1c\x801\xf9\x80\xe8O\xace\x11\xa9\xe0\x97\xc0\x98\t\xaca\xa7\xdaK\xbdZmI\xa7\u07b2\xa1\xeb\xa2'=\xacr\x87\xd0&j`Y5\xb8G\xb7\x12ږ\x86\xed\x8e\x14\xadFƕ\xe9\xa9\xcc\xc0}\nV\xaa\xae\xf9\x1f\x8f\x8d(\x1fO\x90\xea!\x15\x8c(\x93߾\x1e\xe7R\xbf\xca{*\xf3\xa1\x1a\x06\xb5!\xc4#\xbd\xe4\xb79\x11\x8f?\xac?\xc1\xe44\xa7\xe0\xc4$\x8cl\x1f\xd5\xe4H|\"\x8a|\x8b\x9c\xb5\xa0\xe5\xd0e\x8b\xe8\x9b>\x90\x1fj\xc9:B\x7fN\xba\xc4MG*S\x95\xa6\xfcTp\x9b'\fl\x10b\xdf\x18Ŧ\x82;\x0f\xb7\xa6Cwk\x04\xffs\xda\x13\xc3R&J\xdf&\xfet0N\xbf\xa4_\x8fl\xbd\x1eO#k1C\vݻ\xeeѦ\x9c%\xe2\x92.\xb5ds\x1b@\x1b\x18̒J\xf5&\x86,\xfd\x8fP\x8c3b\xc01\x9b\x1c\xa1}\x1b\xc7ҨHO\xbf3\x82\xe7G34\x0fIb\xee\xd9Q\x8b\xf6`\x1d\x0e\x06\x86I\x81o\x81H\x0f\xfa\xd8\xcd\xfd\x95p\x8f/\x17g\x0f\x1cҜ̣\x18\xe0\x8d\xfc\x8f߈-M\x1f\xc3k\xd1\f2\xf9\xabs:rOF\xedh\x068z\x9f:2\xf8t<3\n\xe7\x13yvK\x8a\xdd\x05\x8eE$w\xbe\riN\xaaI.\x8d\x0e}\x82cRG\x1f\x03\xa2\vs\xd7r\xba<\x8a\xdeA\xe0\xf0O_\xee\x7f\xa1\x98F\a1.\xf8,3\x96\x85\xe3\xe4\xe9\xe2x\xb1cFd\xd19\xb3qX\x83r\x9ck\x0ez\x86\xd9\x1c\xcen\xfa\xa9\x8c\x8e;N\xf1wi\xb9\x10O\xb5\xff\xb2C\x7f\xad\xc2\xe1\xc5\xc8\xcc\xe2\x89W\xd8\x1c\xae)\u07be\xeek\xf3&\x196\x81\x1a\xd2\xd4-\x95.Xz\a\x11\vY\x1aJua;\xb8 a}*9\xf5\xfeY\xc1O\xcbB\xf5>\xe7\vI\x9d\x1d\x8d\xf6j\xd8\xdf\x1c\xdfr\x0f\x95\xe3.\x9a/\xc6(\x9a\x93\xc8E\x03\x9b\xed\xc4\xc5q\xb6\xa65\xabWl\xee\xe7\x9b\xe8\x87\x0fg+e~\xb5\xc17yŖ\x1a>\x7fI\v\xa1\x06\xc6f\xa4@j\xf8\xfc\xa5\xf8k\x00\xc5\xf1\a\xa8\xca\v\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WKo\xdc6\x10\xbe\xebW\f\x92\x83[ \xd2\"\xe8\xa5Х\b\x9c\x16\b\x9a\x87\x11;\xbe\x049p\xc5\xd1\xeet)R\xe5\f\xe5n\x7f}1\x94\xe4}xכ\xa2\xa8%\xc0\xe0hf\xf8\xcd7\x0fr\x8b\xb2,\v\xd3\xd3=F\xa6\xe0k0=\xe1_\x82^W\\m~\xe6\x8a\xc2bx\xbdD1\xaf\x8b\ry[\xc3ub\t\xddg\xe4\x90b\x83o\xb1%OB\xc1\x17\x1d\x8a\xb1FL]\x00\x18\xef\x83\x18\x15\xb3.\x01\x9a\xe0%\x06\xe70\x96+\xf4\xd5&-q\x99\xc8Y\x8cy\x87y\xff\x1f\x92\xdf\xf8\xf0\xe0\x7f,\x00\x9a\x88\xd9\xc3\x1du\xc8b\xba\xbe\x06\x9f\x9c+\x00\xbc鰆!\xb8\xd4!{\xd3\xf3:\x88\vM\xd6\xe6j@\x871T\x14\n\xee\xb1\xd1\xedW1\xa4\xbe\x86݇\xd1\xc5\x04m\f\xeb>{\xbb\x9d\xbc\xbd\x9f\xbce\x05G,\xbf?\xa3\xf4\x9eX\xb2b\xefR4\xee,\xb2\xac\xc3\xe4WəxN\xab\x00\xe8#2\xc6\x01\xbf\x8c\\\xfcF\xe8,\xd7\xd0\x1a\xc7X\x00p\x13z\xac\xe1\xa3\xe9\x90{Ӡ-\x00\x06\xe3\xc8f0cL\xa1G\xff\xe6\xe6\xdd\xfdO\xb7\xcd\x1a\xbb\x9c\x12\x15[\xe4&R\x9f\xf5\xce\x04\x03\xc4``F\x03\x0fk\x8c\b\xf7\x999`\t\x11y\x02>\xb9\x04\x98#\xe0j\x12\xf51\xf4\x18\x85f\x82\xf5\xd9+\xb2G\xd9\x11\x9e+\x05<\xea\x80ղB\x06Y#\f\xa3\f-p\x0e\x06B\v\xb2&\x86\x88\x99)/\xbbT\xcdOh\xc1x\b\xcb?\xb0\x91\nn\x95\xcd\xc8\xc0된\xd5Z\x1c0\nDl\xc2\xca\xd3ߏ\x9e\x19$\xe4-\x9d\x11d9\xf0H^0z\xe3\x94ꄯ\xc0x\v\x9d\xd9BD\xdd\x03\x92\xdf\xf3\x96U\xb8\x82\x0f!\"\x90oC\rk\x91\x9e\xeb\xc5bE2\xb7U\x13\xba.y\x92\xed\"7\a-\x93\x84\xc8\v\x8b\x03\xba\x05Ӫ4\xb1Y\x93`#)\xe2\xc2\xf4Tf\xe0^\x83媳/\xe3ԃ|\xb5\x87T\xb6Z\x1c,\x91\xfc\xeaQ\x9cK\xfc,\xefZ\xdbc\xdaG\xb31\xc4\x1d\xbd\xe4W\x99\x95Ͽ\xde\xde\xc1\xbciN\xc1\x9eK\x98\xd8ޙ\xf1\x8ex%\x8a|\x8b1[A\x1bC\x97=\xa2\xb7} /y\xd18B\x7fH:\xa7eG\xa2\x99\xfe3!\x8b槂\xeb<\\`\x89\x90zk\x04m\x05\xef<\\\x9b\x0eݵa\xfc\xdfiW\x86\xb9TJ/\x13\xbf?\x13\xe7?\xb5\xaf'\xb6\x1e\xc5\xf3\xa8:\x99\xa1ӝz\xdbcs\xd0(\xea\x83Z\x9a:\xb7\r\x11̞G\x98\xbb\xf8\xb4\xb7\xb9y\xcf5\xf04\xc4[Z\x1d\xca\x00\x8c\xb5\xf9\x000\xee\xe6\x8c\xddYzN\xc4z\x1d|K+-G\r\xa0\x8fa \x8b\xb1\x9cc\x9b0\xa48\x05\x99gcU\x9c\xda\xeb\x88a}\x9b\x88V3i\\\xfd,\x86G5\xddN\f\xf9q\x12\xed\xccsy\xc5n\x9a\x98^\xd0\xdb<\x87\x0f\x1f\t\xb9J\x19-<\x90\xac\xc7\xe2\xdf\x1b\xf4\x00\x979\xd7g\x83ۧ\xc2#\xccwk\x84\rn\xc7\xe1\x88\xc0\xd8D\x14\x9dg\x8cN\xdbR{\xae\x02\xf8\x90X\x14\x94\xd1&\xa7\xa7\x90\xf5\x99l7\xb8=&\xf6B\"\xa7\x93\xf9\x12\xd4+=\xbaf\xa0\x11[\x8c\xe8\xe5d\xdb\xea5!z\x14\xcc\xf7\x10\x1b\x1a\xd6Y\xd9`/\xbc\b\x03Ɓ\xf0a\xf1\x10\xe2\x86\xfc\xaaT\x8a\xcb1\xe9\xbcP \xbcx\x99\xff\x9d\xc0\x03p\xf7\xe9\xed\xa7\x1a\xdeX\vA\xd6\x18!1\xb6\xc9\xcd\x05\xb5w^\xbd\x02m\xf5W\x90\xc8\xferU<\xf1\xf3<\x1f!gǸ\x8b\x9ch3S\xbb\xd5\xf36\xc3Qjn\xc7<\x84\b:\x035\xb9ݔ\xbd\xb1\xebOeoD\xb3\f\xc1\xa19.1\x9d\xa2\x14\xf1\xe0$з\xd4\xc2\xf9\xde\x16\x9a;\xb2.\x9e\x89\xe6fR\xd26\xd6Hf\xa39\xe9\xe3\r\"\xdf'\xcc\n\xab\xe2\xbb\x18=\x05\xbf|t]\\\xc0\xceb$\x1d\xf4\xd6\xf7\x8c\xd8l4Ŷ\x9c\xc6l\x93\xa2\x16\xec\xe4\x11B\xbb\xe7\x13\xc0\xfc\xf71ۯ\r\xe3\xb3\xfc\x9e\xf6}\xa3v3\xe5\x8eZl\xb6\x0eGoJ\xfc\xe1a\xf0\xaf\x0e\x04}ѧ\xee\x18T\to\x06C\xce,\x1d>\xf9\xf2ś3\xdf\xce\xe4\xf7DڎD\xd3M\xb0\x86\xe1\xf5n\x95sZο\t\xf4\x83N\xb08\xa0\xadAb\x1a\x81M\x956Iv\xb5`\x1a\x1d&h?\x1e\xff\x1cx\xf1\xe2\xe0F\x9f\x97M\xf0\xe3I\xc75|\xfd\xa67q\xbd\x0f\xdbiNp\r_\xbf\x15\xff\f\x00\x83\xdcLnR\r\x00\x00"),
 }
 
 var CRDs = crds()
@@ -39,6 +39,7 @@ type podTemplateConfig struct {
 	withSecret                        bool
 	defaultResticMaintenanceFrequency time.Duration
 	plugins                           []string
+	defaultVolumesToRestic            bool
 }
 
 func WithImage(image string) podTemplateOption {
@@ -94,6 +95,12 @@ func WithDefaultResticMaintenanceFrequency(val time.Duration) podTemplateOption
 	}
 }
 
+func WithDefaultVolumesToRestic() podTemplateOption {
+	return func(c *podTemplateConfig) {
+		c.defaultVolumesToRestic = true
+	}
+}
+
 func WithPlugins(plugins []string) podTemplateOption {
 	return func(c *podTemplateConfig) {
 		c.plugins = plugins
@@ -245,6 +252,10 @@ func Deployment(namespace string, opts ...podTemplateOption) *appsv1.Deployment
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--restore-only")
 	}
 
+	if c.defaultVolumesToRestic {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--default-volumes-to-restic")
+	}
+
 	if c.defaultResticMaintenanceFrequency > 0 {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--default-restic-prune-frequency=%v", c.defaultResticMaintenanceFrequency))
 	}
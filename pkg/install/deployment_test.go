@@ -49,4 +49,8 @@ func TestDeployment(t *testing.T) {
 	deploy = Deployment("velero", WithDefaultResticMaintenanceFrequency(24*time.Hour))
 	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
 	assert.Equal(t, "--default-restic-prune-frequency=24h0m0s", deploy.Spec.Template.Spec.Containers[0].Args[1])
+
+	deploy = Deployment("velero", WithDefaultVolumesToRestic())
+	assert.Len(t, deploy.Spec.Template.Spec.Containers[0].Args, 2)
+	assert.Equal(t, "--default-volumes-to-restic", deploy.Spec.Template.Spec.Containers[0].Args[1])
 }
@@ -152,7 +152,8 @@ func BackupStorageLocation(namespace, provider, bucket, prefix string, config ma
 					Prefix: prefix,
 				},
 			},
-			Config: config,
+			Config:  config,
+			Default: true,
 		},
 	}
 }
@@ -217,6 +218,7 @@ type VeleroOptions struct {
 	DefaultResticMaintenanceFrequency time.Duration
 	Plugins                           []string
 	NoDefaultBackupLocation           bool
+	DefaultVolumesToRestic            bool
 }
 
 func AllCRDs() *unstructured.UnstructuredList {
@@ -280,6 +282,10 @@ func AllResources(o *VeleroOptions) (*unstructured.UnstructuredList, error) {
 		deployOpts = append(deployOpts, WithPlugins(o.Plugins))
 	}
 
+	if o.DefaultVolumesToRestic {
+		deployOpts = append(deployOpts, WithDefaultVolumesToRestic())
+	}
+
 	deploy := Deployment(o.Namespace, deployOpts...)
 
 	appendUnstructured(resources, deploy)
@@ -29,6 +29,7 @@ func TestResources(t *testing.T) {
 	assert.Equal(t, "test", bsl.Spec.Provider)
 	assert.Equal(t, "test", bsl.Spec.StorageType.ObjectStorage.Bucket)
 	assert.Equal(t, make(map[string]string), bsl.Spec.Config)
+	assert.True(t, bsl.Spec.Default)
 
 	vsl := VolumeSnapshotLocation("velero", "test", make(map[string]string))
 
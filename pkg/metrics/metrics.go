@@ -46,12 +46,17 @@ const (
 	restoreSuccessTotal           = "restore_success_total"
 	restorePartialFailureTotal    = "restore_partial_failure_total"
 	restoreFailedTotal            = "restore_failed_total"
+	restoreDurationSeconds        = "restore_duration_seconds"
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
 
-	scheduleLabel   = "schedule"
-	backupNameLabel = "backupName"
+	backupStorageLocationAvailable               = "backup_storage_location_available"
+	backupStorageLocationLastValidationTimestamp = "backup_storage_location_last_validation_timestamp"
+
+	scheduleLabel       = "schedule"
+	backupNameLabel     = "backupName"
+	backupLocationLabel = "backupLocation"
 
 	secondsInMinute = 60.0
 )
@@ -197,6 +202,25 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			restoreDurationSeconds: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricNamespace,
+					Name:      restoreDurationSeconds,
+					Help:      "Time taken to complete restore, in seconds",
+					Buckets: []float64{
+						toSeconds(1 * time.Minute),
+						toSeconds(5 * time.Minute),
+						toSeconds(10 * time.Minute),
+						toSeconds(15 * time.Minute),
+						toSeconds(30 * time.Minute),
+						toSeconds(1 * time.Hour),
+						toSeconds(2 * time.Hour),
+						toSeconds(3 * time.Hour),
+						toSeconds(4 * time.Hour),
+					},
+				},
+				[]string{scheduleLabel},
+			),
 			restoreValidationFailedTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
 					Namespace: metricNamespace,
@@ -229,6 +253,22 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupStorageLocationAvailable: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationAvailable,
+					Help:      "Whether a backup storage location was available the last time it was validated (1 if available, 0 if not)",
+				},
+				[]string{backupLocationLabel},
+			),
+			backupStorageLocationLastValidationTimestamp: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      backupStorageLocationLastValidationTimestamp,
+					Help:      "Last time a backup storage location was validated, Unix timestamp in seconds",
+				},
+				[]string{backupLocationLabel},
+			),
 		},
 	}
 }
@@ -408,6 +448,13 @@ func (m *ServerMetrics) RegisterRestoreFailed(backupSchedule string) {
 	}
 }
 
+// RegisterRestoreDuration records the number of seconds a restore took.
+func (m *ServerMetrics) RegisterRestoreDuration(backupSchedule string, seconds float64) {
+	if c, ok := m.metrics[restoreDurationSeconds].(*prometheus.HistogramVec); ok {
+		c.WithLabelValues(backupSchedule).Observe(seconds)
+	}
+}
+
 // RegisterRestoreValidationFailed records a restore that failed validation.
 func (m *ServerMetrics) RegisterRestoreValidationFailed(backupSchedule string) {
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
@@ -435,3 +482,29 @@ func (m *ServerMetrics) RegisterVolumeSnapshotFailures(backupSchedule string, vo
 		c.WithLabelValues(backupSchedule).Add(float64(volumeSnapshotsFailed))
 	}
 }
+
+// SetBackupStorageLocationAvailability records whether a backup storage location
+// was available when it was validated, and when that was.
+func (m *ServerMetrics) SetBackupStorageLocationAvailability(backupLocation string, available bool, validationTime time.Time) {
+	if g, ok := m.metrics[backupStorageLocationAvailable].(*prometheus.GaugeVec); ok {
+		value := 0.0
+		if available {
+			value = 1.0
+		}
+		g.WithLabelValues(backupLocation).Set(value)
+	}
+	if g, ok := m.metrics[backupStorageLocationLastValidationTimestamp].(*prometheus.GaugeVec); ok {
+		g.WithLabelValues(backupLocation).Set(float64(validationTime.Unix()))
+	}
+}
+
+// RemoveBackupStorageLocation removes the metrics of a backup storage location
+// that no longer exists.
+func (m *ServerMetrics) RemoveBackupStorageLocation(backupLocation string) {
+	if g, ok := m.metrics[backupStorageLocationAvailable].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(backupLocation)
+	}
+	if g, ok := m.metrics[backupStorageLocationLastValidationTimestamp].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(backupLocation)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"io/ioutil"
+	"path"
+
+	"github.com/pkg/errors"
+)
+
+// ComputeChecksums returns the SHA-256 checksums of the backup's contents, pod
+// volume backups and volume snapshots files, keyed by file name, so that they can
+// be recorded in the backup's metadata before it's uploaded. Each file is rewound
+// after being read, or buffered in memory if it can't be, so that it can still be
+// uploaded afterwards.
+func ComputeChecksums(info *BackupInfo) (map[string]string, error) {
+	// the layout's prefix doesn't affect file names
+	layout := NewObjectStoreLayout("")
+
+	files := []struct {
+		key  string
+		file *io.Reader
+	}{
+		{key: layout.getBackupContentsKey(info.Name), file: &info.Contents},
+		{key: layout.getPodVolumeBackupsKey(info.Name), file: &info.PodVolumeBackups},
+		{key: layout.getBackupVolumeSnapshotsKey(info.Name), file: &info.VolumeSnapshots},
+	}
+
+	checksums := make(map[string]string)
+	for _, f := range files {
+		if *f.file == nil {
+			continue
+		}
+
+		if _, ok := (*f.file).(io.Seeker); !ok {
+			data, err := ioutil.ReadAll(*f.file)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error reading %s", path.Base(f.key))
+			}
+			*f.file = bytes.NewReader(data)
+		}
+
+		if err := seekToBeginning(*f.file); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, *f.file); err != nil {
+			return nil, errors.Wrapf(err, "error computing checksum of %s", path.Base(f.key))
+		}
+		checksums[path.Base(f.key)] = hex.EncodeToString(hash.Sum(nil))
+
+		if err := seekToBeginning(*f.file); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return checksums, nil
+}
+
+// checksumReader computes the checksum of the data read from a reader and
+// returns an error instead of io.EOF if it doesn't match the expected one.
+type checksumReader struct {
+	io.Reader
+	name     string
+	expected string
+	hash     hash.Hash
+}
+
+func newChecksumReader(r io.Reader, name, expected string) *checksumReader {
+	return &checksumReader{
+		Reader:   r,
+		name:     name,
+		expected: expected,
+		hash:     sha256.New(),
+	}
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.hash.Write(p[:n])
+
+	if err == io.EOF {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != r.expected {
+			return n, errors.Errorf("checksum mismatch for %s: expected %s, got %s", r.name, r.expected, actual)
+		}
+	}
+
+	return n, err
+}
@@ -34,6 +34,20 @@ func (_m *BackupStore) BackupExists(bucket string, backupName string) (bool, err
 	return r0, r1
 }
 
+// CheckWritable provides a mock function with given fields:
+func (_m *BackupStore) CheckWritable() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteBackup provides a mock function with given fields: name
 func (_m *BackupStore) DeleteBackup(name string) error {
 	ret := _m.Called(name)
@@ -226,13 +240,27 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
-// PutRestoreLog provides a mock function with given fields: backup, restore, log
-func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
-	ret := _m.Called(backup, restore, log)
+// PutRestoreLog provides a mock function with given fields: backup, restore, log, encryptionKeyID
+func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader, encryptionKeyID string) error {
+	ret := _m.Called(backup, restore, log, encryptionKeyID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, string) error); ok {
+		r0 = rf(backup, restore, log, encryptionKeyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestorePlan provides a mock function with given fields: backup, restore, plan, encryptionKeyID
+func (_m *BackupStore) PutRestorePlan(backup string, restore string, plan io.Reader, encryptionKeyID string) error {
+	ret := _m.Called(backup, restore, plan, encryptionKeyID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
-		r0 = rf(backup, restore, log)
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, string) error); ok {
+		r0 = rf(backup, restore, plan, encryptionKeyID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -240,13 +268,13 @@ func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reade
 	return r0
 }
 
-// PutRestoreResults provides a mock function with given fields: backup, restore, results
-func (_m *BackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
-	ret := _m.Called(backup, restore, results)
+// PutRestoreResults provides a mock function with given fields: backup, restore, results, encryptionKeyID
+func (_m *BackupStore) PutRestoreResults(backup string, restore string, results io.Reader, encryptionKeyID string) error {
+	ret := _m.Called(backup, restore, results, encryptionKeyID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
-		r0 = rf(backup, restore, results)
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader, string) error); ok {
+		r0 = rf(backup, restore, results, encryptionKeyID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -17,10 +17,12 @@ limitations under the License.
 package persistence
 
 import (
+	"bytes"
 	"compress/gzip"
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"path"
 	"strings"
 	"time"
 
@@ -29,6 +31,8 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+	"github.com/vmware-tanzu/velero/pkg/encryption"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -36,18 +40,26 @@ import (
 
 type BackupInfo struct {
 	Name string
+	// EncryptionKeyID is the ID of the key to encrypt all of the backup's
+	// files except its metadata with. If empty, the files are not encrypted.
+	EncryptionKeyID string
 	Metadata,
 	Contents,
 	Log,
 	PodVolumeBackups,
 	VolumeSnapshots,
-	BackupResourceList io.Reader
+	BackupResourceList,
+	CSIVolumeSnapshots,
+	CSIVolumeSnapshotContents io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
 // Velero backup and restore data in/from a persistent backup store.
 type BackupStore interface {
 	IsValid() error
+	// CheckWritable verifies that objects can be written to, and deleted from,
+	// the backup store by writing and deleting a small probe object.
+	CheckWritable() error
 
 	ListBackups() ([]string, error)
 
@@ -62,8 +74,12 @@ type BackupStore interface {
 
 	DeleteBackup(name string) error
 
-	PutRestoreLog(backup, restore string, log io.Reader) error
-	PutRestoreResults(backup, restore string, results io.Reader) error
+	// PutRestoreLog, PutRestoreResults and PutRestorePlan upload a restore's
+	// files, encrypting them with the key with the given ID, which should be
+	// the restore's backup's key. If the ID is empty, they're not encrypted.
+	PutRestoreLog(backup, restore string, log io.Reader, encryptionKeyID string) error
+	PutRestoreResults(backup, restore string, results io.Reader, encryptionKeyID string) error
+	PutRestorePlan(backup, restore string, plan io.Reader, encryptionKeyID string) error
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
@@ -73,10 +89,11 @@ type BackupStore interface {
 const DownloadURLTTL = 10 * time.Minute
 
 type objectBackupStore struct {
-	objectStore velero.ObjectStore
-	bucket      string
-	layout      *ObjectStoreLayout
-	logger      logrus.FieldLogger
+	objectStore     velero.ObjectStore
+	bucket          string
+	layout          *ObjectStoreLayout
+	credentialStore credentials.FileStore
+	logger          logrus.FieldLogger
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -85,7 +102,11 @@ type ObjectStoreGetter interface {
 	GetObjectStore(provider string) (velero.ObjectStore, error)
 }
 
-func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, logger logrus.FieldLogger) (BackupStore, error) {
+// NewObjectBackupStore returns a BackupStore for the given location. If the location has a
+// credential, the credential is written to a file using credentialStore and the path to the
+// file is passed to the object store plugin in its config. credentialStore is also used to
+// read the keys that encrypted backups are encrypted with.
+func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectStoreGetter ObjectStoreGetter, credentialStore credentials.FileStore, logger logrus.FieldLogger) (BackupStore, error) {
 	if location.Spec.ObjectStorage == nil {
 		return nil, errors.New("backup storage location does not use object storage")
 	}
@@ -116,12 +137,17 @@ func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectSto
 		location.Spec.Config["prefix"] = prefix
 	}
 
+	config, err := credentials.ConfigWithCredentialsFile(location.Spec.Config, location.Spec.Credential, credentialStore)
+	if err != nil {
+		return nil, err
+	}
+
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := objectStore.Init(location.Spec.Config); err != nil {
+	if err := objectStore.Init(config); err != nil {
 		return nil, err
 	}
 
@@ -131,10 +157,11 @@ func NewObjectBackupStore(location *velerov1api.BackupStorageLocation, objectSto
 	}))
 
 	return &objectBackupStore{
-		objectStore: objectStore,
-		bucket:      bucket,
-		layout:      NewObjectStoreLayout(prefix),
-		logger:      log,
+		objectStore:     objectStore,
+		bucket:          bucket,
+		layout:          NewObjectStoreLayout(prefix),
+		credentialStore: credentialStore,
+		logger:          log,
 	}, nil
 }
 
@@ -163,6 +190,20 @@ func (s *objectBackupStore) IsValid() error {
 	return nil
 }
 
+func (s *objectBackupStore) CheckWritable() error {
+	key := s.layout.getProbeKey()
+
+	if err := s.objectStore.PutObject(s.bucket, key, strings.NewReader("velero")); err != nil {
+		return errors.Wrap(err, "error writing probe object")
+	}
+
+	if err := s.objectStore.DeleteObject(s.bucket, key); err != nil {
+		return errors.Wrap(err, "error deleting probe object")
+	}
+
+	return nil
+}
+
 func (s *objectBackupStore) ListBackups() ([]string, error) {
 	prefixes, err := s.objectStore.ListCommonPrefixes(s.bucket, s.layout.subdirs["backups"], "/")
 	if err != nil {
@@ -188,7 +229,12 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	encryptionKey, err := s.getEncryptionKey(info.EncryptionKeyID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.putObject(s.layout.getBackupLogKey(info.Name), info.Log, encryptionKey); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
@@ -201,17 +247,43 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return nil
 	}
 
+	// the metadata file is never encrypted, since it records the ID of
+	// the key that the rest of the backup's files are encrypted with.
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.putObject(s.layout.getBackupContentsKey(info.Name), info.Contents, encryptionKey); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getPodVolumeBackupsKey(info.Name), info.PodVolumeBackups); err != nil {
+	if err := s.putObject(s.layout.getPodVolumeBackupsKey(info.Name), info.PodVolumeBackups, encryptionKey); err != nil {
+		errs := []error{err}
+
+		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
+		errs = append(errs, deleteErr)
+
+		deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
+		errs = append(errs, deleteErr)
+
+		return kerrors.NewAggregate(errs)
+	}
+
+	if err := s.putObject(s.layout.getBackupVolumeSnapshotsKey(info.Name), info.VolumeSnapshots, encryptionKey); err != nil {
+		errs := []error{err}
+
+		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
+		errs = append(errs, deleteErr)
+
+		deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
+		errs = append(errs, deleteErr)
+
+		return kerrors.NewAggregate(errs)
+	}
+
+	if err := s.putObject(s.layout.getBackupResourceListKey(info.Name), info.BackupResourceList, encryptionKey); err != nil {
 		errs := []error{err}
 
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
@@ -223,7 +295,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return kerrors.NewAggregate(errs)
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupVolumeSnapshotsKey(info.Name), info.VolumeSnapshots); err != nil {
+	if err := s.putObject(s.layout.getCSIVolumeSnapshotsKey(info.Name), info.CSIVolumeSnapshots, encryptionKey); err != nil {
 		errs := []error{err}
 
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
@@ -235,7 +307,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return kerrors.NewAggregate(errs)
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupResourceListKey(info.Name), info.BackupResourceList); err != nil {
+	if err := s.putObject(s.layout.getCSIVolumeSnapshotContentsKey(info.Name), info.CSIVolumeSnapshotContents, encryptionKey); err != nil {
 		errs := []error{err}
 
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
@@ -291,8 +363,13 @@ func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Sna
 	}
 	defer res.Close()
 
+	data, err := s.readBackupFile(name, s.layout.getBackupVolumeSnapshotsKey(name), res)
+	if err != nil {
+		return nil, err
+	}
+
 	var volumeSnapshots []*volume.Snapshot
-	if err := decode(res, &volumeSnapshots); err != nil {
+	if err := decode(data, &volumeSnapshots); err != nil {
 		return nil, err
 	}
 
@@ -342,16 +419,97 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 	}
 	defer res.Close()
 
+	data, err := s.readBackupFile(name, s.layout.getPodVolumeBackupsKey(name), res)
+	if err != nil {
+		return nil, err
+	}
+
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
-	if err := decode(res, &podVolumeBackups); err != nil {
+	if err := decode(data, &podVolumeBackups); err != nil {
 		return nil, err
 	}
 
 	return podVolumeBackups, nil
 }
 
+// GetBackupContents returns a reader on the backup's tarball. If the backup has a checksum
+// recorded for it, reading to the end of the tarball returns an error if it doesn't match.
 func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+	key := s.layout.getBackupContentsKey(name)
+
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.openBackupFile(name, key, res)
+	if err != nil {
+		res.Close()
+		return nil, err
+	}
+
+	return &readCloser{Reader: data, Closer: res}, nil
+}
+
+// openBackupFile returns a reader on the data read from r, the contents of the
+// named backup's file with the given key. The data is decrypted if the backup is
+// encrypted, and its checksum is verified once it's read to the end if the backup
+// has one recorded for the file.
+func (s *objectBackupStore) openBackupFile(backupName, key string, r io.Reader) (io.Reader, error) {
+	// backups that don't have a metadata file can't be encrypted or have
+	// checksums, since both are recorded in the metadata file.
+	exists, err := s.objectStore.ObjectExists(s.bucket, s.layout.getBackupMetadataKey(backupName))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exists {
+		return r, nil
+	}
+
+	backup, err := s.GetBackupMetadata(backupName)
+	if err != nil {
+		return nil, err
+	}
+
+	if backup.Status.EncryptionKeyID != "" {
+		encryptionKey, err := encryption.GetKeyFromFileStore(s.credentialStore, backup.Status.EncryptionKeyID)
+		if err != nil {
+			return nil, err
+		}
+
+		if r, err = encryption.NewReader(r, encryptionKey); err != nil {
+			return nil, err
+		}
+	}
+
+	if checksum, ok := backup.Status.Checksums[path.Base(key)]; ok {
+		r = newChecksumReader(r, path.Base(key), checksum)
+	}
+
+	return r, nil
+}
+
+// readBackupFile reads all of the data from r, the contents of the named backup's file
+// with the given key, decrypting it and verifying its checksum as openBackupFile does.
+func (s *objectBackupStore) readBackupFile(backupName, key string, r io.Reader) (io.Reader, error) {
+	data, err := s.openBackupFile(backupName, key, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// read everything up front, since decoding doesn't necessarily read
+	// to the end, which is when the checksum is verified.
+	contents, err := ioutil.ReadAll(data)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return bytes.NewReader(contents), nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
 }
 
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
@@ -396,12 +554,34 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
-func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
+func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader, encryptionKeyID string) error {
+	return s.putRestoreObject(s.layout.getRestoreLogKey(restore), log, encryptionKeyID)
+}
+
+func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader, encryptionKeyID string) error {
+	return s.putRestoreObject(s.layout.getRestoreResultsKey(restore), results, encryptionKeyID)
 }
 
-func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
+func (s *objectBackupStore) PutRestorePlan(backup string, restore string, plan io.Reader, encryptionKeyID string) error {
+	return s.putRestoreObject(s.layout.getRestorePlanKey(restore), plan, encryptionKeyID)
+}
+
+func (s *objectBackupStore) putRestoreObject(key string, file io.Reader, encryptionKeyID string) error {
+	encryptionKey, err := s.getEncryptionKey(encryptionKeyID)
+	if err != nil {
+		return err
+	}
+
+	return s.putObject(key, file, encryptionKey)
+}
+
+// getEncryptionKey returns the key with the given ID, or nil if the ID is empty.
+func (s *objectBackupStore) getEncryptionKey(keyID string) ([]byte, error) {
+	if keyID == "" {
+		return nil, nil
+	}
+
+	return encryption.GetKeyFromFileStore(s.credentialStore, keyID)
 }
 
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
@@ -414,10 +594,16 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeSnapshotsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResults:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestorePlan:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestorePlanKey(target.Name), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
@@ -433,6 +619,26 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
+// putObject uploads file to the given key, encrypting it with encryptionKey
+// if it's non-nil.
+func (s *objectBackupStore) putObject(key string, file io.Reader, encryptionKey []byte) error {
+	if file == nil || encryptionKey == nil {
+		return seekAndPutObject(s.objectStore, s.bucket, key, file)
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	encrypted, err := encryption.NewEncryptingReader(file, encryptionKey)
+	if err != nil {
+		return err
+	}
+	defer encrypted.Close()
+
+	return s.objectStore.PutObject(s.bucket, key, encrypted)
+}
+
 func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
 	if file == nil {
 		return nil
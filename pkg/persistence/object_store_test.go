@@ -23,17 +23,20 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"os"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -158,6 +161,33 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestCheckWritable(t *testing.T) {
+	t.Run("writable backup store leaves no probe object behind", func(t *testing.T) {
+		harness := newObjectBackupStoreTestHarness("foo", "cluster-1")
+
+		require.NoError(t, harness.CheckWritable())
+		assert.Empty(t, harness.objectStore.Data[harness.bucket])
+
+		// the probe object mustn't make the store invalid if it's left behind.
+		require.NoError(t, harness.objectStore.PutObject(harness.bucket, harness.layout.getProbeKey(), bytes.NewReader(nil)))
+		assert.NoError(t, harness.IsValid())
+	})
+
+	t.Run("error writing probe object is returned", func(t *testing.T) {
+		objectStore := new(providermocks.ObjectStore)
+		objectStore.On("PutObject", "foo", "metadata/availability-probe", mock.Anything).Return(errors.New("access denied"))
+
+		store := &objectBackupStore{
+			objectStore: objectStore,
+			bucket:      "foo",
+			layout:      NewObjectStoreLayout(""),
+			logger:      velerotest.NewLogger(),
+		}
+
+		assert.EqualError(t, store.CheckWritable(), "error writing probe object: access denied")
+	})
+}
+
 func TestListBackups(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -379,7 +409,7 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
 	// volumesnapshots file not found should not error
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker("foo"))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker(`{"apiVersion":"velero.io/v1","kind":"Backup","metadata":{"name":"test-backup"}}`))
 	res, err := harness.GetBackupVolumeSnapshots("test-backup")
 	assert.NoError(t, err)
 	assert.Nil(t, res)
@@ -431,6 +461,150 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestPutAndGetEncryptedBackup(t *testing.T) {
+	keyFile, err := ioutil.TempFile("", "encryption-key")
+	require.NoError(t, err)
+	defer os.Remove(keyFile.Name())
+
+	_, err = keyFile.Write(bytes.Repeat([]byte("k"), 32))
+	require.NoError(t, err)
+	require.NoError(t, keyFile.Close())
+
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.credentialStore = velerotest.NewFakeCredentialsFileStore(keyFile.Name(), nil)
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backup.Status.EncryptionKeyID = "key-1"
+	metadata, err := json.Marshal(backup)
+	require.NoError(t, err)
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").Result(),
+	}
+	podVolumeBackupsData := new(bytes.Buffer)
+	gzw := gzip.NewWriter(podVolumeBackupsData)
+	require.NoError(t, json.NewEncoder(gzw).Encode(podVolumeBackups))
+	require.NoError(t, gzw.Close())
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:             "backup-1",
+		EncryptionKeyID:  "key-1",
+		Metadata:         bytes.NewReader(metadata),
+		Contents:         newStringReadSeeker("contents"),
+		Log:              newStringReadSeeker("log"),
+		PodVolumeBackups: podVolumeBackupsData,
+	}))
+
+	// the metadata file is stored as-is and everything else is encrypted
+	assert.Equal(t, metadata, harness.objectStore.Data[harness.bucket]["backups/backup-1/velero-backup.json"])
+	assert.NotContains(t, string(harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1.tar.gz"]), "contents")
+	assert.NotContains(t, string(harness.objectStore.Data[harness.bucket]["backups/backup-1/backup-1-logs.gz"]), "log")
+
+	rc, err := harness.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	res, err := harness.GetPodVolumeBackups("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, podVolumeBackups, res)
+
+	// an encrypted backup can't be read without its key
+	harness.credentialStore = velerotest.NewFakeCredentialsFileStore("", errors.New("secret not found"))
+	_, err = harness.GetBackupContents("backup-1")
+	assert.EqualError(t, err, `error getting encryption key "key-1": secret not found`)
+}
+
+func TestPutEncryptedRestoreFiles(t *testing.T) {
+	keyFile, err := ioutil.TempFile("", "encryption-key")
+	require.NoError(t, err)
+	defer os.Remove(keyFile.Name())
+
+	_, err = keyFile.Write(bytes.Repeat([]byte("k"), 32))
+	require.NoError(t, err)
+	require.NoError(t, keyFile.Close())
+
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.credentialStore = velerotest.NewFakeCredentialsFileStore(keyFile.Name(), nil)
+
+	require.NoError(t, harness.PutRestoreLog("backup-1", "restore-1", newStringReadSeeker("log"), "key-1"))
+	require.NoError(t, harness.PutRestoreResults("backup-1", "restore-1", newStringReadSeeker("results"), "key-1"))
+	require.NoError(t, harness.PutRestorePlan("backup-1", "restore-1", newStringReadSeeker("plan"), "key-1"))
+
+	for objectKey, want := range map[string]string{
+		"restores/restore-1/restore-restore-1-logs.gz":    "log",
+		"restores/restore-1/restore-restore-1-results.gz": "results",
+		"restores/restore-1/restore-restore-1-plan.gz":    "plan",
+	} {
+		data, ok := harness.objectStore.Data[harness.bucket][objectKey]
+		require.True(t, ok, "missing %s", objectKey)
+		assert.NotContains(t, string(data), want)
+	}
+
+	// restore files aren't encrypted when the backup isn't
+	require.NoError(t, harness.PutRestoreLog("backup-1", "restore-2", newStringReadSeeker("log"), ""))
+	assert.Equal(t, "log", string(harness.objectStore.Data[harness.bucket]["restores/restore-2/restore-restore-2-logs.gz"]))
+}
+
+func TestPutAndGetBackupWithChecksums(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").Result(),
+	}
+	podVolumeBackupsData := new(bytes.Buffer)
+	gzw := gzip.NewWriter(podVolumeBackupsData)
+	require.NoError(t, json.NewEncoder(gzw).Encode(podVolumeBackups))
+	require.NoError(t, gzw.Close())
+
+	info := BackupInfo{
+		Name:             "backup-1",
+		Contents:         strings.NewReader("contents"),
+		Log:              strings.NewReader("log"),
+		PodVolumeBackups: podVolumeBackupsData,
+	}
+
+	checksums, err := ComputeChecksums(&info)
+	require.NoError(t, err)
+	assert.Len(t, checksums, 2)
+	assert.Equal(t, "d1b2a59fbea7e20077af9f91b27e95e865061b270be03ff539ab3b73587882e8", checksums["backup-1.tar.gz"])
+	assert.Contains(t, checksums, "backup-1-podvolumebackups.json.gz")
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backup.Status.Checksums = checksums
+	metadata, err := json.Marshal(backup)
+	require.NoError(t, err)
+	info.Metadata = bytes.NewReader(metadata)
+
+	require.NoError(t, harness.PutBackup(info))
+
+	rc, err := harness.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	res, err := harness.GetPodVolumeBackups("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, podVolumeBackups, res)
+
+	// files that have been modified in object storage fail verification
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1.tar.gz", newStringReadSeeker("corrupted")))
+	rc, err = harness.GetBackupContents("backup-1")
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(rc)
+	assert.EqualError(t, err, "checksum mismatch for backup-1.tar.gz: expected d1b2a59fbea7e20077af9f91b27e95e865061b270be03ff539ab3b73587882e8, got 3dbb3963d11aa418de8b61f846c3dbd5af43b40d252842adb823f90936fe6920")
+
+	podVolumeBackupsData = new(bytes.Buffer)
+	gzw = gzip.NewWriter(podVolumeBackupsData)
+	require.NoError(t, json.NewEncoder(gzw).Encode([]*velerov1api.PodVolumeBackup{}))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1-podvolumebackups.json.gz", podVolumeBackupsData))
+	_, err = harness.GetPodVolumeBackups("backup-1")
+	assert.Contains(t, err.Error(), "checksum mismatch for backup-1-podvolumebackups.json.gz")
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -548,6 +722,7 @@ func TestGetDownloadURL(t *testing.T) {
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
 				velerov1api.DownloadTargetKindRestoreLog:     "restores/my-backup/restore-my-backup-logs.gz",
 				velerov1api.DownloadTargetKindRestoreResults: "restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestorePlan:    "restores/my-backup/restore-my-backup-plan.gz",
 			},
 		},
 		{
@@ -557,6 +732,7 @@ func TestGetDownloadURL(t *testing.T) {
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
 				velerov1api.DownloadTargetKindRestoreLog:     "velero-backups/restores/my-backup/restore-my-backup-logs.gz",
 				velerov1api.DownloadTargetKindRestoreResults: "velero-backups/restores/my-backup/restore-my-backup-results.gz",
+				velerov1api.DownloadTargetKindRestorePlan:    "velero-backups/restores/my-backup/restore-my-backup-plan.gz",
 			},
 		},
 		{
@@ -565,6 +741,7 @@ func TestGetDownloadURL(t *testing.T) {
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
 				velerov1api.DownloadTargetKindRestoreLog:     "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-logs.gz",
 				velerov1api.DownloadTargetKindRestoreResults: "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-results.gz",
+				velerov1api.DownloadTargetKindRestorePlan:    "restores/b-cool-20170913154901-20170913154902/restore-b-cool-20170913154901-20170913154902-plan.gz",
 			},
 		},
 	}
@@ -605,6 +782,7 @@ func TestNewObjectBackupStore(t *testing.T) {
 		name              string
 		location          *velerov1api.BackupStorageLocation
 		objectStoreGetter objectStoreGetter
+		credentialStore   credentials.FileStore
 		wantBucket        string
 		wantPrefix        string
 		wantErr           string
@@ -650,11 +828,31 @@ func TestNewObjectBackupStore(t *testing.T) {
 			wantBucket: "bucket",
 			wantPrefix: "prefix/",
 		},
+		{
+			name: "when location has a credential, the credentials file is written successfully",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Credential(builder.ForSecretKeySelector("credential", "key").Result()).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: velerotest.NewFakeCredentialsFileStore("/tmp/credentials/secret-file", nil),
+			wantBucket:      "bucket",
+		},
+		{
+			name: "when location has a credential that can't be written to a file, an error is returned",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").
+				Credential(builder.ForSecretKeySelector("credential", "key").Result()).Result(),
+			objectStoreGetter: objectStoreGetter{
+				"provider-1": newInMemoryObjectStore("bucket"),
+			},
+			credentialStore: velerotest.NewFakeCredentialsFileStore("", errors.New("secret not found")),
+			wantErr:         "error getting location credentials file: secret not found",
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			res, err := NewObjectBackupStore(tc.location, tc.objectStoreGetter, velerotest.NewLogger())
+			res, err := NewObjectBackupStore(tc.location, tc.objectStoreGetter, tc.credentialStore, velerotest.NewLogger())
 			if tc.wantErr != "" {
 				require.Equal(t, tc.wantErr, err.Error())
 			} else {
@@ -46,6 +46,12 @@ type Manager interface {
 	// GetRestoreItemAction returns the restore item action plugin for name.
 	GetRestoreItemAction(name string) (velero.RestoreItemAction, error)
 
+	// GetDeleteItemActions returns all delete item action plugins.
+	GetDeleteItemActions() ([]velero.DeleteItemAction, error)
+
+	// GetDeleteItemAction returns the delete item action plugin for name.
+	GetDeleteItemAction(name string) (velero.DeleteItemAction, error)
+
 	// CleanupClients terminates all of the Manager's running plugin processes.
 	CleanupClients()
 }
@@ -224,3 +230,38 @@ func (m *manager) GetRestoreItemAction(name string) (velero.RestoreItemAction, e
 	r := newRestartableRestoreItemAction(name, restartableProcess)
 	return r, nil
 }
+
+// GetDeleteItemActions returns all delete item actions as restartableDeleteItemActions.
+func (m *manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
+	list := m.registry.List(framework.PluginKindDeleteItemAction)
+
+	actions := make([]velero.DeleteItemAction, 0, len(list))
+
+	for i := range list {
+		id := list[i]
+
+		r, err := m.GetDeleteItemAction(id.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, r)
+	}
+
+	return actions, nil
+}
+
+// GetDeleteItemAction returns a restartableDeleteItemAction for name.
+func (m *manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, error) {
+	// Backwards compatibility with non-namespaced, built-in plugins.
+	if !strings.Contains(name, "/") {
+		name = "velero.io/" + name
+	}
+	restartableProcess, err := m.getRestartableProcess(framework.PluginKindDeleteItemAction, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRestartableDeleteItemAction(name, restartableProcess)
+	return r, nil
+}
@@ -241,6 +241,23 @@ func TestGetRestoreItemAction(t *testing.T) {
 	)
 }
 
+func TestGetDeleteItemAction(t *testing.T) {
+	getPluginTest(t,
+		framework.PluginKindDeleteItemAction,
+		"velero.io/pod",
+		func(m Manager, name string) (interface{}, error) {
+			return m.GetDeleteItemAction(name)
+		},
+		func(name string, sharedPluginProcess RestartableProcess) interface{} {
+			return &restartableDeleteItemAction{
+				key:                 kindAndName{kind: framework.PluginKindDeleteItemAction, name: name},
+				sharedPluginProcess: sharedPluginProcess,
+			}
+		},
+		false,
+	)
+}
+
 func getPluginTest(
 	t *testing.T,
 	kind framework.PluginKind,
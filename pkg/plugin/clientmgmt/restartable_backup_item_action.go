@@ -17,6 +17,8 @@ limitations under the License.
 package clientmgmt
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -87,3 +89,16 @@ func (r *restartableBackupItemAction) Execute(item runtime.Unstructured, backup
 
 	return delegate.Execute(item, backup)
 }
+
+// ExecuteWithContext restarts the plugin's process if needed, then delegates the call.
+func (r *restartableBackupItemAction) ExecuteWithContext(ctx context.Context, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if contextDelegate, ok := delegate.(velero.ContextBackupItemAction); ok {
+		return contextDelegate.ExecuteWithContext(ctx, item, backup)
+	}
+	return delegate.Execute(item, backup)
+}
@@ -76,6 +76,12 @@ func (c *BackupItemActionGRPCClient) AppliesTo() (velero.ResourceSelector, error
 }
 
 func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
+	return c.ExecuteWithContext(context.Background(), item, backup)
+}
+
+// ExecuteWithContext calls the plugin's Execute, canceling the call if ctx is done.
+// The plugin gets a canceled context too if it implements velero.ContextBackupItemAction.
+func (c *BackupItemActionGRPCClient) ExecuteWithContext(ctx context.Context, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, error) {
 	itemJSON, err := json.Marshal(item.UnstructuredContent())
 	if err != nil {
 		return nil, nil, errors.WithStack(err)
@@ -92,7 +98,7 @@ func (c *BackupItemActionGRPCClient) Execute(item runtime.Unstructured, backup *
 		Backup: backupJSON,
 	}
 
-	res, err := c.grpcClient.Execute(context.Background(), req)
+	res, err := c.grpcClient.Execute(ctx, req)
 	if err != nil {
 		return nil, nil, fromGRPCError(err)
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -98,7 +99,15 @@ func (s *BackupItemActionGRPCServer) Execute(ctx context.Context, req *proto.Exe
 		return nil, newGRPCError(errors.WithStack(err))
 	}
 
-	updatedItem, additionalItems, err := impl.Execute(&item, &backup)
+	var (
+		updatedItem     runtime.Unstructured
+		additionalItems []velero.ResourceIdentifier
+	)
+	if contextImpl, ok := impl.(velero.ContextBackupItemAction); ok {
+		updatedItem, additionalItems, err = contextImpl.ExecuteWithContext(ctx, &item, &backup)
+	} else {
+		updatedItem, additionalItems, err = impl.Execute(&item, &backup)
+	}
 	if err != nil {
 		return nil, newGRPCError(err)
 	}
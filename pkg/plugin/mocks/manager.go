@@ -169,3 +169,49 @@ func (_m *Manager) GetRestoreItemActions() ([]velero.RestoreItemAction, error) {
 
 	return r0, r1
 }
+
+// GetDeleteItemAction provides a mock function with given fields: name
+func (_m *Manager) GetDeleteItemAction(name string) (velero.DeleteItemAction, error) {
+	ret := _m.Called(name)
+
+	var r0 velero.DeleteItemAction
+	if rf, ok := ret.Get(0).(func(string) velero.DeleteItemAction); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(velero.DeleteItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeleteItemActions provides a mock function with given fields:
+func (_m *Manager) GetDeleteItemActions() ([]velero.DeleteItemAction, error) {
+	ret := _m.Called()
+
+	var r0 []velero.DeleteItemAction
+	if rf, ok := ret.Get(0).(func() []velero.DeleteItemAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]velero.DeleteItemAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
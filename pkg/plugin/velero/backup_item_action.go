@@ -17,6 +17,8 @@ limitations under the License.
 package velero
 
 import (
+	"context"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -37,6 +39,17 @@ type BackupItemAction interface {
 	Execute(item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []ResourceIdentifier, error)
 }
 
+// ContextBackupItemAction is a BackupItemAction whose Execute can be interrupted.
+// Velero calls ExecuteWithContext instead of Execute for actions that implement it,
+// and cancels ctx if the backup is canceled while the action is running.
+type ContextBackupItemAction interface {
+	BackupItemAction
+
+	// ExecuteWithContext is the same as Execute, but should return promptly
+	// once ctx is done.
+	ExecuteWithContext(ctx context.Context, item runtime.Unstructured, backup *api.Backup) (runtime.Unstructured, []ResourceIdentifier, error)
+}
+
 // ResourceIdentifier describes a single item by its group, resource, namespace, and name.
 type ResourceIdentifier struct {
 	schema.GroupResource
@@ -0,0 +1,270 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"encoding/json"
+	"regexp"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigVersion is the only supported version of the resource modifiers
+// config format.
+const ConfigVersion = "v1"
+
+var validOperations = sets.NewString("add", "remove", "replace", "move", "copy", "test")
+
+// JSONPatch is a single RFC 6902 JSON patch operation.
+type JSONPatch struct {
+	// Operation is one of add, remove, replace, move, copy or test.
+	Operation string `json:"operation"`
+
+	// From is the source location of move and copy operations.
+	From string `json:"from,omitempty"`
+
+	// Path is the location in the object that the operation applies to.
+	Path string `json:"path"`
+
+	// Value is the value used by add, replace and test operations.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Conditions select the objects that a rule applies to. An object must match
+// all of the conditions that are specified.
+type Conditions struct {
+	// GroupResource is the resource of the objects, for example "pods" or
+	// "deployments.apps". Required.
+	GroupResource string `json:"groupResource"`
+
+	// Namespaces is a list of namespaces, after namespace mapping, that the
+	// objects must be restored into. If empty, objects in any namespace and
+	// cluster-scoped objects match.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ResourceNameRegex is a regular expression that the objects' names must match.
+	ResourceNameRegex string `json:"resourceNameRegex,omitempty"`
+
+	// LabelSelector is a label selector that the objects' labels must match.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// ResourceModifierRule is a JSON patch applied to the objects matching its
+// conditions. Any test operations in the patch act as further conditions: if
+// one fails, the rule is skipped for that object.
+type ResourceModifierRule struct {
+	Conditions Conditions  `json:"conditions"`
+	Patches    []JSONPatch `json:"patches"`
+}
+
+// ResourceModifiers is the format of the config stored in a restore's
+// resource modifier ConfigMap.
+type ResourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []ResourceModifierRule `json:"resourceModifierRules"`
+
+	rules []*compiledRule
+}
+
+type compiledRule struct {
+	conditions Conditions
+	nameRegex  *regexp.Regexp
+	selector   labels.Selector
+	tests      jsonpatch.Patch
+	patch      jsonpatch.Patch
+}
+
+// GetResourceModifiersFromConfig parses and validates the resource modifier
+// rules in a ConfigMap. The ConfigMap must have exactly one data entry,
+// containing the rules in YAML or JSON.
+func GetResourceModifiersFromConfig(cm *corev1api.ConfigMap) (*ResourceModifiers, error) {
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("resource modifiers ConfigMap %s/%s must have exactly one data entry, found %d", cm.Namespace, cm.Name, len(cm.Data))
+	}
+
+	var data string
+	for _, v := range cm.Data {
+		data = v
+	}
+
+	modifiers := new(ResourceModifiers)
+	if err := yaml.UnmarshalStrict([]byte(data), modifiers); err != nil {
+		return nil, errors.Wrapf(err, "error parsing resource modifiers ConfigMap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	if err := modifiers.compile(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource modifiers ConfigMap %s/%s", cm.Namespace, cm.Name)
+	}
+
+	return modifiers, nil
+}
+
+// compile validates the rules and prepares them to be applied.
+func (m *ResourceModifiers) compile() error {
+	if m.Version != ConfigVersion {
+		return errors.Errorf("unsupported version %q, must be %q", m.Version, ConfigVersion)
+	}
+
+	for i, rule := range m.ResourceModifierRules {
+		compiled, err := compileRule(rule)
+		if err != nil {
+			return errors.Wrapf(err, "rule %d", i)
+		}
+		m.rules = append(m.rules, compiled)
+	}
+
+	return nil
+}
+
+func compileRule(rule ResourceModifierRule) (*compiledRule, error) {
+	if rule.Conditions.GroupResource == "" {
+		return nil, errors.New("conditions.groupResource is required")
+	}
+	if len(rule.Patches) == 0 {
+		return nil, errors.New("at least one patch is required")
+	}
+
+	compiled := &compiledRule{
+		conditions: rule.Conditions,
+		selector:   labels.Everything(),
+	}
+
+	if rule.Conditions.ResourceNameRegex != "" {
+		nameRegex, err := regexp.Compile(rule.Conditions.ResourceNameRegex)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid conditions.resourceNameRegex")
+		}
+		compiled.nameRegex = nameRegex
+	}
+
+	if rule.Conditions.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(rule.Conditions.LabelSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid conditions.labelSelector")
+		}
+		compiled.selector = selector
+	}
+
+	var tests, ops []map[string]interface{}
+	for j, p := range rule.Patches {
+		if !validOperations.Has(p.Operation) {
+			return nil, errors.Errorf("patch %d: invalid operation %q, must be one of %v", j, p.Operation, validOperations.List())
+		}
+
+		op := map[string]interface{}{
+			"op":   p.Operation,
+			"path": p.Path,
+		}
+		if p.From != "" {
+			op["from"] = p.From
+		}
+		if p.Value != nil {
+			op["value"] = p.Value
+		}
+
+		if p.Operation == "test" {
+			tests = append(tests, op)
+		}
+		ops = append(ops, op)
+	}
+
+	var err error
+	if compiled.tests, err = decodePatch(tests); err != nil {
+		return nil, err
+	}
+	if compiled.patch, err = decodePatch(ops); err != nil {
+		return nil, err
+	}
+
+	return compiled, nil
+}
+
+func decodePatch(ops []map[string]interface{}) (jsonpatch.Patch, error) {
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(ops)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	patch, err := jsonpatch.DecodePatch(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid patches")
+	}
+	return patch, nil
+}
+
+// ApplyResourceModifierRules applies each of the rules matching obj, an object
+// of the given group-resource, to it in order.
+func (m *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) error {
+	for i, rule := range m.rules {
+		if !rule.matches(obj, groupResource) {
+			continue
+		}
+
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if rule.tests != nil {
+			if _, err := rule.tests.Apply(data); err != nil {
+				log.WithError(err).Debugf("Skipping resource modifier rule %d because a test operation failed", i)
+				continue
+			}
+		}
+
+		modified, err := rule.patch.Apply(data)
+		if err != nil {
+			return errors.Wrapf(err, "error applying resource modifier rule %d", i)
+		}
+
+		if err := obj.UnmarshalJSON(modified); err != nil {
+			return errors.Wrapf(err, "error decoding object modified by resource modifier rule %d", i)
+		}
+
+		log.Infof("Applied resource modifier rule %d", i)
+	}
+
+	return nil
+}
+
+func (r *compiledRule) matches(obj *unstructured.Unstructured, groupResource string) bool {
+	if r.conditions.GroupResource != groupResource {
+		return false
+	}
+
+	if len(r.conditions.Namespaces) > 0 && !sets.NewString(r.conditions.Namespaces...).Has(obj.GetNamespace()) {
+		return false
+	}
+
+	if r.nameRegex != nil && !r.nameRegex.MatchString(obj.GetName()) {
+		return false
+	}
+
+	return r.selector.Matches(labels.Set(obj.GetLabels()))
+}
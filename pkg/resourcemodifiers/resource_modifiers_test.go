@@ -0,0 +1,243 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemodifiers
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetResourceModifiersFromConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]string
+		expectedErr string
+	}{
+		{
+			name: "valid rules are parsed",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: deployments.apps
+    resourceNameRegex: "^web-.*$"
+    namespaces: [staging]
+    labelSelector:
+      matchLabels:
+        app: web
+  patches:
+  - operation: replace
+    path: /spec/replicas
+    value: 1
+`},
+		},
+		{
+			name:        "ConfigMap with more than one data entry is invalid",
+			data:        map[string]string{"a.yaml": "version: v1", "b.yaml": "version: v1"},
+			expectedErr: "resource modifiers ConfigMap velero/modifiers must have exactly one data entry, found 2",
+		},
+		{
+			name:        "unknown fields are invalid",
+			data:        map[string]string{"rules.yaml": "version: v1\nrules: []"},
+			expectedErr: `error parsing resource modifiers ConfigMap velero/modifiers: error unmarshaling JSON: while decoding JSON: json: unknown field "rules"`,
+		},
+		{
+			name:        "unsupported version is invalid",
+			data:        map[string]string{"rules.yaml": "version: v2"},
+			expectedErr: `invalid resource modifiers ConfigMap velero/modifiers: unsupported version "v2", must be "v1"`,
+		},
+		{
+			name: "rule without a group-resource is invalid",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- patches:
+  - operation: remove
+    path: /spec/replicas
+`},
+			expectedErr: "invalid resource modifiers ConfigMap velero/modifiers: rule 0: conditions.groupResource is required",
+		},
+		{
+			name: "rule with an invalid name regex is invalid",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+    resourceNameRegex: "["
+  patches:
+  - operation: remove
+    path: /spec/replicas
+`},
+			expectedErr: "invalid resource modifiers ConfigMap velero/modifiers: rule 0: invalid conditions.resourceNameRegex: error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name: "rule with an invalid operation is invalid",
+			data: map[string]string{"rules.yaml": `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: delete
+    path: /spec/replicas
+`},
+			expectedErr: `invalid resource modifiers ConfigMap velero/modifiers: rule 0: patch 0: invalid operation "delete", must be one of [add copy move remove replace test]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := builder.ForConfigMap("velero", "modifiers").Result()
+			cm.Data = tc.data
+
+			res, err := GetResourceModifiersFromConfig(cm)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, res.rules, len(res.ResourceModifierRules))
+		})
+	}
+}
+
+func TestApplyResourceModifierRules(t *testing.T) {
+	rules := `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: deployments.apps
+    resourceNameRegex: "^web-.*$"
+    namespaces: [staging]
+    labelSelector:
+      matchLabels:
+        app: web
+  patches:
+  - operation: test
+    path: /spec/replicas
+    value: 3
+  - operation: replace
+    path: /spec/replicas
+    value: 1
+- conditions:
+    groupResource: deployments.apps
+  patches:
+  - operation: replace
+    path: /spec/template/spec/containers/0/image
+    value: registry.staging.example.com/web:v1
+`
+	cm := builder.ForConfigMap("velero", "modifiers").Data("rules.yaml", rules).Result()
+	modifiers, err := GetResourceModifiersFromConfig(cm)
+	require.NoError(t, err)
+
+	deployment := func(namespace, name string, replicas int) *unstructured.Unstructured {
+		return velerotest.UnstructuredOrDie(`{
+			"apiVersion": "apps/v1",
+			"kind": "Deployment",
+			"metadata": {"namespace": "` + namespace + `", "name": "` + name + `", "labels": {"app": "web"}},
+			"spec": {
+				"replicas": ` + strconv.Itoa(replicas) + `,
+				"template": {"spec": {"containers": [{"name": "web", "image": "registry.example.com/web:v1"}]}}
+			}
+		}`)
+	}
+
+	tests := []struct {
+		name             string
+		obj              *unstructured.Unstructured
+		groupResource    string
+		expectedReplicas int64
+		expectedImage    string
+	}{
+		{
+			name:             "object matching all of the conditions is modified by both rules",
+			obj:              deployment("staging", "web-1", 3),
+			groupResource:    "deployments.apps",
+			expectedReplicas: 1,
+			expectedImage:    "registry.staging.example.com/web:v1",
+		},
+		{
+			name:             "object failing a test operation isn't modified by that rule",
+			obj:              deployment("staging", "web-1", 5),
+			groupResource:    "deployments.apps",
+			expectedReplicas: 5,
+			expectedImage:    "registry.staging.example.com/web:v1",
+		},
+		{
+			name:             "object in another namespace isn't modified by the namespaced rule",
+			obj:              deployment("prod", "web-1", 3),
+			groupResource:    "deployments.apps",
+			expectedReplicas: 3,
+			expectedImage:    "registry.staging.example.com/web:v1",
+		},
+		{
+			name:             "object whose name doesn't match isn't modified by the namespaced rule",
+			obj:              deployment("staging", "api-1", 3),
+			groupResource:    "deployments.apps",
+			expectedReplicas: 3,
+			expectedImage:    "registry.staging.example.com/web:v1",
+		},
+		{
+			name:             "object of another resource isn't modified",
+			obj:              deployment("staging", "web-1", 3),
+			groupResource:    "statefulsets.apps",
+			expectedReplicas: 3,
+			expectedImage:    "registry.example.com/web:v1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, modifiers.ApplyResourceModifierRules(tc.obj, tc.groupResource, velerotest.NewLogger()))
+
+			replicas, _, err := unstructured.NestedInt64(tc.obj.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReplicas, replicas)
+
+			containers, _, err := unstructured.NestedSlice(tc.obj.Object, "spec", "template", "spec", "containers")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedImage, containers[0].(map[string]interface{})["image"])
+		})
+	}
+}
+
+func TestApplyResourceModifierRulesError(t *testing.T) {
+	rules := `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: remove
+    path: /spec/nodeName
+`
+	cm := builder.ForConfigMap("velero", "modifiers").Data("rules.yaml", rules).Result()
+	modifiers, err := GetResourceModifiersFromConfig(cm)
+	require.NoError(t, err)
+
+	pod := velerotest.UnstructuredOrDie(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"namespace": "ns-1", "name": "pod-1"}, "spec": {}}`)
+	err = modifiers.ApplyResourceModifierRules(pod, "pods", velerotest.NewLogger())
+	assert.Error(t, err)
+}
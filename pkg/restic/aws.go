@@ -16,18 +16,27 @@ limitations under the License.
 
 package restic
 
+import (
+	"github.com/vmware-tanzu/velero/pkg/credentials"
+)
+
 const (
-	// AWS specific environment variable
-	awsProfileEnvVar = "AWS_PROFILE"
-	awsProfileKey    = "profile"
+	// AWS specific environment variables
+	awsProfileEnvVar         = "AWS_PROFILE"
+	awsProfileKey            = "profile"
+	awsCredentialsFileEnvVar = "AWS_SHARED_CREDENTIALS_FILE"
 )
 
 // getS3ResticEnvVars gets the environment variables that restic
-// relies on (AWS_PROFILE) based on info in the provided object
-// storage location config map.
+// relies on (AWS_PROFILE, AWS_SHARED_CREDENTIALS_FILE) based on info
+// in the provided object storage location config map.
 func getS3ResticEnvVars(config map[string]string) (map[string]string, error) {
 	result := make(map[string]string)
 
+	if credentialsFile, ok := config[credentials.CredentialsFileKey]; ok {
+		result[awsCredentialsFileEnvVar] = credentialsFile
+	}
+
 	if profile, ok := config[awsProfileKey]; ok {
 		result[awsProfileEnvVar] = profile
 	}
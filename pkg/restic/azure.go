@@ -27,6 +27,8 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 )
 
 const (
@@ -43,20 +45,34 @@ const (
 )
 
 func getStorageAccountKey(config map[string]string) (string, *azure.Environment, error) {
-	// load environment vars from $AZURE_CREDENTIALS_FILE, if it exists
-	if err := loadEnv(); err != nil {
+	// load credentials from the location's credentials file if it has one, otherwise
+	// from $AZURE_CREDENTIALS_FILE, if it exists. Values in the file take precedence
+	// over the environment.
+	credentialsFile := config[credentials.CredentialsFileKey]
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("AZURE_CREDENTIALS_FILE")
+	}
+
+	creds, err := loadCredentials(credentialsFile)
+	if err != nil {
 		return "", nil, err
 	}
+	getValue := func(key string) string {
+		if val, ok := creds[key]; ok {
+			return val
+		}
+		return os.Getenv(key)
+	}
 
 	// 1. we need AZURE_TENANT_ID, AZURE_CLIENT_ID, AZURE_CLIENT_SECRET, AZURE_SUBSCRIPTION_ID
-	envVars, err := getRequiredValues(os.Getenv, tenantIDEnvVar, clientIDEnvVar, clientSecretEnvVar, subscriptionIDEnvVar)
+	envVars, err := getRequiredValues(getValue, tenantIDEnvVar, clientIDEnvVar, clientSecretEnvVar, subscriptionIDEnvVar)
 	if err != nil {
 		return "", nil, errors.Wrap(err, "unable to get all required environment variables")
 	}
 
 	// 2. Get Azure cloud from AZURE_CLOUD_NAME, if it exists. If the env var does not
 	// exist, parseAzureEnvironment will return azure.PublicCloud.
-	env, err := parseAzureEnvironment(os.Getenv(cloudNameEnvVar))
+	env, err := parseAzureEnvironment(getValue(cloudNameEnvVar))
 	if err != nil {
 		return "", nil, errors.Wrap(err, "unable to parse azure cloud name environment variable")
 	}
@@ -129,17 +145,19 @@ func getAzureResticEnvVars(config map[string]string) (map[string]string, error)
 	}, nil
 }
 
-func loadEnv() error {
-	envFile := os.Getenv("AZURE_CREDENTIALS_FILE")
-	if envFile == "" {
-		return nil
+// loadCredentials reads the key-value pairs from the given credentials file, which
+// is in the dotenv format. If the filename is empty, no credentials are returned.
+func loadCredentials(credentialsFile string) (map[string]string, error) {
+	if credentialsFile == "" {
+		return nil, nil
 	}
 
-	if err := godotenv.Overload(envFile); err != nil {
-		return errors.Wrapf(err, "error loading environment from AZURE_CREDENTIALS_FILE (%s)", envFile)
+	creds, err := godotenv.Read(credentialsFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error loading credentials from %s", credentialsFile)
 	}
 
-	return nil
+	return creds, nil
 }
 
 // ParseAzureEnvironment returns an azure.Environment for the given cloud
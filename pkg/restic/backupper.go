@@ -169,7 +169,11 @@ ForEachVolume:
 	for i, count := 0, numVolumeSnapshots; i < count; i++ {
 		select {
 		case <-b.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			if b.ctx.Err() == context.Canceled {
+				errs = append(errs, errors.New("canceled while waiting for all PodVolumeBackups to complete"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
 			switch res.Status.Phase {
@@ -23,11 +23,14 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
@@ -55,6 +58,11 @@ const (
 	podAnnotationPrefix = "snapshot.velero.io/"
 
 	volumesToBackupAnnotation = "backup.velero.io/backup-volumes"
+
+	// volumesToExcludeAnnotation is the annotation on a pod whose mounted volumes
+	// should be excluded from restic backup when restic is used for all volumes
+	// by default.
+	volumesToExcludeAnnotation = "backup.velero.io/backup-volumes-excludes"
 )
 
 // getPodSnapshotAnnotations returns a map, of volume name -> snapshot id,
@@ -123,6 +131,52 @@ func GetVolumesToBackup(obj metav1.Object) []string {
 	return strings.Split(backupsValue, ",")
 }
 
+// getVolumesToExclude returns a list of volume names listed in the provided
+// pod's backup-volumes-excludes annotation.
+func getVolumesToExclude(obj metav1.Object) []string {
+	excludesValue := obj.GetAnnotations()[volumesToExcludeAnnotation]
+	if excludesValue == "" {
+		return nil
+	}
+
+	return strings.Split(excludesValue, ",")
+}
+
+// GetPodVolumesUsingRestic returns a list of volume names to backup with
+// restic for the provided pod. If defaultVolumesToRestic is false, only the
+// volumes listed in the pod's backup-volumes annotation are returned. Otherwise,
+// all of the pod's volumes are returned except for those listed in the pod's
+// backup-volumes-excludes annotation and those whose type is not supported
+// (hostPath, secret, configMap, projected and downwardAPI volumes).
+func GetPodVolumesUsingRestic(pod *corev1api.Pod, defaultVolumesToRestic bool) []string {
+	if !defaultVolumesToRestic {
+		return GetVolumesToBackup(pod)
+	}
+
+	volsToExclude := sets.NewString(getVolumesToExclude(pod)...)
+
+	var podVolumes []string
+	for _, pv := range pod.Spec.Volumes {
+		// hostPath volumes are not supported because they're not mounted into /var/lib/kubelet/pods,
+		// so the restic daemonset can't see them.
+		if pv.HostPath != nil {
+			continue
+		}
+		// secrets, configmaps and the projected/downwardAPI volumes built from them
+		// are restored from their API objects, so there's no need to back up their data.
+		if pv.Secret != nil || pv.ConfigMap != nil || pv.Projected != nil || pv.DownwardAPI != nil {
+			continue
+		}
+		if volsToExclude.Has(pv.Name) {
+			continue
+		}
+
+		podVolumes = append(podVolumes, pv.Name)
+	}
+
+	return podVolumes
+}
+
 // SnapshotIdentifier uniquely identifies a restic snapshot
 // taken by Velero.
 type SnapshotIdentifier struct {
@@ -221,14 +275,20 @@ func NewPodVolumeRestoreListOptions(name string) metav1.ListOptions {
 // AzureCmdEnv returns a list of environment variables (in the format var=val) that
 // should be used when running a restic command for an Azure backend. This list is
 // the current environment, plus the Azure-specific variables restic needs, namely
-// a storage account name and key.
-func AzureCmdEnv(backupLocationLister velerov1listers.BackupStorageLocationLister, namespace, backupLocation string) ([]string, error) {
+// a storage account name and key. If the location has a credential, it's used to
+// get the storage account key.
+func AzureCmdEnv(backupLocationLister velerov1listers.BackupStorageLocationLister, credentialFileStore credentials.FileStore, namespace, backupLocation string) ([]string, error) {
 	loc, err := backupLocationLister.BackupStorageLocations(namespace).Get(backupLocation)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting backup storage location")
 	}
 
-	azureVars, err := getAzureResticEnvVars(loc.Spec.Config)
+	config, err := credentials.ConfigWithCredentialsFile(loc.Spec.Config, loc.Spec.Credential, credentialFileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	azureVars, err := getAzureResticEnvVars(config)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting azure restic env vars")
 	}
@@ -244,14 +304,19 @@ func AzureCmdEnv(backupLocationLister velerov1listers.BackupStorageLocationListe
 // S3CmdEnv returns a list of environment variables (in the format var=val) that
 // should be used when running a restic command for an S3 backend. This list is
 // the current environment, plus the AWS-specific variables restic needs, namely
-// a credential profile.
-func S3CmdEnv(backupLocationLister velerov1listers.BackupStorageLocationLister, namespace, backupLocation string) ([]string, error) {
+// a credential profile and, if the location has a credential, a credentials file.
+func S3CmdEnv(backupLocationLister velerov1listers.BackupStorageLocationLister, credentialFileStore credentials.FileStore, namespace, backupLocation string) ([]string, error) {
 	loc, err := backupLocationLister.BackupStorageLocations(namespace).Get(backupLocation)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting backup storage location")
 	}
 
-	awsVars, err := getS3ResticEnvVars(loc.Spec.Config)
+	config, err := credentials.ConfigWithCredentialsFile(loc.Spec.Config, loc.Spec.Credential, credentialFileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	awsVars, err := getS3ResticEnvVars(config)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting aws restic env vars")
 	}
@@ -171,6 +171,81 @@ func TestGetVolumesToBackup(t *testing.T) {
 	}
 }
 
+func TestGetPodVolumesUsingRestic(t *testing.T) {
+	tests := []struct {
+		name                   string
+		defaultVolumesToRestic bool
+		pod                    *corev1api.Pod
+		expected               []string
+	}{
+		{
+			name:                   "without default volumes to restic, only annotated volumes are returned",
+			defaultVolumesToRestic: false,
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{volumesToBackupAnnotation: "volume-1"},
+				},
+				Spec: corev1api.PodSpec{
+					Volumes: []corev1api.Volume{{Name: "volume-1"}, {Name: "volume-2"}},
+				},
+			},
+			expected: []string{"volume-1"},
+		},
+		{
+			name:                   "with default volumes to restic, all volumes are returned",
+			defaultVolumesToRestic: true,
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{
+					Volumes: []corev1api.Volume{{Name: "volume-1"}, {Name: "volume-2"}},
+				},
+			},
+			expected: []string{"volume-1", "volume-2"},
+		},
+		{
+			name:                   "with default volumes to restic, excluded volumes are not returned",
+			defaultVolumesToRestic: true,
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{volumesToExcludeAnnotation: "volume-2,volume-3"},
+				},
+				Spec: corev1api.PodSpec{
+					Volumes: []corev1api.Volume{{Name: "volume-1"}, {Name: "volume-2"}, {Name: "volume-3"}},
+				},
+			},
+			expected: []string{"volume-1"},
+		},
+		{
+			name:                   "with default volumes to restic, unsupported volume types are not returned",
+			defaultVolumesToRestic: true,
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{
+					Volumes: []corev1api.Volume{
+						{Name: "volume-1"},
+						{Name: "host-path", VolumeSource: corev1api.VolumeSource{HostPath: &corev1api.HostPathVolumeSource{}}},
+						{Name: "secret", VolumeSource: corev1api.VolumeSource{Secret: &corev1api.SecretVolumeSource{}}},
+						{Name: "config-map", VolumeSource: corev1api.VolumeSource{ConfigMap: &corev1api.ConfigMapVolumeSource{}}},
+						{Name: "projected", VolumeSource: corev1api.VolumeSource{Projected: &corev1api.ProjectedVolumeSource{}}},
+						{Name: "downward-api", VolumeSource: corev1api.VolumeSource{DownwardAPI: &corev1api.DownwardAPIVolumeSource{}}},
+					},
+				},
+			},
+			expected: []string{"volume-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := GetPodVolumesUsingRestic(test.pod, test.defaultVolumesToRestic)
+
+			// sort to ensure good compare of slices
+			sort.Strings(test.expected)
+			sort.Strings(res)
+
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
 func TestGetSnapshotsInBackup(t *testing.T) {
 	tests := []struct {
 		name                  string
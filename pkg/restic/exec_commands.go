@@ -18,6 +18,7 @@ package restic
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -75,8 +76,8 @@ func GetSnapshotID(repoIdentifier, passwordFile string, tags map[string]string,
 }
 
 // RunBackup runs a `restic backup` command and watches the output to provide
-// progress updates to the caller.
-func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+// progress updates to the caller. The command is killed if ctx is done.
+func RunBackup(ctx context.Context, backupCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
 	// buffers for copying command stdout/err output into
 	stdoutBuf := new(bytes.Buffer)
 	stderrBuf := new(bytes.Buffer)
@@ -85,7 +86,7 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 	// updates
 	quit := make(chan struct{})
 
-	cmd := backupCmd.Cmd()
+	cmd := backupCmd.CmdContext(ctx)
 	cmd.Stdout = stdoutBuf
 	cmd.Stderr = stderrBuf
 
@@ -122,6 +123,10 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updateFunc func(veler
 	cmd.Wait()
 	quit <- struct{}{}
 
+	if ctx.Err() != nil {
+		return stdoutBuf.String(), stderrBuf.String(), errors.Wrap(ctx.Err(), "restic backup was stopped")
+	}
+
 	summary, err := getSummaryLine(stdoutBuf.Bytes())
 	if err != nil {
 		return stdoutBuf.String(), stderrBuf.String(), err
@@ -182,8 +187,8 @@ func getSummaryLine(b []byte) ([]byte, error) {
 }
 
 // RunRestore runs a `restic restore` command and monitors the volume size to
-// provide progress updates to the caller.
-func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
+// provide progress updates to the caller. The command is killed if ctx is done.
+func RunRestore(ctx context.Context, restoreCmd *Command, log logrus.FieldLogger, updateFunc func(velerov1api.PodVolumeOperationProgress)) (string, string, error) {
 	snapshotSize, err := getSnapshotSize(restoreCmd.RepoIdentifier, restoreCmd.PasswordFile, restoreCmd.Args[0], restoreCmd.Env)
 	if err != nil {
 		return "", "", errors.Wrap(err, "error getting snapshot size")
@@ -218,9 +223,13 @@ func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updateFunc func(vel
 		}
 	}()
 
-	stdout, stderr, err := exec.RunCommand(restoreCmd.Cmd())
+	stdout, stderr, err := exec.RunCommand(restoreCmd.CmdContext(ctx))
 	quit <- struct{}{}
 
+	if ctx.Err() != nil {
+		return stdout, stderr, errors.Wrap(ctx.Err(), "restic restore was stopped")
+	}
+
 	// update progress to 100%
 	updateFunc(velerov1api.PodVolumeOperationProgress{
 		TotalBytes: snapshotSize,
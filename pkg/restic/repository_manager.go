@@ -18,6 +18,7 @@ package restic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
@@ -59,6 +61,10 @@ type RepositoryManager interface {
 	// available snapshots in a repo.
 	Forget(context.Context, SnapshotIdentifier) error
 
+	// SnapshotExists returns whether the specified snapshot
+	// still exists in its repo.
+	SnapshotExists(context.Context, SnapshotIdentifier) (bool, error)
+
 	BackupperFactory
 
 	RestorerFactory
@@ -93,6 +99,7 @@ type repositoryManager struct {
 	ctx                          context.Context
 	pvcClient                    corev1client.PersistentVolumeClaimsGetter
 	pvClient                     corev1client.PersistentVolumesGetter
+	credentialFileStore          credentials.FileStore
 }
 
 // NewRepositoryManager constructs a RepositoryManager.
@@ -106,6 +113,7 @@ func NewRepositoryManager(
 	backupLocationInformer velerov1informers.BackupStorageLocationInformer,
 	pvcClient corev1client.PersistentVolumeClaimsGetter,
 	pvClient corev1client.PersistentVolumesGetter,
+	credentialFileStore credentials.FileStore,
 	log logrus.FieldLogger,
 ) (RepositoryManager, error) {
 	rm := &repositoryManager{
@@ -118,6 +126,7 @@ func NewRepositoryManager(
 		backupLocationInformerSynced: backupLocationInformer.Informer().HasSynced,
 		pvcClient:                    pvcClient,
 		pvClient:                     pvClient,
+		credentialFileStore:          credentialFileStore,
 		log:                          log,
 		ctx:                          ctx,
 
@@ -234,10 +243,48 @@ func (rm *repositoryManager) Forget(ctx context.Context, snapshot SnapshotIdenti
 	return rm.exec(ForgetCommand(repo.Spec.ResticIdentifier, snapshot.SnapshotID), repo.Spec.BackupStorageLocation)
 }
 
+func (rm *repositoryManager) SnapshotExists(ctx context.Context, snapshot SnapshotIdentifier) (bool, error) {
+	if !cache.WaitForCacheSync(ctx.Done(), rm.repoInformerSynced) {
+		return false, errors.New("timed out waiting for cache to sync")
+	}
+
+	repo, err := rm.repoEnsurer.EnsureRepo(ctx, rm.namespace, snapshot.VolumeNamespace, snapshot.BackupStorageLocation)
+	if err != nil {
+		return false, err
+	}
+
+	// restic snapshots requires a non-exclusive lock
+	rm.repoLocker.Lock(repo.Name)
+	defer rm.repoLocker.Unlock(repo.Name)
+
+	snapshotsCmd := SnapshotsCommand(repo.Spec.ResticIdentifier)
+	snapshotsCmd.Args = []string{snapshot.SnapshotID}
+	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--json")
+
+	stdout, err := rm.run(snapshotsCmd, repo.Spec.BackupStorageLocation)
+	if err != nil {
+		return false, err
+	}
+
+	var snapshots []json.RawMessage
+	if err := json.Unmarshal([]byte(stdout), &snapshots); err != nil {
+		return false, errors.Wrap(err, "error decoding restic snapshots output")
+	}
+
+	return len(snapshots) > 0, nil
+}
+
 func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
+	_, err := rm.run(cmd, backupLocation)
+	return err
+}
+
+// run executes the restic command against the repo in the given
+// backup location and returns its stdout.
+func (rm *repositoryManager) run(cmd *Command, backupLocation string) (string, error) {
 	file, err := TempCredentialsFile(rm.secretsLister, rm.namespace, cmd.RepoName(), rm.fileSystem)
 	if err != nil {
-		return err
+		return "", err
 	}
 	// ignore error since there's nothing we can do and it's a temp file.
 	defer os.Remove(file)
@@ -246,22 +293,22 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 
 	if strings.HasPrefix(cmd.RepoIdentifier, "azure") {
 		if !cache.WaitForCacheSync(rm.ctx.Done(), rm.backupLocationInformerSynced) {
-			return errors.New("timed out waiting for cache to sync")
+			return "", errors.New("timed out waiting for cache to sync")
 		}
 
-		env, err := AzureCmdEnv(rm.backupLocationLister, rm.namespace, backupLocation)
+		env, err := AzureCmdEnv(rm.backupLocationLister, rm.credentialFileStore, rm.namespace, backupLocation)
 		if err != nil {
-			return err
+			return "", err
 		}
 		cmd.Env = env
 	} else if strings.HasPrefix(cmd.RepoIdentifier, "s3") {
 		if !cache.WaitForCacheSync(rm.ctx.Done(), rm.backupLocationInformerSynced) {
-			return errors.New("timed out waiting for cache to sync")
+			return "", errors.New("timed out waiting for cache to sync")
 		}
 
-		env, err := S3CmdEnv(rm.backupLocationLister, rm.namespace, backupLocation)
+		env, err := S3CmdEnv(rm.backupLocationLister, rm.credentialFileStore, rm.namespace, backupLocation)
 		if err != nil {
-			return err
+			return "", err
 		}
 		cmd.Env = env
 	}
@@ -274,8 +321,8 @@ func (rm *repositoryManager) exec(cmd *Command, backupLocation string) error {
 		"stderr":     stderr,
 	}).Debugf("Ran restic command")
 	if err != nil {
-		return errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
 	}
 
-	return nil
+	return stdout, nil
 }
@@ -132,7 +132,11 @@ ForEachVolume:
 	for i := 0; i < numRestores; i++ {
 		select {
 		case <-r.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			if r.ctx.Err() == context.Canceled {
+				errs = append(errs, errors.New("canceled while waiting for all PodVolumeRestores to complete"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
 			if res.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// chooseAPIVersionsToRestore determines, for each resource that was backed up at
+// multiple API versions, which of the backed-up versions should be restored into
+// the target cluster. The result is a map from group resource to the name of the
+// version directory within the resource's directory in the backup. Resources for
+// which no backed-up version is served by the target cluster are omitted, so they're
+// restored from their non-versioned path.
+func chooseAPIVersionsToRestore(backupGroups map[string]metav1.APIGroup, clusterGroups []metav1.APIGroup, log logrus.FieldLogger) map[string]string {
+	clusterGroupsByName := make(map[string]metav1.APIGroup, len(clusterGroups))
+	for _, group := range clusterGroups {
+		clusterGroupsByName[group.Name] = group
+	}
+
+	chosen := make(map[string]string)
+	for resource, backupGroup := range backupGroups {
+		log := log.WithField("resource", resource)
+
+		clusterGroup, ok := clusterGroupsByName[backupGroup.Name]
+		if !ok {
+			log.Infof("API group %q is not served by the cluster, not choosing a version to restore", backupGroup.Name)
+			continue
+		}
+
+		version := chooseAPIVersion(backupGroup, clusterGroup)
+		if version == "" {
+			log.Warnf("None of the backed-up API versions of the resource are served by the cluster, restoring it at the version preferred by the backup's cluster")
+			continue
+		}
+
+		versionDir := version
+		if version == backupGroup.PreferredVersion.Version {
+			versionDir += velerov1api.PreferredVersionDir
+		}
+
+		log.Infof("Restoring resource at API version %s", version)
+		chosen[resource] = versionDir
+	}
+
+	return chosen
+}
+
+// chooseAPIVersion returns the version of an API group to restore, given the versions
+// of the group in the backup and the versions served by the target cluster. In order
+// of priority, the chosen version is:
+//  1. the target cluster's preferred version, if it's in the backup
+//  2. the source cluster's preferred version, if it's served by the target cluster
+//  3. the first version served by the target cluster that's in the backup, in the
+//     target cluster's order of preference
+//
+// If none of the backed-up versions are served by the target cluster, an empty string
+// is returned.
+func chooseAPIVersion(backupGroup, clusterGroup metav1.APIGroup) string {
+	backupVersions := sets.NewString()
+	for _, version := range backupGroup.Versions {
+		backupVersions.Insert(version.Version)
+	}
+
+	clusterVersions := sets.NewString()
+	for _, version := range clusterGroup.Versions {
+		clusterVersions.Insert(version.Version)
+	}
+
+	if backupVersions.Has(clusterGroup.PreferredVersion.Version) {
+		return clusterGroup.PreferredVersion.Version
+	}
+
+	if clusterVersions.Has(backupGroup.PreferredVersion.Version) {
+		return backupGroup.PreferredVersion.Version
+	}
+
+	for _, version := range clusterGroup.Versions {
+		if backupVersions.Has(version.Version) {
+			return version.Version
+		}
+	}
+
+	return ""
+}
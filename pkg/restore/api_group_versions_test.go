@@ -0,0 +1,99 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func apiGroup(name, preferredVersion string, versions ...string) metav1.APIGroup {
+	group := metav1.APIGroup{Name: name}
+	for _, version := range versions {
+		group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{
+			GroupVersion: name + "/" + version,
+			Version:      version,
+		})
+	}
+	if preferredVersion != "" {
+		group.PreferredVersion = metav1.GroupVersionForDiscovery{
+			GroupVersion: name + "/" + preferredVersion,
+			Version:      preferredVersion,
+		}
+	}
+	return group
+}
+
+func TestChooseAPIVersionsToRestore(t *testing.T) {
+	tests := []struct {
+		name          string
+		backupGroups  map[string]metav1.APIGroup
+		clusterGroups []metav1.APIGroup
+		want          map[string]string
+	}{
+		{
+			name: "the cluster's preferred version is chosen if it's in the backup",
+			backupGroups: map[string]metav1.APIGroup{
+				"widgets.foo": apiGroup("foo", "v1", "v1", "v2", "v1beta1"),
+			},
+			clusterGroups: []metav1.APIGroup{apiGroup("foo", "v2", "v2", "v1")},
+			want:          map[string]string{"widgets.foo": "v2"},
+		},
+		{
+			name: "the backup's preferred version is chosen if the cluster's preferred version isn't in the backup",
+			backupGroups: map[string]metav1.APIGroup{
+				"widgets.foo": apiGroup("foo", "v1", "v1", "v1beta1"),
+			},
+			clusterGroups: []metav1.APIGroup{apiGroup("foo", "v2", "v2", "v1beta1", "v1")},
+			want:          map[string]string{"widgets.foo": "v1-preferredversion"},
+		},
+		{
+			name: "the cluster's most preferred version in the backup is chosen if neither preferred version is available",
+			backupGroups: map[string]metav1.APIGroup{
+				"widgets.foo": apiGroup("foo", "v1", "v1", "v1beta1", "v1beta2"),
+			},
+			clusterGroups: []metav1.APIGroup{apiGroup("foo", "v2", "v2", "v1beta2", "v1beta1")},
+			want:          map[string]string{"widgets.foo": "v1beta2"},
+		},
+		{
+			name: "no version is chosen if none of the backed-up versions are served",
+			backupGroups: map[string]metav1.APIGroup{
+				"widgets.foo": apiGroup("foo", "v1", "v1"),
+			},
+			clusterGroups: []metav1.APIGroup{apiGroup("foo", "v2", "v2")},
+			want:          map[string]string{},
+		},
+		{
+			name: "no version is chosen if the group isn't served",
+			backupGroups: map[string]metav1.APIGroup{
+				"widgets.foo": apiGroup("foo", "v1", "v1"),
+			},
+			clusterGroups: []metav1.APIGroup{apiGroup("bar", "v1", "v1")},
+			want:          map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, chooseAPIVersionsToRestore(tc.backupGroups, tc.clusterGroups, test.NewLogger()))
+		})
+	}
+}
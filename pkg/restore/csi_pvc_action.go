@@ -0,0 +1,87 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// CSIPVCAction updates a PersistentVolumeClaim that was snapshotted with CSI
+// during backup so that it's dynamically provisioned from its VolumeSnapshot.
+type CSIPVCAction struct {
+	logger logrus.FieldLogger
+}
+
+// NewCSIPVCAction creates a new CSIPVCAction.
+func NewCSIPVCAction(logger logrus.FieldLogger) *CSIPVCAction {
+	return &CSIPVCAction{logger: logger}
+}
+
+func (a *CSIPVCAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"persistentvolumeclaims"},
+	}, nil
+}
+
+// Execute sets the PersistentVolumeClaim's data source to the VolumeSnapshot named
+// in its velero.io/volume-snapshot-name label, and removes its binding to the
+// backed-up PersistentVolume.
+func (a *CSIPVCAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	a.logger.Info("Executing CSIPVCAction")
+	defer a.logger.Info("Done executing CSIPVCAction")
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	snapshotName := obj.GetLabels()[velerov1api.VolumeSnapshotLabel]
+	if snapshotName == "" {
+		a.logger.Debug("Persistent volume claim has no CSI volume snapshot")
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	dataSource := map[string]interface{}{
+		"apiGroup": kuberesource.VolumeSnapshots.Group,
+		"kind":     "VolumeSnapshot",
+		"name":     snapshotName,
+	}
+	if err := unstructured.SetNestedMap(obj.Object, dataSource, "spec", "dataSource"); err != nil {
+		return nil, errors.Wrap(err, "unable to set persistent volume claim data source")
+	}
+
+	unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+	annotations := obj.GetAnnotations()
+	delete(annotations, "pv.kubernetes.io/bind-completed")
+	delete(annotations, "pv.kubernetes.io/bound-by-controller")
+	obj.SetAnnotations(annotations)
+
+	a.logger.Infof("Provisioning persistent volume claim %s/%s from volume snapshot %s", obj.GetNamespace(), obj.GetName(), snapshotName)
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCSIPVCActionExecute(t *testing.T) {
+	tests := []struct {
+		name            string
+		featureDisabled bool
+		labels          []string
+		wantDataSource  map[string]interface{}
+		wantVolumeName  string
+	}{
+		{
+			name:           "PVC without a volume snapshot label is not modified",
+			wantVolumeName: "pv-1",
+		},
+		{
+			name:            "feature flag disabled does not modify the PVC",
+			featureDisabled: true,
+			labels:          []string{velerov1api.VolumeSnapshotLabel, "vs-1"},
+			wantVolumeName:  "pv-1",
+		},
+		{
+			name:   "PVC with a volume snapshot label gets a data source and no volume name",
+			labels: []string{velerov1api.VolumeSnapshotLabel, "vs-1"},
+			wantDataSource: map[string]interface{}{
+				"apiGroup": "snapshot.storage.k8s.io",
+				"kind":     "VolumeSnapshot",
+				"name":     "vs-1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.featureDisabled {
+				features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag)
+				defer features.NewFeatureFlagSet()
+			}
+
+			pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").
+				ObjectMeta(
+					builder.WithLabels(tc.labels...),
+					builder.WithAnnotations("pv.kubernetes.io/bind-completed", "yes"),
+				).
+				VolumeName("pv-1").
+				Result()
+
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+			require.NoError(t, err)
+			item := &unstructured.Unstructured{Object: obj}
+
+			res, err := NewCSIPVCAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+				Item:           item,
+				ItemFromBackup: item.DeepCopy(),
+				Restore:        builder.ForRestore("velero", "restore-1").Result(),
+			})
+			require.NoError(t, err)
+
+			updated := res.UpdatedItem.(*unstructured.Unstructured)
+			dataSource, _, _ := unstructured.NestedMap(updated.Object, "spec", "dataSource")
+			volumeName, _, _ := unstructured.NestedString(updated.Object, "spec", "volumeName")
+
+			assert.Equal(t, tc.wantDataSource, dataSource)
+			assert.Equal(t, tc.wantVolumeName, volumeName)
+			if tc.wantDataSource != nil {
+				assert.NotContains(t, updated.GetAnnotations(), "pv.kubernetes.io/bind-completed")
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/csi"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// CSIVolumeSnapshotAction updates a VolumeSnapshot so that it binds to its
+// restored, pre-provisioned VolumeSnapshotContent instead of taking a new
+// snapshot of its source claim.
+type CSIVolumeSnapshotAction struct {
+	logger logrus.FieldLogger
+}
+
+// NewCSIVolumeSnapshotAction creates a new CSIVolumeSnapshotAction.
+func NewCSIVolumeSnapshotAction(logger logrus.FieldLogger) *CSIVolumeSnapshotAction {
+	return &CSIVolumeSnapshotAction{logger: logger}
+}
+
+func (a *CSIVolumeSnapshotAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"volumesnapshots.snapshot.storage.k8s.io"},
+	}, nil
+}
+
+// Execute replaces the VolumeSnapshot's source with the name of the
+// VolumeSnapshotContent it was bound to when it was backed up.
+func (a *CSIVolumeSnapshotAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	a.logger.Info("Executing CSIVolumeSnapshotAction")
+	defer a.logger.Info("Done executing CSIVolumeSnapshotAction")
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	// use input.ItemFromBackup because status has already been removed from input.Item
+	fromBackup, ok := input.ItemFromBackup.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object from backup was of unexpected type %T", input.ItemFromBackup)
+	}
+
+	contentName := csi.GetBoundVolumeSnapshotContentName(fromBackup)
+	if contentName == "" {
+		return nil, errors.Errorf("volume snapshot %s/%s was not bound to a volume snapshot content when it was backed up", fromBackup.GetNamespace(), fromBackup.GetName())
+	}
+
+	source := map[string]interface{}{
+		"volumeSnapshotContentName": contentName,
+	}
+	if err := unstructured.SetNestedMap(obj.Object, source, "spec", "source"); err != nil {
+		return nil, errors.Wrap(err, "unable to set volume snapshot source")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCSIVolumeSnapshotActionExecute(t *testing.T) {
+	features.NewFeatureFlagSet(velerov1api.CSIFeatureFlag)
+	defer features.NewFeatureFlagSet()
+
+	fromBackup := velerotest.UnstructuredOrDie(`
+	{
+		"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+		"kind": "VolumeSnapshot",
+		"metadata": {"namespace": "ns-1", "name": "vs-1"},
+		"spec": {
+			"volumeSnapshotClassName": "class-1",
+			"source": {"persistentVolumeClaimName": "pvc-1"}
+		},
+		"status": {"boundVolumeSnapshotContentName": "content-1", "readyToUse": true}
+	}`)
+
+	item := fromBackup.DeepCopy()
+	unstructured.RemoveNestedField(item.Object, "status")
+
+	res, err := NewCSIVolumeSnapshotAction(velerotest.NewLogger()).Execute(&velero.RestoreItemActionExecuteInput{
+		Item:           item,
+		ItemFromBackup: fromBackup,
+		Restore:        builder.ForRestore("velero", "restore-1").Result(),
+	})
+	require.NoError(t, err)
+
+	source, _, _ := unstructured.NestedMap(res.UpdatedItem.(*unstructured.Unstructured).Object, "spec", "source")
+	assert.Equal(t, map[string]interface{}{"volumeSnapshotContentName": "content-1"}, source)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/csi"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// CSIVolumeSnapshotContentAction updates a VolumeSnapshotContent so that it's
+// restored as a pre-provisioned content referring to the existing storage snapshot.
+type CSIVolumeSnapshotContentAction struct {
+	logger logrus.FieldLogger
+}
+
+// NewCSIVolumeSnapshotContentAction creates a new CSIVolumeSnapshotContentAction.
+func NewCSIVolumeSnapshotContentAction(logger logrus.FieldLogger) *CSIVolumeSnapshotContentAction {
+	return &CSIVolumeSnapshotContentAction{logger: logger}
+}
+
+func (a *CSIVolumeSnapshotContentAction) AppliesTo() (velero.ResourceSelector, error) {
+	return velero.ResourceSelector{
+		IncludedResources: []string{"volumesnapshotcontents.snapshot.storage.k8s.io"},
+	}, nil
+}
+
+// Execute replaces the VolumeSnapshotContent's source with the handle of the
+// storage snapshot, clears its reference to the backed-up VolumeSnapshot's UID,
+// and sets its deletion policy to Retain so the storage snapshot outlives the
+// restored objects.
+func (a *CSIVolumeSnapshotContentAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+	}
+
+	a.logger.Info("Executing CSIVolumeSnapshotContentAction")
+	defer a.logger.Info("Done executing CSIVolumeSnapshotContentAction")
+
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
+	// use input.ItemFromBackup because status has already been removed from input.Item
+	fromBackup, ok := input.ItemFromBackup.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object from backup was of unexpected type %T", input.ItemFromBackup)
+	}
+
+	snapshotHandle, _, _ := unstructured.NestedString(fromBackup.Object, "status", "snapshotHandle")
+	if snapshotHandle == "" {
+		return nil, errors.Errorf("volume snapshot content %s has no snapshot handle", fromBackup.GetName())
+	}
+
+	source := map[string]interface{}{
+		"snapshotHandle": snapshotHandle,
+	}
+	if err := unstructured.SetNestedMap(obj.Object, source, "spec", "source"); err != nil {
+		return nil, errors.Wrap(err, "unable to set volume snapshot content source")
+	}
+
+	unstructured.RemoveNestedField(obj.Object, "spec", "volumeSnapshotRef", "uid")
+	unstructured.RemoveNestedField(obj.Object, "spec", "volumeSnapshotRef", "resourceVersion")
+
+	// the referenced snapshot is restored into the remapped namespace, if any
+	refNamespace, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeSnapshotRef", "namespace")
+	if targetNamespace, ok := input.Restore.Spec.NamespaceMapping[refNamespace]; ok {
+		if err := unstructured.SetNestedField(obj.Object, targetNamespace, "spec", "volumeSnapshotRef", "namespace"); err != nil {
+			return nil, errors.Wrap(err, "unable to set volume snapshot content reference namespace")
+		}
+	}
+
+	if err := unstructured.SetNestedField(obj.Object, csi.DeletionPolicyRetain, "spec", "deletionPolicy"); err != nil {
+		return nil, errors.Wrap(err, "unable to set volume snapshot content deletion policy")
+	}
+
+	return velero.NewRestoreItemActionExecuteOutput(obj), nil
+}
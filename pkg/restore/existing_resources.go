@@ -0,0 +1,63 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import "sync"
+
+// ExistingResourceAction describes what a restore did with an item that
+// already existed in the cluster.
+type ExistingResourceAction string
+
+const (
+	// ExistingResourceActionUpdated means the in-cluster version was
+	// different from the backed-up version and was updated to match it.
+	ExistingResourceActionUpdated ExistingResourceAction = "updated"
+
+	// ExistingResourceActionUnchanged means the in-cluster version was
+	// the same as the backed-up version, so it was left as-is.
+	ExistingResourceActionUnchanged ExistingResourceAction = "unchanged"
+
+	// ExistingResourceActionUpdateFailed means the in-cluster version was
+	// different from the backed-up version, but couldn't be updated.
+	ExistingResourceActionUpdateFailed ExistingResourceAction = "update-failed"
+)
+
+// ExistingResources records what a restore with the "update" existing
+// resource policy did with each item that already existed in the cluster.
+type ExistingResources struct {
+	// Items maps each item's resource ID, e.g. "configmaps/ns-1/cm-1",
+	// to what was done with it.
+	Items map[string]ExistingResourceAction `json:"items"`
+
+	lock sync.Mutex
+}
+
+// Add records what was done with an item. It's safe to call from multiple
+// goroutines, and a nil *ExistingResources ignores all items.
+func (e *ExistingResources) Add(resourceID string, action ExistingResourceAction) {
+	if e == nil {
+		return
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.Items == nil {
+		e.Items = make(map[string]ExistingResourceAction)
+	}
+	e.Items[resourceID] = action
+}
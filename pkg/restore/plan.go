@@ -0,0 +1,79 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import "sync"
+
+// PlanAction describes what a restore would do with an item.
+type PlanAction string
+
+const (
+	// PlanActionCreate means the item doesn't exist in the cluster
+	// and would be created.
+	PlanActionCreate PlanAction = "create"
+
+	// PlanActionExistsIdentical means the item already exists in the
+	// cluster and is the same as the backed-up version.
+	PlanActionExistsIdentical PlanAction = "exists-identical"
+
+	// PlanActionExistsDifferent means the item already exists in the
+	// cluster and is different from the backed-up version. What the
+	// restore would do with it depends on the restore's existing
+	// resource policy.
+	PlanActionExistsDifferent PlanAction = "exists-different"
+
+	// PlanActionSkippedByAction means a restore item action discarded
+	// the item, so it wouldn't be restored.
+	PlanActionSkippedByAction PlanAction = "skipped-by-action"
+)
+
+// PlanItem is a single item in a restore plan.
+type PlanItem struct {
+	// Resource is the group-resource of the item, e.g. "deployments.apps".
+	Resource string `json:"resource"`
+
+	// Namespace is the namespace the item would be restored into,
+	// after any namespace mapping. It's empty for cluster-scoped items.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name the item would be restored with.
+	Name string `json:"name"`
+
+	// Action is what the restore would do with the item.
+	Action PlanAction `json:"action"`
+}
+
+// Plan is the list of items that a dry-run restore would have
+// restored, along with what it would have done with each of them.
+type Plan struct {
+	Items []PlanItem `json:"items"`
+
+	lock sync.Mutex
+}
+
+// Add appends an item to the plan. It's safe to call from multiple goroutines.
+func (p *Plan) Add(resource, namespace, name string, action PlanAction) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.Items = append(p.Items, PlanItem{
+		Resource:  resource,
+		Namespace: namespace,
+		Name:      name,
+		Action:    action,
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -39,6 +40,7 @@ type pvRestorer struct {
 	volumeSnapshots         []*volume.Snapshot
 	volumeSnapshotterGetter VolumeSnapshotterGetter
 	snapshotLocationLister  listers.VolumeSnapshotLocationLister
+	credentialFileStore     credentials.FileStore
 }
 
 func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -87,7 +89,12 @@ func (r *pvRestorer) executePVAction(obj *unstructured.Unstructured) (*unstructu
 		return nil, errors.WithStack(err)
 	}
 
-	if err := volumeSnapshotter.Init(snapshotInfo.location.Spec.Config); err != nil {
+	config, err := credentials.ConfigWithCredentialsFile(snapshotInfo.location.Spec.Config, snapshotInfo.location.Spec.Credential, r.credentialFileStore)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := volumeSnapshotter.Init(config); err != nil {
 		return nil, errors.WithStack(err)
 	}
 
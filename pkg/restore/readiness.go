@@ -0,0 +1,240 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+// DefaultReadyTimeout is how long a restore waits for its restored
+// items to become ready if the restore doesn't specify a timeout.
+const DefaultReadyTimeout = 10 * time.Minute
+
+// readyPollInterval is how often the restored items are checked for
+// readiness. It's a variable so it can be shortened for testing.
+var readyPollInterval = 5 * time.Second
+
+// unreadyItem identifies a restored item that isn't ready, and why.
+type unreadyItem struct {
+	kind      string
+	namespace string
+	name      string
+	reason    string
+}
+
+// WaitForReady polls the Deployments, StatefulSets, DaemonSets, PersistentVolumeClaims
+// and Pods labeled as created by the named restore until all of them are ready or the
+// timeout elapses or ctx is canceled. It returns a warning for each item that still isn't
+// ready, along with the reason why, and the number of such items.
+func WaitForReady(ctx go_context.Context, kubeClient kubernetes.Interface, restoreName string, timeout time.Duration, log logrus.FieldLogger) (Result, int) {
+	var (
+		warnings Result
+		unready  []unreadyItem
+	)
+
+	opts := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", velerov1api.RestoreNameLabel, label.GetValidName(restoreName)),
+	}
+
+	log.Infof("Waiting up to %s for restored items to become ready", timeout)
+
+	timeoutCtx, cancel := go_context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := wait.PollImmediateUntil(readyPollInterval, func() (bool, error) {
+		var err error
+		unready, err = listUnreadyItems(kubeClient, opts)
+		if err != nil {
+			// keep polling in case the error is transient; if it isn't, it's
+			// reported once the timeout elapses.
+			log.WithError(err).Warn("Error checking readiness of restored items")
+			return false, nil
+		}
+		return len(unready) == 0, nil
+	}, timeoutCtx.Done())
+
+	if err == nil {
+		log.Info("All restored items are ready")
+		return warnings, 0
+	}
+
+	if ctx.Err() != nil {
+		log.Info("Stopped waiting for restored items to become ready because the restore was canceled")
+		warnings.AddVeleroError(errors.New("stopped waiting for restored items to become ready because the restore was canceled"))
+		return warnings, len(unready)
+	}
+
+	if len(unready) == 0 {
+		// every poll failed, so readiness is unknown.
+		warnings.AddVeleroError(errors.New("unable to determine whether restored items are ready"))
+		return warnings, 0
+	}
+
+	for _, item := range unready {
+		log.Warnf("%s %s/%s is not ready: %s", item.kind, item.namespace, item.name, item.reason)
+		warnings.Add(item.namespace, errors.Errorf("%s %s is not ready after %s: %s", item.kind, item.name, timeout, item.reason))
+	}
+
+	return warnings, len(unready)
+}
+
+// listUnreadyItems returns the items matching the list options that aren't ready.
+func listUnreadyItems(kubeClient kubernetes.Interface, opts metav1.ListOptions) ([]unreadyItem, error) {
+	var res []unreadyItem
+
+	deployments, err := kubeClient.AppsV1().Deployments(metav1.NamespaceAll).List(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing deployments")
+	}
+	for i := range deployments.Items {
+		if reason := deploymentNotReadyReason(&deployments.Items[i]); reason != "" {
+			res = append(res, unreadyItem{"Deployment", deployments.Items[i].Namespace, deployments.Items[i].Name, reason})
+		}
+	}
+
+	statefulSets, err := kubeClient.AppsV1().StatefulSets(metav1.NamespaceAll).List(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing statefulsets")
+	}
+	for i := range statefulSets.Items {
+		if reason := statefulSetNotReadyReason(&statefulSets.Items[i]); reason != "" {
+			res = append(res, unreadyItem{"StatefulSet", statefulSets.Items[i].Namespace, statefulSets.Items[i].Name, reason})
+		}
+	}
+
+	daemonSets, err := kubeClient.AppsV1().DaemonSets(metav1.NamespaceAll).List(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing daemonsets")
+	}
+	for i := range daemonSets.Items {
+		if reason := daemonSetNotReadyReason(&daemonSets.Items[i]); reason != "" {
+			res = append(res, unreadyItem{"DaemonSet", daemonSets.Items[i].Namespace, daemonSets.Items[i].Name, reason})
+		}
+	}
+
+	pvcs, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing persistentvolumeclaims")
+	}
+	for i := range pvcs.Items {
+		if pvcs.Items[i].Status.Phase != corev1api.ClaimBound {
+			res = append(res, unreadyItem{"PersistentVolumeClaim", pvcs.Items[i].Namespace, pvcs.Items[i].Name, fmt.Sprintf("phase is %q", pvcs.Items[i].Status.Phase)})
+		}
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing pods")
+	}
+	for i := range pods.Items {
+		if reason := podNotReadyReason(&pods.Items[i]); reason != "" {
+			res = append(res, unreadyItem{"Pod", pods.Items[i].Namespace, pods.Items[i].Name, reason})
+		}
+	}
+
+	return res, nil
+}
+
+// replicasOrDefault returns the value of a workload's replicas field, which
+// defaults to 1 if unset.
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// deploymentNotReadyReason returns why the deployment isn't ready, or an empty
+// string if it's ready.
+func deploymentNotReadyReason(deployment *appsv1api.Deployment) string {
+	replicas := replicasOrDefault(deployment.Spec.Replicas)
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return "the deployment controller hasn't observed the latest spec"
+	}
+	if deployment.Status.UpdatedReplicas < replicas {
+		return fmt.Sprintf("%d of %d replicas updated", deployment.Status.UpdatedReplicas, replicas)
+	}
+	if deployment.Status.AvailableReplicas < replicas {
+		return fmt.Sprintf("%d of %d replicas available", deployment.Status.AvailableReplicas, replicas)
+	}
+	return ""
+}
+
+// statefulSetNotReadyReason returns why the statefulset isn't ready, or an empty
+// string if it's ready.
+func statefulSetNotReadyReason(statefulSet *appsv1api.StatefulSet) string {
+	replicas := replicasOrDefault(statefulSet.Spec.Replicas)
+
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return "the statefulset controller hasn't observed the latest spec"
+	}
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return fmt.Sprintf("%d of %d replicas ready", statefulSet.Status.ReadyReplicas, replicas)
+	}
+	return ""
+}
+
+// daemonSetNotReadyReason returns why the daemonset isn't ready, or an empty
+// string if it's ready.
+func daemonSetNotReadyReason(daemonSet *appsv1api.DaemonSet) string {
+	if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+		return "the daemonset controller hasn't observed the latest spec"
+	}
+	if daemonSet.Status.NumberReady < daemonSet.Status.DesiredNumberScheduled {
+		return fmt.Sprintf("%d of %d pods ready", daemonSet.Status.NumberReady, daemonSet.Status.DesiredNumberScheduled)
+	}
+	return ""
+}
+
+// podNotReadyReason returns why the pod isn't ready, or an empty string if it's
+// ready. Pods that have run to completion are considered ready.
+func podNotReadyReason(pod *corev1api.Pod) string {
+	switch pod.Status.Phase {
+	case corev1api.PodSucceeded:
+		return ""
+	case corev1api.PodFailed:
+		return "pod has failed"
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != corev1api.PodReady {
+			continue
+		}
+		if condition.Status == corev1api.ConditionTrue {
+			return ""
+		}
+		if condition.Message != "" {
+			return condition.Message
+		}
+	}
+
+	return fmt.Sprintf("pod is not ready (phase %q)", pod.Status.Phase)
+}
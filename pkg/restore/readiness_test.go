@@ -0,0 +1,157 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestWaitForReady(t *testing.T) {
+	defer func(interval time.Duration) { readyPollInterval = interval }(readyPollInterval)
+	readyPollInterval = time.Millisecond
+
+	restoreLabel := builder.WithLabels(velerov1api.RestoreNameLabel, "restore-1")
+
+	readyDeployment := builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(restoreLabel).Result()
+	readyDeployment.Status = appsv1api.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1}
+
+	unavailableDeployment := builder.ForDeployment("ns-1", "deploy-2").ObjectMeta(restoreLabel).Result()
+	unavailableDeployment.Spec.Replicas = int32Ptr(3)
+	unavailableDeployment.Status = appsv1api.DeploymentStatus{UpdatedReplicas: 3, AvailableReplicas: 1}
+
+	unreadyStatefulSet := &appsv1api.StatefulSet{}
+	unreadyStatefulSet.Namespace, unreadyStatefulSet.Name = "ns-2", "sts-1"
+	restoreLabel(unreadyStatefulSet)
+	unreadyStatefulSet.Spec.Replicas = int32Ptr(2)
+	unreadyStatefulSet.Status.ReadyReplicas = 1
+
+	readyDaemonSet := &appsv1api.DaemonSet{}
+	readyDaemonSet.Namespace, readyDaemonSet.Name = "ns-2", "ds-1"
+	restoreLabel(readyDaemonSet)
+	readyDaemonSet.Status = appsv1api.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 2}
+
+	readyPod := builder.ForPod("ns-1", "pod-1").ObjectMeta(restoreLabel).Result()
+	readyPod.Status = corev1api.PodStatus{
+		Phase:      corev1api.PodRunning,
+		Conditions: []corev1api.PodCondition{{Type: corev1api.PodReady, Status: corev1api.ConditionTrue}},
+	}
+
+	completedPod := builder.ForPod("ns-1", "pod-2").ObjectMeta(restoreLabel).Result()
+	completedPod.Status.Phase = corev1api.PodSucceeded
+
+	unreadyPod := builder.ForPod("ns-2", "pod-3").ObjectMeta(restoreLabel).Result()
+	unreadyPod.Status = corev1api.PodStatus{
+		Phase:      corev1api.PodRunning,
+		Conditions: []corev1api.PodCondition{{Type: corev1api.PodReady, Status: corev1api.ConditionFalse, Message: "containers with unready status: [app]"}},
+	}
+
+	// not created by the restore, so it's ignored.
+	otherPod := builder.ForPod("ns-1", "pod-4").ObjectMeta(builder.WithLabels(velerov1api.RestoreNameLabel, "restore-2")).Result()
+
+	tests := []struct {
+		name             string
+		objects          []runtime.Object
+		wantUnreadyItems int
+		wantWarnings     map[string][]string
+	}{
+		{
+			name:    "no restored items",
+			objects: []runtime.Object{otherPod},
+		},
+		{
+			name: "all restored items are ready",
+			objects: []runtime.Object{
+				readyDeployment,
+				readyDaemonSet,
+				readyPod,
+				completedPod,
+				otherPod,
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(restoreLabel).Phase(corev1api.ClaimBound).Result(),
+			},
+		},
+		{
+			name: "unready items are reported as warnings",
+			objects: []runtime.Object{
+				readyDeployment,
+				unavailableDeployment,
+				unreadyStatefulSet,
+				readyDaemonSet,
+				readyPod,
+				unreadyPod,
+				otherPod,
+				builder.ForPersistentVolumeClaim("ns-1", "pvc-1").ObjectMeta(restoreLabel).Phase(corev1api.ClaimPending).Result(),
+			},
+			wantUnreadyItems: 4,
+			wantWarnings: map[string][]string{
+				"ns-1": {
+					"Deployment deploy-2 is not ready after 20ms: 1 of 3 replicas available",
+					`PersistentVolumeClaim pvc-1 is not ready after 20ms: phase is "Pending"`,
+				},
+				"ns-2": {
+					"StatefulSet sts-1 is not ready after 20ms: 1 of 2 replicas ready",
+					"Pod pod-3 is not ready after 20ms: containers with unready status: [app]",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset(tc.objects...)
+
+			warnings, unreadyItems := WaitForReady(go_context.Background(), kubeClient, "restore-1", 20*time.Millisecond, velerotest.NewLogger())
+
+			assert.Equal(t, tc.wantUnreadyItems, unreadyItems)
+			assert.Equal(t, tc.wantWarnings, warnings.Namespaces)
+			assert.Empty(t, warnings.Velero)
+			assert.Empty(t, warnings.Cluster)
+		})
+	}
+}
+
+func TestWaitForReadyCanceled(t *testing.T) {
+	defer func(interval time.Duration) { readyPollInterval = interval }(readyPollInterval)
+	readyPollInterval = time.Millisecond
+
+	unreadyPod := builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels(velerov1api.RestoreNameLabel, "restore-1")).Result()
+	unreadyPod.Status.Phase = corev1api.PodPending
+
+	ctx, cancel := go_context.WithCancel(go_context.Background())
+	cancel()
+
+	warnings, unreadyItems := WaitForReady(ctx, fake.NewSimpleClientset(unreadyPod), "restore-1", time.Hour, velerotest.NewLogger())
+
+	assert.Equal(t, 1, unreadyItems)
+	assert.Equal(t, []string{"stopped waiting for restored items to become ready because the restore was canceled"}, warnings.Velero)
+	assert.Empty(t, warnings.Namespaces)
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
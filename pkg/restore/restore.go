@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -39,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -47,19 +47,30 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/credentials"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
+	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
+	"github.com/vmware-tanzu/velero/pkg/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/parallel"
+	"github.com/vmware-tanzu/velero/pkg/util/progress"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
+// progressUpdateInterval is how often a restore's progress is patched onto its
+// status while it's being processed.
+const progressUpdateInterval = 10 * time.Second
+
 type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, error)
 }
@@ -72,6 +83,24 @@ type Request struct {
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
 	BackupReader     io.Reader
+
+	// ResourceModifiers are the rules for modifying restored objects
+	// from the restore's resource modifier ConfigMap, if it has one.
+	ResourceModifiers *resourcemodifiers.ResourceModifiers
+
+	// Plan, if the restore is a dry run, is where the items that
+	// would have been restored are recorded.
+	Plan *Plan
+
+	// ExistingResources, if the restore's existing resource policy is
+	// "update", is where what was done with each item that already existed
+	// in the cluster is recorded.
+	ExistingResources *ExistingResources
+
+	// Context is canceled if the restore is canceled while it's running,
+	// in which case the items that haven't been restored yet are skipped.
+	// If it's nil, the restore can't be canceled.
+	Context go_context.Context
 }
 
 // Restorer knows how to restore a backup.
@@ -86,15 +115,19 @@ type Restorer interface {
 
 // kubernetesRestorer implements Restorer for restoring into a Kubernetes cluster.
 type kubernetesRestorer struct {
+	restoreClient              velerov1client.RestoresGetter
 	discoveryHelper            discovery.Helper
 	dynamicFactory             client.DynamicFactory
 	namespaceClient            corev1.NamespaceInterface
+	podCommandExecutor         podexec.PodCommandExecutor
 	resticRestorerFactory      restic.RestorerFactory
 	resticTimeout              time.Duration
 	resourceTerminatingTimeout time.Duration
+	itemWorkers                int
 	resourcePriorities         []string
 	fileSystem                 filesystem.Interface
 	pvRenamer                  func(string) (string, error)
+	credentialFileStore        credentials.FileStore
 	logger                     logrus.FieldLogger
 }
 
@@ -158,23 +191,31 @@ func prioritizeResources(helper discovery.Helper, priorities []string, includedR
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
 func NewKubernetesRestorer(
+	restoreClient velerov1client.RestoresGetter,
 	discoveryHelper discovery.Helper,
 	dynamicFactory client.DynamicFactory,
 	resourcePriorities []string,
 	namespaceClient corev1.NamespaceInterface,
+	podCommandExecutor podexec.PodCommandExecutor,
 	resticRestorerFactory restic.RestorerFactory,
 	resticTimeout time.Duration,
 	resourceTerminatingTimeout time.Duration,
+	itemWorkers int,
+	credentialFileStore credentials.FileStore,
 	logger logrus.FieldLogger,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
+		restoreClient:              restoreClient,
 		discoveryHelper:            discoveryHelper,
 		dynamicFactory:             dynamicFactory,
 		namespaceClient:            namespaceClient,
+		podCommandExecutor:         podCommandExecutor,
 		resticRestorerFactory:      resticRestorerFactory,
 		resticTimeout:              resticTimeout,
 		resourceTerminatingTimeout: resourceTerminatingTimeout,
+		itemWorkers:                itemWorkers,
 		resourcePriorities:         resourcePriorities,
+		credentialFileStore:        credentialFileStore,
 		logger:                     logger,
 		pvRenamer: func(string) (string, error) {
 			veleroCloneUuid, err := uuid.NewV4()
@@ -228,6 +269,11 @@ func (kr *kubernetesRestorer) Restore(
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
 
+	resourceRestoreHooks, err := getResourceRestoreHooks(req.Restore.Spec.Hooks.Resources, kr.discoveryHelper)
+	if err != nil {
+		return Result{}, Result{Velero: []string{err.Error()}}
+	}
+
 	podVolumeTimeout := kr.resticTimeout
 	if val := req.Restore.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
 		parsed, err := time.ParseDuration(val)
@@ -238,11 +284,27 @@ func (kr *kubernetesRestorer) Restore(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	cancelCtx := req.Context
+	if cancelCtx == nil {
+		cancelCtx = go_context.Background()
+	}
+
+	// the restic restorer stops waiting for pod volume restores when the
+	// timeout elapses or the restore is canceled, whichever comes first.
+	ctx, cancelFunc := go_context.WithTimeout(cancelCtx, podVolumeTimeout)
 	defer cancelFunc()
 
+	itemWorkers := kr.itemWorkers
+	if req.Spec.ItemWorkers > 0 {
+		itemWorkers = req.Spec.ItemWorkers
+	}
+
+	if req.Spec.DryRun && req.Plan == nil {
+		req.Plan = new(Plan)
+	}
+
 	var resticRestorer restic.Restorer
-	if kr.resticRestorerFactory != nil {
+	if kr.resticRestorerFactory != nil && !req.Spec.DryRun {
 		resticRestorer, err = kr.resticRestorerFactory.NewRestorer(ctx, req.Restore)
 		if err != nil {
 			return Result{}, Result{Velero: []string{err.Error()}}
@@ -257,6 +319,7 @@ func (kr *kubernetesRestorer) Restore(
 		volumeSnapshots:         req.VolumeSnapshots,
 		volumeSnapshotterGetter: volumeSnapshotterGetter,
 		snapshotLocationLister:  snapshotLocationLister,
+		credentialFileStore:     kr.credentialFileStore,
 	}
 
 	restoreCtx := &context{
@@ -271,7 +334,11 @@ func (kr *kubernetesRestorer) Restore(
 		dynamicFactory:             kr.dynamicFactory,
 		fileSystem:                 kr.fileSystem,
 		namespaceClient:            kr.namespaceClient,
+		podCommandExecutor:         kr.podCommandExecutor,
 		actions:                    resolvedActions,
+		resourceRestoreHooks:       resourceRestoreHooks,
+		hookErrs:                   make(chan hookErr),
+		podVolumeTimeout:           podVolumeTimeout,
 		volumeSnapshotterGetter:    volumeSnapshotterGetter,
 		resticRestorer:             resticRestorer,
 		resticErrs:                 make(chan error),
@@ -280,12 +347,19 @@ func (kr *kubernetesRestorer) Restore(
 		volumeSnapshots:            req.VolumeSnapshots,
 		podVolumeBackups:           req.PodVolumeBackups,
 		resourceTerminatingTimeout: kr.resourceTerminatingTimeout,
+		itemWorkers:                itemWorkers,
 		resourceClients:            make(map[resourceClientKey]client.Dynamic),
 		restoredItems:              make(map[velero.ResourceIdentifier]struct{}),
 		renamedPVs:                 make(map[string]string),
 		pvRenamer:                  kr.pvRenamer,
 		discoveryHelper:            kr.discoveryHelper,
 		resourcePriorities:         kr.resourcePriorities,
+		restoreClient:              kr.restoreClient,
+		progress:                   progress.NewTracker(),
+		resourceModifiers:          req.ResourceModifiers,
+		plan:                       req.Plan,
+		existingResources:          req.ExistingResources,
+		cancelCtx:                  cancelCtx,
 	}
 
 	return restoreCtx.execute()
@@ -365,7 +439,12 @@ type context struct {
 	dynamicFactory             client.DynamicFactory
 	fileSystem                 filesystem.Interface
 	namespaceClient            corev1.NamespaceInterface
+	podCommandExecutor         podexec.PodCommandExecutor
 	actions                    []resolvedAction
+	resourceRestoreHooks       []resourceRestoreHook
+	hooksWaitGroup             sync.WaitGroup
+	hookErrs                   chan hookErr
+	podVolumeTimeout           time.Duration
 	volumeSnapshotterGetter    VolumeSnapshotterGetter
 	resticRestorer             restic.Restorer
 	resticWaitGroup            sync.WaitGroup
@@ -375,12 +454,24 @@ type context struct {
 	volumeSnapshots            []*volume.Snapshot
 	podVolumeBackups           []*velerov1api.PodVolumeBackup
 	resourceTerminatingTimeout time.Duration
+	itemWorkers                int
 	resourceClients            map[resourceClientKey]client.Dynamic
 	restoredItems              map[velero.ResourceIdentifier]struct{}
 	renamedPVs                 map[string]string
 	pvRenamer                  func(string) (string, error)
 	discoveryHelper            discovery.Helper
 	resourcePriorities         []string
+	restoreClient              velerov1client.RestoresGetter
+	progress                   *progress.Tracker
+	chosenGroupVersionDirs     map[string]string
+	resourceModifiers          *resourcemodifiers.ResourceModifiers
+	plan                       *Plan
+	existingResources          *ExistingResources
+	cancelCtx                  go_context.Context
+
+	// lock guards resourceClients, restoredItems, renamedPVs and pvsToProvision
+	// while items are being restored concurrently.
+	lock sync.Mutex
 }
 
 type resourceClientKey struct {
@@ -388,12 +479,17 @@ type resourceClientKey struct {
 	namespace string
 }
 
+// canceled returns true if the restore has been canceled.
+func (ctx *context) canceled() bool {
+	return ctx.cancelCtx != nil && ctx.cancelCtx.Err() != nil
+}
+
 func (ctx *context) execute() (Result, Result) {
 	warnings, errs := Result{}, Result{}
 
 	ctx.log.Infof("Starting restore of backup %s", kube.NamespaceAndName(ctx.backup))
 
-	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader)
+	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader, ctx.backup.Status.Compression)
 	if err != nil {
 		ctx.log.Infof("error unzipping and extracting: %v", err)
 		errs.AddVeleroError(err)
@@ -410,54 +506,49 @@ func (ctx *context) execute() (Result, Result) {
 		return warnings, errs
 	}
 
-	existingNamespaces := sets.NewString()
-
-	for _, resource := range ctx.prioritizedResources {
-		// we don't want to explicitly restore namespace API objs because we'll handle
-		// them as a special case prior to restoring anything into them
-		if resource == kuberesource.Namespaces {
-			continue
+	if features.IsEnabled(features.APIGroupVersionsFeatureFlag) {
+		backupGroupVersions, err := archive.NewParser(ctx.log, ctx.fileSystem).ParseGroupVersions(ctx.restoreDir)
+		if err != nil {
+			errs.AddVeleroError(errors.Wrap(err, "error parsing backup API group versions"))
+			return warnings, errs
 		}
 
-		resourceList := backupResources[resource.String()]
-		if resourceList == nil {
-			continue
-		}
+		ctx.chosenGroupVersionDirs = chooseAPIVersionsToRestore(backupGroupVersions, ctx.discoveryHelper.APIGroups(), ctx.log)
+	}
 
-		for namespace, items := range resourceList.ItemsByNamespace {
-			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
-				ctx.log.Infof("Skipping namespace %s", namespace)
-				continue
-			}
+	ctx.progress.AddTotalItems(ctx.estimateTotalItems(backupResources))
 
-			// get target namespace to restore into, if different
-			// from source namespace
-			targetNamespace := namespace
-			if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
-				targetNamespace = target
-			}
+	quit := make(chan struct{})
+	var progressWaitGroup sync.WaitGroup
+	if ctx.restoreClient != nil {
+		progressWaitGroup.Add(1)
+		go func() {
+			defer progressWaitGroup.Done()
+			progress.Report(ctx.progress, progressUpdateInterval, quit, func(totalItems, itemsRestored int) error {
+				return ctx.patchProgress(velerov1api.RestoreProgress{TotalItems: totalItems, ItemsRestored: itemsRestored})
+			})
+		}()
+	}
+	defer func() {
+		close(quit)
+		progressWaitGroup.Wait()
 
-			// if we don't know whether this namespace exists yet, attempt to create
-			// it in order to ensure it exists. Try to get it from the backup tarball
-			// (in order to get any backed-up metadata), but if we don't find it there,
-			// create a blank one.
-			if namespace != "" && !existingNamespaces.Has(targetNamespace) {
-				logger := ctx.log.WithField("namespace", namespace)
-				ns := getNamespace(logger, getItemFilePath(ctx.restoreDir, "namespaces", "", namespace), targetNamespace)
-				if _, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
-					errs.AddVeleroError(err)
-					continue
-				}
+		// the final progress is persisted along with the rest of the restore's
+		// status by the restore controller.
+		totalItems, itemsRestored := ctx.progress.Counts()
+		ctx.restore.Status.Progress = &velerov1api.RestoreProgress{TotalItems: totalItems, ItemsRestored: itemsRestored}
+	}()
 
-				// keep track of namespaces that we know exist so we don't
-				// have to try to create them multiple times
-				existingNamespaces.Insert(targetNamespace)
-			}
+	existingNamespaces := sets.NewString()
 
-			w, e := ctx.restoreResource(resource.String(), targetNamespace, namespace, items)
-			warnings.Merge(&w)
-			errs.Merge(&e)
+	for _, tier := range priorityTiers(ctx.discoveryHelper, ctx.resourcePriorities, ctx.prioritizedResources) {
+		if ctx.canceled() {
+			break
 		}
+
+		w, e := ctx.restoreTier(tier, backupResources, existingNamespaces)
+		warnings.Merge(&w)
+		errs.Merge(&e)
 	}
 
 	// TODO: Re-order this logic so that CRs can be prioritized in the main loop, rather than after.
@@ -496,46 +587,18 @@ func (ctx *context) execute() (Result, Result) {
 	}
 
 	// Use the same restore logic as above, but for newly available API groups (CRDs)
-	for _, resource := range addedResources {
-		resourceList := backupResources[resource.String()]
-		if resourceList == nil {
-			continue
+	for _, tier := range priorityTiers(ctx.discoveryHelper, ctx.resourcePriorities, addedResources) {
+		if ctx.canceled() {
+			break
 		}
 
-		for namespace, items := range resourceList.ItemsByNamespace {
-			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
-				ctx.log.Infof("Skipping namespace %s", namespace)
-				continue
-			}
-
-			// get target namespace to restore into, if different
-			// from source namespace
-			targetNamespace := namespace
-			if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
-				targetNamespace = target
-			}
-
-			// if we don't know whether this namespace exists yet, attempt to create
-			// it in order to ensure it exists. Try to get it from the backup tarball
-			// (in order to get any backed-up metadata), but if we don't find it there,
-			// create a blank one.
-			if namespace != "" && !existingNamespaces.Has(targetNamespace) {
-				logger := ctx.log.WithField("namespace", namespace)
-				ns := getNamespace(logger, getItemFilePath(ctx.restoreDir, "namespaces", "", namespace), targetNamespace)
-				if _, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout); err != nil {
-					errs.AddVeleroError(err)
-					continue
-				}
-
-				// keep track of namespaces that we know exist so we don't
-				// have to try to create them multiple times
-				existingNamespaces.Insert(targetNamespace)
-			}
+		w, e := ctx.restoreTier(tier, backupResources, existingNamespaces)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
 
-			w, e := ctx.restoreResource(resource.String(), targetNamespace, namespace, items)
-			warnings.Merge(&w)
-			errs.Merge(&e)
-		}
+	if ctx.canceled() {
+		ctx.log.Warn("Restore was canceled before all items were restored")
 	}
 
 	// wait for all of the restic restore goroutines to be done, which is
@@ -560,16 +623,118 @@ func (ctx *context) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all restic restores to complete")
 
+	// wait for all of the restore hook goroutines to be done, using the
+	// same approach as for restic restores above.
+	go func() {
+		ctx.log.Info("Waiting for all restore hooks to complete")
+
+		ctx.hooksWaitGroup.Wait()
+		close(ctx.hookErrs)
+	}()
+
+	for hookErr := range ctx.hookErrs {
+		if hookErr.warning {
+			warnings.Add(hookErr.namespace, hookErr.err)
+		} else {
+			errs.Add(hookErr.namespace, hookErr.err)
+		}
+	}
+	ctx.log.Info("Done waiting for all restore hooks to complete")
+
 	return warnings, errs
 }
 
-func getItemFilePath(rootDir, groupResource, namespace, name string) string {
-	switch namespace {
-	case "":
-		return filepath.Join(rootDir, velerov1api.ResourcesDir, groupResource, velerov1api.ClusterScopedDir, name+".json")
-	default:
-		return filepath.Join(rootDir, velerov1api.ResourcesDir, groupResource, velerov1api.NamespaceScopedDir, namespace, name+".json")
+// ensureNamespace makes sure the provided namespace exists in the cluster and is
+// ready to restore into, creating it if necessary. If the restore is a dry run,
+// the namespace is only added to the plan if it would be created.
+func (ctx *context) ensureNamespace(ns *v1.Namespace) error {
+	if !ctx.restore.Spec.DryRun {
+		_, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+		return err
+	}
+
+	_, err := ctx.namespaceClient.Get(ns.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		ctx.plan.Add(kuberesource.Namespaces.String(), "", ns.Name, PlanActionCreate)
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+
+	return nil
+}
+
+// estimateTotalItems returns the number of items in the backup that are expected to
+// be restored, based on the restore's resource and namespace filters. Items that are
+// excluded by the restore's label selector are removed from the total as they're found.
+func (ctx *context) estimateTotalItems(backupResources map[string]*archive.ResourceItems) int {
+	var total int
+	for resource, resourceList := range backupResources {
+		// namespaces aren't explicitly restored; they're created as needed for the
+		// items that are restored into them.
+		if resource == kuberesource.Namespaces.String() || !ctx.resourceIncludesExcludes.ShouldInclude(resource) {
+			continue
+		}
+
+		for namespace, items := range resourceList.ItemsByNamespace {
+			if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+				continue
+			}
+
+			if namespace == "" && !ctx.shouldRestoreClusterScopedResources() {
+				continue
+			}
+
+			total += len(items)
+		}
 	}
+
+	return total
+}
+
+// shouldRestoreClusterScopedResources returns true if cluster-scoped resources should be
+// included in the restore, based on its IncludeClusterResources and namespace filters.
+func (ctx *context) shouldRestoreClusterScopedResources() bool {
+	if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
+		return false
+	}
+
+	return boolptr.IsSetToTrue(ctx.restore.Spec.IncludeClusterResources) || ctx.namespaceIncludesExcludes.IncludeEverything()
+}
+
+// patchProgress patches the restore's status with its current progress.
+func (ctx *context) patchProgress(progress velerov1api.RestoreProgress) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"progress": progress,
+		},
+	})
+	if err != nil {
+		ctx.log.WithError(errors.WithStack(err)).Error("Error marshaling restore progress patch")
+		return err
+	}
+
+	if _, err := ctx.restoreClient.Restores(ctx.restore.Namespace).Patch(ctx.restore.Name, types.MergePatchType, patch); err != nil {
+		ctx.log.WithError(errors.WithStack(err)).Warn("Error updating restore progress")
+		return err
+	}
+	return nil
+}
+
+// itemFilePath returns the path of an item within the extracted backup. If an API
+// version was chosen to restore for the item's resource, the item is read from that
+// version's directory, unless it's not there, in which case it's read from its
+// non-versioned path.
+func (ctx *context) itemFilePath(groupResource, namespace, name string) string {
+	if versionDir, ok := ctx.chosenGroupVersionDirs[groupResource]; ok {
+		itemPath := archive.GetVersionedItemFilePath(ctx.restoreDir, groupResource, versionDir, namespace, name)
+		if _, err := ctx.fileSystem.Stat(itemPath); err == nil {
+			return itemPath
+		}
+	}
+
+	return archive.GetItemFilePath(ctx.restoreDir, groupResource, namespace, name)
 }
 
 // getNamespace returns a namespace API object that we should attempt to
@@ -758,19 +923,146 @@ func (ctx *context) crdAvailable(name string, crdClient client.Dynamic) (bool, e
 	return available, err
 }
 
-// restoreResource restores the specified cluster or namespace scoped resource. If namespace is
-// empty we are restoring a cluster level resource, otherwise into the specified namespace.
-func (ctx *context) restoreResource(resource, targetNamespace, originalNamespace string, items []string) (Result, Result) {
+// priorityTiers splits resources, ordered as by prioritizeResources, into the tiers in
+// which they're restored. Each resource in priorities is a tier of its own, so that it's
+// fully restored before the next one is started. The rest of the resources aren't ordered
+// relative to one another, so they make up a single, last tier.
+func priorityTiers(helper discovery.Helper, priorities []string, resources []schema.GroupResource) [][]schema.GroupResource {
+	prioritized := sets.NewString()
+	for _, r := range priorities {
+		if gvr, _, err := helper.ResourceFor(schema.ParseGroupResource(r).WithVersion("")); err == nil {
+			prioritized.Insert(gvr.GroupResource().String())
+		}
+	}
+
+	var tiers [][]schema.GroupResource
+	var unprioritized []schema.GroupResource
+	for _, resource := range resources {
+		if prioritized.Has(resource.String()) {
+			tiers = append(tiers, []schema.GroupResource{resource})
+		} else {
+			unprioritized = append(unprioritized, resource)
+		}
+	}
+	if len(unprioritized) > 0 {
+		tiers = append(tiers, unprioritized)
+	}
+
+	return tiers
+}
+
+// resourceItem identifies an item in the backup to restore.
+type resourceItem struct {
+	groupResource     schema.GroupResource
+	targetNamespace   string
+	originalNamespace string
+	name              string
+}
+
+// restoreTier restores the items of a tier's resources from each of the namespaces that
+// they're in in the backup. Any namespaces that the items are to be restored into are
+// created first, then the items of all of the tier's resources are restored using up to
+// the restore's number of item workers. All of the items have been restored when it returns.
+func (ctx *context) restoreTier(resources []schema.GroupResource, backupResources map[string]*archive.ResourceItems, existingNamespaces sets.String) (Result, Result) {
 	warnings, errs := Result{}, Result{}
 
+	var items []resourceItem
+	for _, resource := range resources {
+		// we don't want to explicitly restore namespace API objs because we'll handle
+		// them as a special case prior to restoring anything into them
+		if resource == kuberesource.Namespaces {
+			continue
+		}
+
+		resourceList := backupResources[resource.String()]
+		if resourceList == nil {
+			continue
+		}
+
+		resourceItems, e := ctx.listResourceItems(resource, resourceList, existingNamespaces)
+		items = append(items, resourceItems...)
+		errs.Merge(&e)
+	}
+
+	var lock sync.Mutex
+	parallel.ForEach(ctx.itemWorkers, len(items), func(i int) {
+		if ctx.canceled() {
+			return
+		}
+
+		w, e := ctx.restoreResourceItem(items[i].groupResource, items[i].targetNamespace, items[i].originalNamespace, items[i].name)
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	})
+
+	return warnings, errs
+}
+
+// listResourceItems returns the items of the resource to restore from each of the
+// namespaces that they're in in the backup, creating any namespaces that the items
+// are to be restored into.
+func (ctx *context) listResourceItems(resource schema.GroupResource, resourceList *archive.ResourceItems, existingNamespaces sets.String) ([]resourceItem, Result) {
+	errs := Result{}
+
+	var items []resourceItem
+	for namespace, names := range resourceList.ItemsByNamespace {
+		if namespace != "" && !ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			ctx.log.Infof("Skipping namespace %s", namespace)
+			continue
+		}
+
+		// get target namespace to restore into, if different
+		// from source namespace
+		targetNamespace := namespace
+		if target, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			targetNamespace = target
+		}
+
+		// if we don't know whether this namespace exists yet, attempt to create
+		// it in order to ensure it exists. Try to get it from the backup tarball
+		// (in order to get any backed-up metadata), but if we don't find it there,
+		// create a blank one.
+		if namespace != "" && !existingNamespaces.Has(targetNamespace) {
+			logger := ctx.log.WithField("namespace", namespace)
+			ns := getNamespace(logger, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace), targetNamespace)
+			if err := ctx.ensureNamespace(ns); err != nil {
+				errs.AddVeleroError(err)
+				continue
+			}
+
+			// keep track of namespaces that we know exist so we don't
+			// have to try to create them multiple times
+			existingNamespaces.Insert(targetNamespace)
+		}
+
+		if !ctx.shouldRestoreResource(resource.String(), targetNamespace) {
+			continue
+		}
+
+		for _, name := range names {
+			items = append(items, resourceItem{groupResource: resource, targetNamespace: targetNamespace, originalNamespace: namespace, name: name})
+		}
+	}
+
+	return items, errs
+}
+
+// shouldRestoreResource returns whether the specified cluster or namespace scoped resource
+// should be restored. If targetNamespace is empty we are restoring a cluster level resource,
+// otherwise into the specified namespace.
+func (ctx *context) shouldRestoreResource(resource, targetNamespace string) bool {
 	if targetNamespace == "" && boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
 		ctx.log.Infof("Skipping resource %s because it's cluster-scoped", resource)
-		return warnings, errs
+		return false
 	}
 
 	if targetNamespace == "" && !boolptr.IsSetToTrue(ctx.restore.Spec.IncludeClusterResources) && !ctx.namespaceIncludesExcludes.IncludeEverything() {
 		ctx.log.Infof("Skipping resource %s because it's cluster-scoped and only specific namespaces are included in the restore", resource)
-		return warnings, errs
+		return false
 	}
 
 	if targetNamespace != "" {
@@ -779,34 +1071,40 @@ func (ctx *context) restoreResource(resource, targetNamespace, originalNamespace
 		ctx.log.Infof("Restoring cluster level resource '%s'", resource)
 	}
 
-	if len(items) == 0 {
-		return warnings, errs
-	}
-
-	groupResource := schema.ParseGroupResource(resource)
+	return true
+}
 
-	for _, item := range items {
-		itemPath := getItemFilePath(ctx.restoreDir, resource, originalNamespace, item)
+// restoreResourceItem restores the named item of the resource from originalNamespace in
+// the backup into targetNamespace.
+func (ctx *context) restoreResourceItem(groupResource schema.GroupResource, targetNamespace, originalNamespace, name string) (Result, Result) {
+	warnings, errs := Result{}, Result{}
 
-		obj, err := ctx.unmarshal(itemPath)
-		if err != nil {
-			errs.Add(targetNamespace, fmt.Errorf("error decoding %q: %v", strings.Replace(itemPath, ctx.restoreDir+"/", "", -1), err))
-			continue
-		}
+	itemPath := ctx.itemFilePath(groupResource.String(), originalNamespace, name)
 
-		if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
-			continue
-		}
+	obj, err := ctx.unmarshal(itemPath)
+	if err != nil {
+		errs.Add(targetNamespace, fmt.Errorf("error decoding %q: %v", strings.Replace(itemPath, ctx.restoreDir+"/", "", -1), err))
+		ctx.progress.ItemDone()
+		return warnings, errs
+	}
 
-		w, e := ctx.restoreItem(obj, groupResource, targetNamespace)
-		warnings.Merge(&w)
-		errs.Merge(&e)
+	if !ctx.selector.Matches(labels.Set(obj.GetLabels())) {
+		ctx.progress.AddTotalItems(-1)
+		return warnings, errs
 	}
 
+	w, e := ctx.restoreItem(obj, groupResource, targetNamespace)
+	warnings.Merge(&w)
+	errs.Merge(&e)
+	ctx.progress.ItemDone()
+
 	return warnings, errs
 }
 
 func (ctx *context) getResourceClient(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace string) (client.Dynamic, error) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
 	key := resourceClientKey{
 		resource:  groupResource.WithVersion(obj.GroupVersionKind().Version),
 		namespace: namespace,
@@ -834,6 +1132,28 @@ func (ctx *context) getResourceClient(groupResource schema.GroupResource, obj *u
 	return client, nil
 }
 
+// markRestored records that the item identified by key is being restored. It returns
+// false if the item had already been recorded.
+func (ctx *context) markRestored(key velero.ResourceIdentifier) bool {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	if _, exists := ctx.restoredItems[key]; exists {
+		return false
+	}
+	ctx.restoredItems[key] = struct{}{}
+	return true
+}
+
+// provisionPV records that the PV with the specified name is to be dynamically
+// re-provisioned rather than restored.
+func (ctx *context) provisionPV(name string) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	ctx.pvsToProvision.Insert(name)
+}
+
 func getResourceID(groupResource schema.GroupResource, namespace, name string) string {
 	if namespace == "" {
 		return fmt.Sprintf("%s/%s", groupResource.String(), name)
@@ -906,11 +1226,10 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		Namespace:     namespace,
 		Name:          name,
 	}
-	if _, exists := ctx.restoredItems[itemKey]; exists {
+	if !ctx.markRestored(itemKey) {
 		ctx.log.Infof("Skipping %s because it's already been restored.", resourceID)
 		return warnings, errs
 	}
-	ctx.restoredItems[itemKey] = struct{}{}
 
 	// TODO: move to restore item action if/when we add a ShouldRestore() method to the interface
 	if groupResource == kuberesource.Pods && obj.GetAnnotations()[v1.MirrorPodAnnotationKey] != "" {
@@ -950,7 +1269,9 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 				shouldRestoreSnapshot = true
 			}
 
-			if shouldRestoreSnapshot {
+			if shouldRestoreSnapshot && ctx.restore.Spec.DryRun {
+				ctx.log.Infof("Dry run, not restoring persistent volume from snapshot.")
+			} else if shouldRestoreSnapshot {
 				// even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 				// uses the original name to look up metadata about the snapshot.
 				ctx.log.Infof("Restoring persistent volume from snapshot.")
@@ -976,7 +1297,9 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 					pvName = obj.GetName()
 				}
 
+				ctx.lock.Lock()
 				ctx.renamedPVs[oldName] = pvName
+				ctx.lock.Unlock()
 				obj.SetName(pvName)
 
 				// add the original PV name as an annotation
@@ -990,14 +1313,21 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
-			ctx.pvsToProvision.Insert(name)
+			ctx.provisionPV(name)
+
+			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
+			return warnings, errs
+
+		case hasCSIVolumeSnapshot(obj, ctx):
+			ctx.log.Infof("Dynamically re-provisioning persistent volume because its claim has a CSI volume snapshot to be restored.")
+			ctx.provisionPV(name)
 
 			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
 			return warnings, errs
 
 		case hasDeleteReclaimPolicy(obj.Object):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
-			ctx.pvsToProvision.Insert(name)
+			ctx.provisionPV(name)
 
 			// return early because we don't want to restore the PV itself, we want to dynamically re-provision it.
 			return warnings, errs
@@ -1017,7 +1347,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 	}
 
 	// clear out non-core metadata fields & status
-	if obj, err = resetMetadataAndStatus(obj); err != nil {
+	if obj, err = ResetMetadataAndStatus(obj); err != nil {
 		errs.Add(namespace, err)
 		return warnings, errs
 	}
@@ -1041,6 +1371,9 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
+			if ctx.restore.Spec.DryRun {
+				ctx.plan.Add(groupResource.String(), obj.GetNamespace(), name, PlanActionSkippedByAction)
+			}
 			return warnings, errs
 		}
 		unstructuredObj, ok := executeOutput.UpdatedItem.(*unstructured.Unstructured)
@@ -1052,7 +1385,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		obj = unstructuredObj
 
 		for _, additionalItem := range executeOutput.AdditionalItems {
-			itemPath := getItemFilePath(ctx.restoreDir, additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
+			itemPath := ctx.itemFilePath(additionalItem.GroupResource.String(), additionalItem.Namespace, additionalItem.Name)
 
 			if _, err := ctx.fileSystem.Stat(itemPath); err != nil {
 				ctx.log.WithError(err).WithFields(logrus.Fields{
@@ -1099,7 +1432,12 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 			return warnings, errs
 		}
 
-		if pvc.Spec.VolumeName != "" && ctx.pvsToProvision.Has(pvc.Spec.VolumeName) {
+		ctx.lock.Lock()
+		provision := pvc.Spec.VolumeName != "" && ctx.pvsToProvision.Has(pvc.Spec.VolumeName)
+		newName, renamed := ctx.renamedPVs[pvc.Spec.VolumeName]
+		ctx.lock.Unlock()
+
+		if provision {
 			ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s for dynamic provisioning because its PV %v has a reclaim policy of Delete", namespace, name, pvc.Spec.VolumeName)
 
 			// use the unstructured helpers here since we're only deleting and
@@ -1112,7 +1450,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 			obj.SetAnnotations(annotations)
 		}
 
-		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
+		if renamed {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
 				errs.Add(namespace, err)
@@ -1133,6 +1471,36 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 	// and which backup they came from
 	addRestoreLabels(obj, ctx.restore.Name, ctx.restore.Spec.BackupName)
 
+	// add any init containers specified by restore hooks. This comes after running
+	// item actions so that the restic wait init container, if any, has been added.
+	if groupResource == kuberesource.Pods {
+		if obj, err = ctx.addInitRestoreHookContainers(obj, originalNamespace); err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error adding restore hook init containers to %s", resourceID))
+			return warnings, errs
+		}
+	}
+
+	// apply the resource modifier rules last, so that they see the object as
+	// it's about to be created.
+	if ctx.resourceModifiers != nil {
+		if err := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.log.WithField("resource", resourceID)); err != nil {
+			errs.Add(namespace, errors.Wrapf(err, "error applying resource modifiers to %s", resourceID))
+			return warnings, errs
+		}
+	}
+
+	if ctx.restore.Spec.DryRun {
+		action, err := planAction(obj, resourceClient)
+		if err != nil {
+			ctx.log.Infof("Error retrieving cluster version of %s: %v", kube.NamespaceAndName(obj), err)
+			warnings.Add(namespace, err)
+			return warnings, errs
+		}
+		ctx.log.Infof("Dry run, not restoring %s: %v (%s)", obj.GroupVersionKind().Kind, name, action)
+		ctx.plan.Add(groupResource.String(), obj.GetNamespace(), obj.GetName(), action)
+		return warnings, errs
+	}
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
 	if apierrors.IsAlreadyExists(restoreErr) {
@@ -1143,13 +1511,16 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 			return warnings, errs
 		}
 		// Remove insubstantial metadata
-		fromCluster, err = resetMetadataAndStatus(fromCluster)
+		fromCluster, err = ResetMetadataAndStatus(fromCluster)
 		if err != nil {
 			ctx.log.Infof("Error trying to reset metadata for %s: %v", kube.NamespaceAndName(obj), err)
 			warnings.Add(namespace, err)
 			return warnings, errs
 		}
 
+		// Keep an unlabeled copy so that an update can also apply the restore labels.
+		unlabeledFromCluster := fromCluster.DeepCopy()
+
 		// We know the object from the cluster won't have the backup/restore name labels, so
 		// copy them from the object we attempted to restore.
 		labels := obj.GetLabels()
@@ -1162,6 +1533,7 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 				if err != nil {
 					ctx.log.Infof("error merging secrets for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdateFailed)
 					return warnings, errs
 				}
 
@@ -1169,28 +1541,53 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 				if err != nil {
 					ctx.log.Infof("error generating patch for ServiceAccount %s: %v", kube.NamespaceAndName(obj), err)
 					warnings.Add(namespace, err)
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdateFailed)
 					return warnings, errs
 				}
 
 				if patchBytes == nil {
 					// In-cluster and desired state are the same, so move on to the next item
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUnchanged)
 					return warnings, errs
 				}
 
 				_, err = resourceClient.Patch(name, patchBytes)
 				if err != nil {
 					warnings.Add(namespace, err)
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdateFailed)
 				} else {
 					ctx.log.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdated)
 				}
 			default:
-				e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
-				warnings.Add(namespace, e)
+				if ctx.restore.Spec.ExistingResourcePolicy != velerov1api.PolicyTypeUpdate {
+					e := errors.Errorf("could not restore, %s. Warning: the in-cluster version is different than the backed-up version.", restoreErr)
+					warnings.Add(namespace, e)
+					break
+				}
+
+				patchBytes, err := generatePatch(unlabeledFromCluster, obj)
+				if err != nil {
+					ctx.log.Infof("error generating patch for %s: %v", kube.NamespaceAndName(obj), err)
+					warnings.Add(namespace, err)
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdateFailed)
+					return warnings, errs
+				}
+
+				if _, err := resourceClient.Patch(name, patchBytes); err != nil {
+					e := errors.Errorf("could not update existing %s to match the backed-up version: %v", resourceID, err)
+					warnings.Add(namespace, e)
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdateFailed)
+				} else {
+					ctx.log.Infof("%s already existed in the cluster and was updated to match the backed-up version", resourceID)
+					ctx.existingResources.Add(resourceID, ExistingResourceActionUpdated)
+				}
 			}
 			return warnings, errs
 		}
 
 		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
+		ctx.existingResources.Add(resourceID, ExistingResourceActionUnchanged)
 		return warnings, errs
 	}
 
@@ -1205,6 +1602,12 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 		restorePodVolumeBackups(ctx, createdObj, originalNamespace)
 	}
 
+	if groupResource == kuberesource.Pods {
+		if hooks := getExecRestoreHooks(obj, originalNamespace, ctx.resourceRestoreHooks); len(hooks) > 0 {
+			ctx.runExecRestoreHooks(createdObj, hooks, resourceClient)
+		}
+	}
+
 	// Wait for a CRD to be available for instantiating resources
 	// before continuing.
 	if groupResource == kuberesource.CustomResourceDefinitions {
@@ -1219,6 +1622,32 @@ func (ctx *context) restoreItem(obj *unstructured.Unstructured, groupResource sc
 	return warnings, errs
 }
 
+// planAction returns what restoring obj would do, based on whether it already
+// exists in the cluster and, if so, whether it's the same as the in-cluster version.
+func planAction(obj *unstructured.Unstructured, client client.Dynamic) (PlanAction, error) {
+	fromCluster, err := client.Get(obj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return PlanActionCreate, nil
+	case err != nil:
+		return "", errors.WithStack(err)
+	}
+
+	// compare the same way as when the create fails because the object
+	// already exists.
+	fromCluster, err = ResetMetadataAndStatus(fromCluster)
+	if err != nil {
+		return "", err
+	}
+	labels := obj.GetLabels()
+	addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+
+	if !equality.Semantic.DeepEqual(fromCluster, obj) {
+		return PlanActionExistsDifferent, nil
+	}
+	return PlanActionExistsIdentical, nil
+}
+
 // shouldRenamePV returns a boolean indicating whether a persistent volume should be given a new name
 // before being restored, or an error if this cannot be determined. A persistent volume will be
 // given a new name if and only if (a) a PV with the original name already exists in-cluster, and
@@ -1330,12 +1759,41 @@ func hasResticBackup(unstructuredPV *unstructured.Unstructured, ctx *context) bo
 	return found
 }
 
+// hasCSIVolumeSnapshot returns true if the CSI feature is enabled and the PV's claim
+// was labeled with a CSI VolumeSnapshot name when it was backed up.
+func hasCSIVolumeSnapshot(unstructuredPV *unstructured.Unstructured, ctx *context) bool {
+	if !features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		return false
+	}
+
+	pv := new(v1.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPV.Object, pv); err != nil {
+		ctx.log.WithError(err).Warnf("Unable to convert PV from unstructured to structured")
+		return false
+	}
+
+	if pv.Spec.CSI == nil || pv.Spec.ClaimRef == nil {
+		return false
+	}
+
+	pvc, err := ctx.unmarshal(ctx.itemFilePath(kuberesource.PersistentVolumeClaims.String(), pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name))
+	if err != nil {
+		ctx.log.WithError(err).Debugf("Unable to read backed-up persistent volume claim %s/%s", pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+		return false
+	}
+
+	return pvc.GetLabels()[velerov1api.VolumeSnapshotLabel] != ""
+}
+
 func hasDeleteReclaimPolicy(obj map[string]interface{}) bool {
 	policy, _, _ := unstructured.NestedString(obj, "spec", "persistentVolumeReclaimPolicy")
 	return policy == string(v1.PersistentVolumeReclaimDelete)
 }
 
-func resetMetadataAndStatus(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+// ResetMetadataAndStatus removes the status and all metadata except the name,
+// namespace, labels and annotations from obj, which is modified in place. These
+// are the fields that restores ignore when creating or comparing objects.
+func ResetMetadataAndStatus(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	res, ok := obj.Object["metadata"]
 	if !ok {
 		return nil, errors.New("metadata not found")
@@ -0,0 +1,445 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	podRestoreHookContainerAnnotationKey   = "post.hook.restore.velero.io/container"
+	podRestoreHookCommandAnnotationKey     = "post.hook.restore.velero.io/command"
+	podRestoreHookOnErrorAnnotationKey     = "post.hook.restore.velero.io/on-error"
+	podRestoreHookExecTimeoutAnnotationKey = "post.hook.restore.velero.io/exec-timeout"
+	podRestoreHookWaitTimeoutAnnotationKey = "post.hook.restore.velero.io/wait-timeout"
+
+	podRestoreHookInitContainerImageAnnotationKey   = "init.hook.restore.velero.io/container-image"
+	podRestoreHookInitContainerNameAnnotationKey    = "init.hook.restore.velero.io/container-name"
+	podRestoreHookInitContainerCommandAnnotationKey = "init.hook.restore.velero.io/command"
+
+	// hookSourceAnnotation is used as the hook name for hooks specified via pod annotations.
+	hookSourceAnnotation = "<from-annotation>"
+)
+
+// resourceRestoreHook is a RestoreResourceHookSpec that has been resolved against
+// the discovery helper so that it can be matched against restored items.
+type resourceRestoreHook struct {
+	name          string
+	namespaces    *collections.IncludesExcludes
+	resources     *collections.IncludesExcludes
+	labelSelector labels.Selector
+	hooks         []velerov1api.RestoreResourceHook
+}
+
+func (r resourceRestoreHook) applicableTo(groupResource schema.GroupResource, namespace string, labels labels.Set) bool {
+	if r.namespaces != nil && !r.namespaces.ShouldInclude(namespace) {
+		return false
+	}
+	if r.resources != nil && !r.resources.ShouldInclude(groupResource.String()) {
+		return false
+	}
+	if r.labelSelector != nil && !r.labelSelector.Matches(labels) {
+		return false
+	}
+	return true
+}
+
+func getResourceRestoreHooks(hookSpecs []velerov1api.RestoreResourceHookSpec, discoveryHelper discovery.Helper) ([]resourceRestoreHook, error) {
+	resourceHooks := make([]resourceRestoreHook, 0, len(hookSpecs))
+
+	for _, s := range hookSpecs {
+		h := resourceRestoreHook{
+			name:       s.Name,
+			namespaces: collections.NewIncludesExcludes().Includes(s.IncludedNamespaces...).Excludes(s.ExcludedNamespaces...),
+			resources:  getResourceIncludesExcludes(discoveryHelper, s.IncludedResources, s.ExcludedResources),
+			hooks:      s.PostHooks,
+		}
+
+		if s.LabelSelector != nil {
+			labelSelector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			h.labelSelector = labelSelector
+		}
+
+		resourceHooks = append(resourceHooks, h)
+	}
+
+	return resourceHooks, nil
+}
+
+// namedExecRestoreHook is an exec restore hook along with the name of the hook
+// spec it came from.
+type namedExecRestoreHook struct {
+	name string
+	hook velerov1api.ExecRestoreHook
+}
+
+// getExecRestoreHooks returns the exec restore hooks that apply to the pod. If the pod has
+// a hook specified via annotations, that takes priority over any hooks defined in the
+// restore spec. namespace is the pod's namespace in the backup, i.e. before any namespace
+// mapping has been applied.
+func getExecRestoreHooks(pod *unstructured.Unstructured, namespace string, resourceHooks []resourceRestoreHook) []namedExecRestoreHook {
+	if hook := getPodExecRestoreHookFromAnnotations(pod.GetAnnotations()); hook != nil {
+		return []namedExecRestoreHook{{name: hookSourceAnnotation, hook: *hook}}
+	}
+
+	var res []namedExecRestoreHook
+	for _, resourceHook := range resourceHooks {
+		if !resourceHook.applicableTo(kuberesource.Pods, namespace, labels.Set(pod.GetLabels())) {
+			continue
+		}
+
+		for _, hook := range resourceHook.hooks {
+			if hook.Exec != nil {
+				res = append(res, namedExecRestoreHook{name: resourceHook.name, hook: *hook.Exec})
+			}
+		}
+	}
+
+	return res
+}
+
+// getInitRestoreHookContainers returns the init containers that should be added to the pod
+// by restore hooks. If the pod has an init container specified via annotations, that takes
+// priority over any hooks defined in the restore spec. namespace is the pod's namespace in
+// the backup, i.e. before any namespace mapping has been applied.
+func getInitRestoreHookContainers(pod *unstructured.Unstructured, namespace string, resourceHooks []resourceRestoreHook) []corev1api.Container {
+	if container := getPodInitContainerFromAnnotations(pod.GetAnnotations()); container != nil {
+		return []corev1api.Container{*container}
+	}
+
+	var res []corev1api.Container
+	for _, resourceHook := range resourceHooks {
+		if !resourceHook.applicableTo(kuberesource.Pods, namespace, labels.Set(pod.GetLabels())) {
+			continue
+		}
+
+		for _, hook := range resourceHook.hooks {
+			if hook.Init != nil {
+				res = append(res, hook.Init.InitContainers...)
+			}
+		}
+	}
+
+	return res
+}
+
+// getPodExecRestoreHookFromAnnotations returns an ExecRestoreHook based on the annotations, as long
+// as the 'command' annotation is present. If it is absent, this returns nil.
+func getPodExecRestoreHookFromAnnotations(annotations map[string]string) *velerov1api.ExecRestoreHook {
+	command := parseHookCommand(annotations[podRestoreHookCommandAnnotationKey])
+	if len(command) == 0 {
+		return nil
+	}
+
+	onError := velerov1api.HookErrorMode(annotations[podRestoreHookOnErrorAnnotationKey])
+	if onError != velerov1api.HookErrorModeContinue && onError != velerov1api.HookErrorModeFail {
+		onError = ""
+	}
+
+	return &velerov1api.ExecRestoreHook{
+		Container:   annotations[podRestoreHookContainerAnnotationKey],
+		Command:     command,
+		OnError:     onError,
+		ExecTimeout: metav1.Duration{Duration: parseHookDuration(annotations[podRestoreHookExecTimeoutAnnotationKey])},
+		WaitTimeout: metav1.Duration{Duration: parseHookDuration(annotations[podRestoreHookWaitTimeoutAnnotationKey])},
+	}
+}
+
+// getPodInitContainerFromAnnotations returns an init container based on the annotations, as long
+// as the 'container-image' and 'command' annotations are present. If either is absent, this
+// returns nil.
+func getPodInitContainerFromAnnotations(annotations map[string]string) *corev1api.Container {
+	image := annotations[podRestoreHookInitContainerImageAnnotationKey]
+	command := parseHookCommand(annotations[podRestoreHookInitContainerCommandAnnotationKey])
+	if image == "" || len(command) == 0 {
+		return nil
+	}
+
+	name := annotations[podRestoreHookInitContainerNameAnnotationKey]
+	if name == "" {
+		name = "restore-hook"
+	}
+
+	return &corev1api.Container{
+		Name:    name,
+		Image:   image,
+		Command: command,
+	}
+}
+
+// parseHookCommand parses a command from an annotation value, which may be
+// either a JSON array or a single string.
+func parseHookCommand(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	var command []string
+	// check for json array
+	if value[0] == '[' {
+		if err := json.Unmarshal([]byte(value), &command); err != nil {
+			command = []string{value}
+		}
+	} else {
+		command = append(command, value)
+	}
+
+	return command
+}
+
+// parseHookDuration parses a duration from an annotation value, returning zero
+// if the value is empty or invalid.
+func parseHookDuration(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0
+	}
+
+	return duration
+}
+
+// addInitContainers adds the provided init containers to the pod. If the pod has
+// a restic wait init container, it's kept first so that restored volume data is
+// in place before the hook containers run.
+func addInitContainers(pod *corev1api.Pod, containers []corev1api.Container) {
+	existing := pod.Spec.InitContainers
+	initContainers := make([]corev1api.Container, 0, len(existing)+len(containers))
+
+	if len(existing) > 0 && existing[0].Name == restic.InitContainer {
+		initContainers = append(initContainers, existing[0])
+		existing = existing[1:]
+	}
+
+	initContainers = append(initContainers, containers...)
+	pod.Spec.InitContainers = append(initContainers, existing...)
+}
+
+// addInitRestoreHookContainers adds the init containers from any applicable restore hooks
+// to the pod, returning the updated pod.
+func (ctx *context) addInitRestoreHookContainers(obj *unstructured.Unstructured, originalNamespace string) (*unstructured.Unstructured, error) {
+	containers := getInitRestoreHookContainers(obj, originalNamespace, ctx.resourceRestoreHooks)
+	if len(containers) == 0 {
+		return obj, nil
+	}
+
+	pod := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	ctx.log.Infof("Adding %d restore hook init container(s) to pod %s", len(containers), kube.NamespaceAndName(obj))
+	addInitContainers(pod, containers)
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &unstructured.Unstructured{Object: res}, nil
+}
+
+// hookErr is an error executing a restore hook, along with the namespace
+// of the pod it was executed in.
+type hookErr struct {
+	namespace string
+	err       error
+	// warning is true if the hook's OnError mode is Continue, meaning the
+	// error should be reported as a warning rather than an error.
+	warning bool
+}
+
+// runExecRestoreHooks executes the provided exec restore hooks in order for the restored
+// pod, in a goroutine. Each hook waits for its container to be running before it's executed.
+// Any errors are sent on the ctx.hookErrs channel.
+func (ctx *context) runExecRestoreHooks(createdObj *unstructured.Unstructured, hooks []namedExecRestoreHook, podClient client.Dynamic) {
+	if ctx.podCommandExecutor == nil {
+		ctx.log.Warn("No pod command executor, not running pod's restore hooks")
+		return
+	}
+	if ctx.canceled() {
+		ctx.log.Warn("Restore was canceled, not running pod's restore hooks")
+		return
+	}
+
+	ctx.hooksWaitGroup.Add(1)
+	go func() {
+		// Done() will only be called after all errors have been successfully sent
+		// on the ctx.hookErrs channel
+		defer ctx.hooksWaitGroup.Done()
+
+		namespace, name := createdObj.GetNamespace(), createdObj.GetName()
+
+		for _, h := range hooks {
+			hookLog := ctx.log.WithFields(
+				logrus.Fields{
+					"pod":        kube.NamespaceAndName(createdObj),
+					"hookSource": hookSourceFor(h.name),
+					"hookType":   "exec",
+					"hookPhase":  "post",
+				},
+			)
+
+			if ctx.canceled() {
+				hookLog.Warn("Restore was canceled, not running pod's remaining restore hooks")
+				return
+			}
+
+			err := ctx.executeExecRestoreHook(hookLog, podClient, namespace, name, h)
+			if err == nil {
+				continue
+			}
+
+			hookLog.WithError(err).Error("Error executing hook")
+			if h.hook.OnError == velerov1api.HookErrorModeContinue {
+				ctx.hookErrs <- hookErr{namespace: namespace, err: err, warning: true}
+				continue
+			}
+
+			// hooks default to failing, so don't run any subsequent hooks for this pod.
+			ctx.hookErrs <- hookErr{namespace: namespace, err: err}
+			return
+		}
+	}()
+}
+
+func hookSourceFor(name string) string {
+	if name == hookSourceAnnotation {
+		return "annotation"
+	}
+	return "restoreSpec"
+}
+
+// executeExecRestoreHook waits for the hook's container to be running in the pod, then
+// executes the hook. Waiting stops early if the restore is canceled.
+func (ctx *context) executeExecRestoreHook(log logrus.FieldLogger, podClient client.Dynamic, namespace, name string, h namedExecRestoreHook) error {
+	waitTimeout := h.hook.WaitTimeout.Duration
+	if waitTimeout == 0 {
+		waitTimeout = ctx.podVolumeTimeout
+	}
+
+	cancelCtx := ctx.cancelCtx
+	if cancelCtx == nil {
+		cancelCtx = go_context.Background()
+	}
+	waitCtx, cancel := go_context.WithTimeout(cancelCtx, waitTimeout)
+	defer cancel()
+
+	var pod *unstructured.Unstructured
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		res, err := podClient.Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false, errors.Errorf("pod %s/%s not found", namespace, name)
+		}
+		if err != nil {
+			log.WithError(err).Debug("Error getting pod, retrying")
+			return false, nil
+		}
+
+		running, err := isContainerRunning(res, h.hook.Container)
+		if err != nil {
+			return false, err
+		}
+		if !running {
+			log.Debug("Waiting for container to be running before executing hook")
+			return false, nil
+		}
+
+		pod = res
+		return true, nil
+	}, waitCtx.Done())
+	if err == wait.ErrWaitTimeout {
+		if ctx.canceled() {
+			return errors.New("restore was canceled while waiting for container to be running")
+		}
+		return errors.Errorf("timed out after %v waiting for container to be running", waitTimeout)
+	}
+	if err != nil {
+		return err
+	}
+
+	execHook := &velerov1api.ExecHook{
+		Container: h.hook.Container,
+		Command:   h.hook.Command,
+		OnError:   h.hook.OnError,
+		Timeout:   h.hook.ExecTimeout,
+	}
+
+	return ctx.podCommandExecutor.ExecutePodCommand(log, pod.UnstructuredContent(), namespace, name, h.name, execHook)
+}
+
+// isContainerRunning returns whether the named container in the pod is running. If
+// container is empty, the pod's first container is checked.
+func isContainerRunning(obj *unstructured.Unstructured, container string) (bool, error) {
+	pod := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pod); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	if container == "" {
+		if len(pod.Spec.Containers) == 0 {
+			return false, errors.New("need at least 1 container")
+		}
+		container = pod.Spec.Containers[0].Name
+	}
+
+	found := false
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false, errors.Errorf("no such container: %q", container)
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == container {
+			return status.State.Running != nil, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	go_context "context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+func toUnstructuredPod(t *testing.T, pod *corev1api.Pod) *unstructured.Unstructured {
+	t.Helper()
+
+	res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+
+	return &unstructured.Unstructured{Object: res}
+}
+
+func TestGetPodExecRestoreHookFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		expectedHook *velerov1api.ExecRestoreHook
+	}{
+		{
+			name:         "missing command annotation",
+			expectedHook: nil,
+		},
+		{
+			name: "malformed command json array",
+			annotations: map[string]string{
+				podRestoreHookCommandAnnotationKey: "[blarg",
+			},
+			expectedHook: &velerov1api.ExecRestoreHook{
+				Command: []string{"[blarg"},
+			},
+		},
+		{
+			name: "valid command json array",
+			annotations: map[string]string{
+				podRestoreHookCommandAnnotationKey: `["a","b","c"]`,
+			},
+			expectedHook: &velerov1api.ExecRestoreHook{
+				Command: []string{"a", "b", "c"},
+			},
+		},
+		{
+			name: "invalid on-error mode is ignored",
+			annotations: map[string]string{
+				podRestoreHookCommandAnnotationKey: "/usr/bin/foo",
+				podRestoreHookOnErrorAnnotationKey: "blah",
+			},
+			expectedHook: &velerov1api.ExecRestoreHook{
+				Command: []string{"/usr/bin/foo"},
+			},
+		},
+		{
+			name: "all annotations set",
+			annotations: map[string]string{
+				podRestoreHookContainerAnnotationKey:   "container1",
+				podRestoreHookCommandAnnotationKey:     "/usr/bin/foo",
+				podRestoreHookOnErrorAnnotationKey:     string(velerov1api.HookErrorModeContinue),
+				podRestoreHookExecTimeoutAnnotationKey: "45s",
+				podRestoreHookWaitTimeoutAnnotationKey: "5m",
+			},
+			expectedHook: &velerov1api.ExecRestoreHook{
+				Container:   "container1",
+				Command:     []string{"/usr/bin/foo"},
+				OnError:     velerov1api.HookErrorModeContinue,
+				ExecTimeout: metav1.Duration{Duration: 45 * time.Second},
+				WaitTimeout: metav1.Duration{Duration: 5 * time.Minute},
+			},
+		},
+		{
+			name: "invalid timeouts are ignored",
+			annotations: map[string]string{
+				podRestoreHookCommandAnnotationKey:     "/usr/bin/foo",
+				podRestoreHookExecTimeoutAnnotationKey: "invalid",
+				podRestoreHookWaitTimeoutAnnotationKey: "invalid",
+			},
+			expectedHook: &velerov1api.ExecRestoreHook{
+				Command: []string{"/usr/bin/foo"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedHook, getPodExecRestoreHookFromAnnotations(tc.annotations))
+		})
+	}
+}
+
+func TestGetPodInitContainerFromAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *corev1api.Container
+	}{
+		{
+			name:     "no annotations",
+			expected: nil,
+		},
+		{
+			name: "missing image annotation",
+			annotations: map[string]string{
+				podRestoreHookInitContainerCommandAnnotationKey: "/usr/bin/foo",
+			},
+			expected: nil,
+		},
+		{
+			name: "missing command annotation",
+			annotations: map[string]string{
+				podRestoreHookInitContainerImageAnnotationKey: "busybox",
+			},
+			expected: nil,
+		},
+		{
+			name: "default container name",
+			annotations: map[string]string{
+				podRestoreHookInitContainerImageAnnotationKey:   "busybox",
+				podRestoreHookInitContainerCommandAnnotationKey: `["/bin/sh", "-c", "echo hello"]`,
+			},
+			expected: &corev1api.Container{
+				Name:    "restore-hook",
+				Image:   "busybox",
+				Command: []string{"/bin/sh", "-c", "echo hello"},
+			},
+		},
+		{
+			name: "container name specified",
+			annotations: map[string]string{
+				podRestoreHookInitContainerNameAnnotationKey:    "my-hook",
+				podRestoreHookInitContainerImageAnnotationKey:   "busybox",
+				podRestoreHookInitContainerCommandAnnotationKey: "/usr/bin/foo",
+			},
+			expected: &corev1api.Container{
+				Name:    "my-hook",
+				Image:   "busybox",
+				Command: []string{"/usr/bin/foo"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getPodInitContainerFromAnnotations(tc.annotations))
+		})
+	}
+}
+
+func TestGetExecRestoreHooks(t *testing.T) {
+	execHook := func(command string) velerov1api.RestoreResourceHook {
+		return velerov1api.RestoreResourceHook{Exec: &velerov1api.ExecRestoreHook{Command: []string{command}}}
+	}
+
+	tests := []struct {
+		name          string
+		pod           *corev1api.Pod
+		namespace     string
+		resourceHooks []resourceRestoreHook
+		expected      []namedExecRestoreHook
+	}{
+		{
+			name:      "no annotations and no hooks",
+			pod:       builder.ForPod("ns-1", "pod-1").Result(),
+			namespace: "ns-1",
+			expected:  nil,
+		},
+		{
+			name: "annotation takes priority over spec hooks",
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations(podRestoreHookCommandAnnotationKey, "/usr/bin/foo")).
+				Result(),
+			namespace: "ns-1",
+			resourceHooks: []resourceRestoreHook{
+				{name: "hook-1", hooks: []velerov1api.RestoreResourceHook{execHook("/usr/bin/bar")}},
+			},
+			expected: []namedExecRestoreHook{
+				{name: hookSourceAnnotation, hook: velerov1api.ExecRestoreHook{Command: []string{"/usr/bin/foo"}}},
+			},
+		},
+		{
+			name:      "applicable spec hooks are returned in order, init hooks are ignored",
+			pod:       builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "foo")).Result(),
+			namespace: "ns-1",
+			resourceHooks: []resourceRestoreHook{
+				{
+					name:  "hook-1",
+					hooks: []velerov1api.RestoreResourceHook{execHook("/usr/bin/foo"), {Init: &velerov1api.InitRestoreHook{}}},
+				},
+				{
+					name:       "excluded-namespace",
+					namespaces: collections.NewIncludesExcludes().Excludes("ns-1"),
+					hooks:      []velerov1api.RestoreResourceHook{execHook("/usr/bin/baz")},
+				},
+				{
+					name:          "non-matching-selector",
+					labelSelector: labels.SelectorFromSet(labels.Set{"app": "bar"}),
+					hooks:         []velerov1api.RestoreResourceHook{execHook("/usr/bin/baz")},
+				},
+				{
+					name:          "matching-selector",
+					labelSelector: labels.SelectorFromSet(labels.Set{"app": "foo"}),
+					hooks:         []velerov1api.RestoreResourceHook{execHook("/usr/bin/bar")},
+				},
+			},
+			expected: []namedExecRestoreHook{
+				{name: "hook-1", hook: velerov1api.ExecRestoreHook{Command: []string{"/usr/bin/foo"}}},
+				{name: "matching-selector", hook: velerov1api.ExecRestoreHook{Command: []string{"/usr/bin/bar"}}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getExecRestoreHooks(toUnstructuredPod(t, tc.pod), tc.namespace, tc.resourceHooks))
+		})
+	}
+}
+
+func TestAddInitContainers(t *testing.T) {
+	tests := []struct {
+		name       string
+		pod        *corev1api.Pod
+		containers []corev1api.Container
+		expected   []string
+	}{
+		{
+			name:       "pod without init containers",
+			pod:        builder.ForPod("ns-1", "pod-1").Result(),
+			containers: []corev1api.Container{{Name: "hook-1"}, {Name: "hook-2"}},
+			expected:   []string{"hook-1", "hook-2"},
+		},
+		{
+			name: "hook containers are added before existing init containers",
+			pod: builder.ForPod("ns-1", "pod-1").
+				InitContainers(builder.ForContainer("init-1", "image").Result()).
+				Result(),
+			containers: []corev1api.Container{{Name: "hook-1"}},
+			expected:   []string{"hook-1", "init-1"},
+		},
+		{
+			name: "restic wait init container is kept first",
+			pod: builder.ForPod("ns-1", "pod-1").
+				InitContainers(
+					builder.ForContainer(restic.InitContainer, "image").Result(),
+					builder.ForContainer("init-1", "image").Result(),
+				).
+				Result(),
+			containers: []corev1api.Container{{Name: "hook-1"}, {Name: "hook-2"}},
+			expected:   []string{restic.InitContainer, "hook-1", "hook-2", "init-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addInitContainers(tc.pod, tc.containers)
+
+			var names []string
+			for _, c := range tc.pod.Spec.InitContainers {
+				names = append(names, c.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestIsContainerRunning(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+	pod.Spec.Containers = []corev1api.Container{{Name: "container-1"}, {Name: "container-2"}}
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{Name: "container-1", State: corev1api.ContainerState{Waiting: &corev1api.ContainerStateWaiting{}}},
+		{Name: "container-2", State: corev1api.ContainerState{Running: &corev1api.ContainerStateRunning{}}},
+	}
+	obj := toUnstructuredPod(t, pod)
+
+	running, err := isContainerRunning(obj, "")
+	require.NoError(t, err)
+	assert.False(t, running)
+
+	running, err = isContainerRunning(obj, "container-2")
+	require.NoError(t, err)
+	assert.True(t, running)
+
+	_, err = isContainerRunning(obj, "container-3")
+	assert.Error(t, err)
+}
+
+func TestExecuteExecRestoreHook(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+	pod.Spec.Containers = []corev1api.Container{{Name: "container-1"}}
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{Name: "container-1", State: corev1api.ContainerState{Running: &corev1api.ContainerStateRunning{}}},
+	}
+	runningPod := toUnstructuredPod(t, pod)
+
+	pod = pod.DeepCopy()
+	pod.Status.ContainerStatuses[0].State = corev1api.ContainerState{Waiting: &corev1api.ContainerStateWaiting{}}
+	waitingPod := toUnstructuredPod(t, pod)
+
+	hook := namedExecRestoreHook{
+		name: "hook-1",
+		hook: velerov1api.ExecRestoreHook{
+			Container:   "container-1",
+			Command:     []string{"/bin/true"},
+			WaitTimeout: metav1.Duration{Duration: time.Minute},
+		},
+	}
+
+	t.Run("errors getting the pod are retried", func(t *testing.T) {
+		podClient := new(velerotest.FakeDynamicClient)
+		podClient.On("Get", "pod-1", metav1.GetOptions{}).Return((*unstructured.Unstructured)(nil), errors.New("connection refused")).Once()
+		podClient.On("Get", "pod-1", metav1.GetOptions{}).Return(runningPod, nil)
+
+		podCommandExecutor := new(velerotest.MockPodCommandExecutor)
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, runningPod.UnstructuredContent(), "ns-1", "pod-1", "hook-1", mock.Anything).Return(nil)
+
+		ctx := &context{
+			log:                velerotest.NewLogger(),
+			podCommandExecutor: podCommandExecutor,
+		}
+
+		require.NoError(t, ctx.executeExecRestoreHook(ctx.log, podClient, "ns-1", "pod-1", hook))
+		podClient.AssertNumberOfCalls(t, "Get", 2)
+		podCommandExecutor.AssertExpectations(t)
+	})
+
+	t.Run("waiting for the container stops when the restore is canceled", func(t *testing.T) {
+		podClient := new(velerotest.FakeDynamicClient)
+		podClient.On("Get", "pod-1", metav1.GetOptions{}).Return(waitingPod, nil)
+
+		podCommandExecutor := new(velerotest.MockPodCommandExecutor)
+
+		cancelCtx, cancel := go_context.WithCancel(go_context.Background())
+		cancel()
+
+		ctx := &context{
+			log:                velerotest.NewLogger(),
+			podCommandExecutor: podCommandExecutor,
+			cancelCtx:          cancelCtx,
+		}
+
+		err := ctx.executeExecRestoreHook(ctx.log, podClient, "ns-1", "pod-1", hook)
+		assert.EqualError(t, err, "restore was canceled while waiting for container to be running")
+		podCommandExecutor.AssertNotCalled(t, "ExecutePodCommand", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRunExecRestoreHooksSkippedWhenCanceled(t *testing.T) {
+	pod := toUnstructuredPod(t, builder.ForPod("ns-1", "pod-1").Result())
+	podClient := new(velerotest.FakeDynamicClient)
+	podCommandExecutor := new(velerotest.MockPodCommandExecutor)
+
+	cancelCtx, cancel := go_context.WithCancel(go_context.Background())
+	cancel()
+
+	ctx := &context{
+		log:                velerotest.NewLogger(),
+		podCommandExecutor: podCommandExecutor,
+		cancelCtx:          cancelCtx,
+		hookErrs:           make(chan hookErr),
+	}
+
+	ctx.runExecRestoreHooks(pod, []namedExecRestoreHook{{name: "hook-1", hook: velerov1api.ExecRestoreHook{Command: []string{"/bin/true"}}}}, podClient)
+	ctx.hooksWaitGroup.Wait()
+
+	podClient.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	podCommandExecutor.AssertNotCalled(t, "ExecutePodCommand", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
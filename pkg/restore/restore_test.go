@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,7 +33,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -48,9 +51,11 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	resticmocks "github.com/vmware-tanzu/velero/pkg/restic/mocks"
 	"github.com/vmware-tanzu/velero/pkg/test"
@@ -561,6 +566,168 @@ func TestRestoreResourceFiltering(t *testing.T) {
 	}
 }
 
+// TestRestoreAPIGroupVersions runs restores with the APIGroupVersionsFeatureFlag enabled
+// of backups containing items at multiple API versions, and verifies that items are
+// restored at the best version served by the target cluster.
+func TestRestoreAPIGroupVersions(t *testing.T) {
+	features.NewFeatureFlagSet(features.APIGroupVersionsFeatureFlag)
+	defer features.NewFeatureFlagSet()
+
+	deploymentsV1beta1 := func(items ...metav1.Object) *test.APIResource {
+		res := test.Deployments(items...)
+		res.Version = "v1beta1"
+		return res
+	}
+	deploymentV1beta1 := func(ns, name string) *appsv1api.Deployment {
+		deploy := builder.ForDeployment(ns, name).Result()
+		deploy.APIVersion = "apps/v1beta1"
+		return deploy
+	}
+	versionedTarball := func(t *testing.T) io.Reader {
+		return newTarWriter(t).
+			addItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").Result()).
+			add("resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json", builder.ForDeployment("ns-1", "deploy-1").Result()).
+			add("resources/deployments.apps/v1beta1/namespaces/ns-1/deploy-1.json", deploymentV1beta1("ns-1", "deploy-1")).
+			done()
+	}
+
+	tests := []struct {
+		name         string
+		tarball      io.Reader
+		apiResources []*test.APIResource
+		want         map[*test.APIResource][]string
+	}{
+		{
+			name:         "when the cluster serves the backup's preferred version, items are restored at that version",
+			tarball:      versionedTarball(t),
+			apiResources: []*test.APIResource{test.Deployments(), deploymentsV1beta1()},
+			want: map[*test.APIResource][]string{
+				test.Deployments():   {"ns-1/deploy-1"},
+				deploymentsV1beta1(): {},
+			},
+		},
+		{
+			name:         "when the cluster only serves a non-preferred version of the backup, items are restored at that version",
+			tarball:      versionedTarball(t),
+			apiResources: []*test.APIResource{deploymentsV1beta1()},
+			want: map[*test.APIResource][]string{
+				test.Deployments():   {},
+				deploymentsV1beta1(): {"ns-1/deploy-1"},
+			},
+		},
+		{
+			name: "when the backup has no version directories, items are restored from their non-versioned path",
+			tarball: newTarWriter(t).
+				addItems("deployments.apps", builder.ForDeployment("ns-1", "deploy-1").Result()).
+				done(),
+			apiResources: []*test.APIResource{test.Deployments(), deploymentsV1beta1()},
+			want: map[*test.APIResource][]string{
+				test.Deployments():   {"ns-1/deploy-1"},
+				deploymentsV1beta1(): {},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      defaultRestore().Result(),
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
+// TestRestoreProgressIsUpdated verifies that after a restore has run, its Status.Progress
+// field reflects the items that were restored, excluding items that were filtered out.
+func TestRestoreProgressIsUpdated(t *testing.T) {
+	h := newHarness(t)
+
+	restore := defaultRestore().
+		IncludeClusterResources(false).
+		LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).
+		Result()
+
+	tarball := newTarWriter(t).
+		addItems("pods",
+			builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+			builder.ForPod("ns-2", "pod-2").Result(),
+		).
+		addItems("persistentvolumes",
+			builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("a", "b")).Result(),
+		).
+		done()
+
+	for _, r := range []*test.APIResource{test.Pods(), test.PVs()} {
+		h.DiscoveryClient.WithAPIResource(r)
+	}
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	data := Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: tarball,
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+	assertEmptyResults(t, warnings, errs)
+	require.NotNil(t, restore.Status.Progress)
+	assert.Equal(t, velerov1api.RestoreProgress{TotalItems: 1, ItemsRestored: 1}, *restore.Status.Progress)
+}
+
+// TestRestoreCanceled verifies that no items are restored when the restore's
+// context has been canceled.
+func TestRestoreCanceled(t *testing.T) {
+	h := newHarness(t)
+
+	restore := defaultRestore().Result()
+
+	tarball := newTarWriter(t).
+		addItems("pods",
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-2", "pod-2").Result(),
+		).
+		done()
+
+	h.DiscoveryClient.WithAPIResource(test.Pods())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	cancelCtx, cancel := ctx.WithCancel(ctx.Background())
+	cancel()
+
+	data := Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: tarball,
+		Context:      cancelCtx,
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+	assertEmptyResults(t, warnings, errs)
+	require.NotNil(t, restore.Status.Progress)
+	assert.Equal(t, 0, restore.Status.Progress.ItemsRestored)
+}
+
 // TestRestoreNamespaceMapping runs restores with namespace mappings specified,
 // and verifies that the set of items created in the API are in the correct
 // namespaces. Validation is done by looking at the namespaces/names of the items
@@ -1016,6 +1183,178 @@ func TestRestoreItems(t *testing.T) {
 	}
 }
 
+// TestRestoreExistingResourcePolicy runs restores of items that already exist in the cluster
+// with a different spec, and validates that they're left alone or updated according to the
+// restore's existing resource policy.
+func TestRestoreExistingResourcePolicy(t *testing.T) {
+	tests := []struct {
+		name                  string
+		restore               *velerov1api.Restore
+		backup                *velerov1api.Backup
+		apiResources          []*test.APIResource
+		tarball               io.Reader
+		want                  []*test.APIResource
+		patchErr              error
+		wantWarnings          Result
+		wantExistingResources map[string]ExistingResourceAction
+	}{
+		{
+			name:    "existing object is not updated and a warning is recorded when policy is empty",
+			restore: defaultRestore().Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key", "backed-up").Result()).
+				done(),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			want: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {`could not restore, configmaps "cm-1" already exists. Warning: the in-cluster version is different than the backed-up version.`},
+				},
+			},
+		},
+		{
+			name:    "existing object is not updated and a warning is recorded when policy is none",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeNone).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key", "backed-up").Result()).
+				done(),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			want: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {`could not restore, configmaps "cm-1" already exists. Warning: the in-cluster version is different than the backed-up version.`},
+				},
+			},
+		},
+		{
+			name:    "existing object is updated to match the backed-up version when policy is update",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key", "backed-up").Result()).
+				done(),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			want: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").
+						ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).
+						Data("key", "backed-up").
+						Result(),
+				),
+			},
+			wantExistingResources: map[string]ExistingResourceAction{
+				"configmaps/ns-1/cm-1": ExistingResourceActionUpdated,
+			},
+		},
+		{
+			name:    "existing object that can't be updated is recorded, with a warning, when policy is update",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key", "backed-up").Result()).
+				done(),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			patchErr: errors.New("forbidden"),
+			want: []*test.APIResource{
+				test.ConfigMaps(builder.ForConfigMap("ns-1", "cm-1").Data("key", "in-cluster").Result()),
+			},
+			wantWarnings: Result{
+				Namespaces: map[string][]string{
+					"ns-1": {"could not update existing configmaps/ns-1/cm-1 to match the backed-up version: forbidden"},
+				},
+			},
+			wantExistingResources: map[string]ExistingResourceAction{
+				"configmaps/ns-1/cm-1": ExistingResourceActionUpdateFailed,
+			},
+		},
+		{
+			name:    "existing object that is identical to the backed-up version is skipped when policy is update",
+			restore: defaultRestore().ExistingResourcePolicy(velerov1api.PolicyTypeUpdate).Result(),
+			backup:  defaultBackup().Result(),
+			tarball: newTarWriter(t).
+				addItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Data("key", "backed-up").Result()).
+				done(),
+			apiResources: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").
+						ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).
+						Data("key", "backed-up").
+						Result(),
+				),
+			},
+			want: []*test.APIResource{
+				test.ConfigMaps(
+					builder.ForConfigMap("ns-1", "cm-1").
+						ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).
+						Data("key", "backed-up").
+						Result(),
+				),
+			},
+			wantExistingResources: map[string]ExistingResourceAction{
+				"configmaps/ns-1/cm-1": ExistingResourceActionUnchanged,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.addItems(t, r)
+			}
+
+			if tc.patchErr != nil {
+				h.DynamicClient.PrependReactor("patch", "*", func(kubetesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tc.patchErr
+				})
+			}
+
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           tc.backup,
+				PodVolumeBackups: nil,
+				VolumeSnapshots:  nil,
+				BackupReader:     tc.tarball,
+			}
+			if tc.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+				data.ExistingResources = new(ExistingResources)
+			}
+			warnings, errs := h.restorer.Restore(
+				data,
+				nil, // actions
+				nil, // snapshot location lister
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, errs)
+			assert.Equal(t, tc.wantWarnings, warnings)
+			assertRestoredItems(t, h, tc.want)
+
+			var existingResources map[string]ExistingResourceAction
+			if data.ExistingResources != nil {
+				existingResources = data.ExistingResources.Items
+			}
+			assert.Equal(t, tc.wantExistingResources, existingResources)
+		})
+	}
+}
+
 // recordResourcesAction is a restore item action that can be configured
 // to run for specific resources/namespaces and simply records the items
 // that it is executed for.
@@ -1349,6 +1688,196 @@ func TestRestoreActionModifications(t *testing.T) {
 	}
 }
 
+// TestRestoreResourceModifiers runs restores with resource modifier rules and verifies that the
+// rules are applied to the matching items, after any restore item actions.
+func TestRestoreResourceModifiers(t *testing.T) {
+	rules := `
+version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+    resourceNameRegex: "^pod-1$"
+  patches:
+  - operation: replace
+    path: /metadata/labels/env
+    value: staging
+`
+	cm := builder.ForConfigMap(velerov1api.DefaultNamespace, "modifiers").Data("rules.yaml", rules).Result()
+	modifiers, err := resourcemodifiers.GetResourceModifiersFromConfig(cm)
+	require.NoError(t, err)
+
+	labellingAction := &pluggableAction{
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			obj := input.Item.(*unstructured.Unstructured).DeepCopy()
+			obj.SetLabels(map[string]string{"env": "prod"})
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		},
+	}
+
+	h := newHarness(t)
+	h.addItems(t, test.Pods())
+
+	restore := defaultRestore().Result()
+	data := Request{
+		Log:     h.log,
+		Restore: restore,
+		Backup:  defaultBackup().Result(),
+		BackupReader: newTarWriter(t).
+			addItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			done(),
+		ResourceModifiers: modifiers,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		[]velero.RestoreItemAction{labellingAction},
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("env", "staging", "velero.io/restore-name", restore.Name, "velero.io/backup-name", restore.Spec.BackupName)).Result(),
+			builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("env", "prod", "velero.io/restore-name", restore.Name, "velero.io/backup-name", restore.Spec.BackupName)).Result(),
+		),
+	})
+}
+
+// TestRestoreDryRun runs a dry-run restore and verifies that nothing is created
+// in the cluster, and that the plan records what would have been done with each item.
+func TestRestoreDryRun(t *testing.T) {
+	skippingAction := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedResources: []string{"configmaps"}},
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			return velero.NewRestoreItemActionExecuteOutput(input.Item).WithoutRestore(), nil
+		},
+	}
+
+	h := newHarness(t)
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+		builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("env", "prod")).Result(),
+	))
+	h.addItems(t, test.ConfigMaps())
+
+	restore := defaultRestore().DryRun(true).Result()
+	data := Request{
+		Log:     h.log,
+		Restore: restore,
+		Backup:  defaultBackup().Result(),
+		BackupReader: newTarWriter(t).
+			addItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+				builder.ForPod("ns-1", "pod-3").Result(),
+			).
+			addItems("configmaps", builder.ForConfigMap("ns-1", "cm-1").Result()).
+			done(),
+		Plan: new(Plan),
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		[]velero.RestoreItemAction{skippingAction},
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, warnings, errs)
+	assert.ElementsMatch(t, []PlanItem{
+		{Resource: "namespaces", Name: "ns-1", Action: PlanActionCreate},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-1", Action: PlanActionExistsIdentical},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-2", Action: PlanActionExistsDifferent},
+		{Resource: "pods", Namespace: "ns-1", Name: "pod-3", Action: PlanActionCreate},
+		{Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: PlanActionSkippedByAction},
+	}, data.Plan.Items)
+
+	pods, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").List(metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+
+	_, err = h.KubeClient.CoreV1().Namespaces().Get("ns-1", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+// TestRestoreWithItemWorkers verifies that when a restore's items are restored
+// concurrently, every item is created, items returned as additional items for
+// several items at once are only restored once, and the results from all of the
+// items are merged.
+func TestRestoreWithItemWorkers(t *testing.T) {
+	var (
+		backedUp []metav1.Object
+		existing []metav1.Object
+		want     []string
+	)
+	for i := 0; i < 30; i++ {
+		ns, name := fmt.Sprintf("ns-%d", i%3), fmt.Sprintf("pod-%d", i)
+		backedUp = append(backedUp, builder.ForPod(ns, name).Result())
+		want = append(want, ns+"/"+name)
+
+		// the first pod in each namespace already exists, and is different
+		// from the backed-up version.
+		if i < 3 {
+			existing = append(existing, builder.ForPod(ns, name).ObjectMeta(builder.WithLabels("env", "prod")).Result())
+		}
+	}
+
+	// every pod returns the same config map as an additional item.
+	var executions int32
+	action := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedResources: []string{"configmaps"}},
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			atomic.AddInt32(&executions, 1)
+			return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
+		},
+	}
+	podAction := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			return &velero.RestoreItemActionExecuteOutput{
+				UpdatedItem: input.Item,
+				AdditionalItems: []velero.ResourceIdentifier{
+					{GroupResource: schema.GroupResource{Resource: "configmaps"}, Namespace: "ns-0", Name: "cm-1"},
+				},
+			}, nil
+		},
+	}
+
+	h := newHarness(t)
+	h.addItems(t, test.Pods(existing...))
+	h.addItems(t, test.ConfigMaps())
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().ItemWorkers(4).Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: newTarWriter(t).
+			addItems("pods", backedUp...).
+			addItems("configmaps", builder.ForConfigMap("ns-0", "cm-1").Result()).
+			done(),
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		[]velero.RestoreItemAction{podAction, action},
+		nil, // snapshot location lister
+		nil, // volume snapshotter getter
+	)
+
+	assertEmptyResults(t, errs)
+	assert.Len(t, warnings.Namespaces, 3)
+	for ns, nsWarnings := range warnings.Namespaces {
+		assert.Len(t, nsWarnings, 1, "namespace %s", ns)
+	}
+
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.Pods():       want,
+		test.ConfigMaps(): {"ns-0/cm-1"},
+	})
+	assert.Equal(t, int32(1), atomic.LoadInt32(&executions))
+}
+
 // TestRestoreActionAdditionalItems runs restores with restore item actions that return additional items
 // to be restored, and verifies that that the correct set of items is created in the API. Verification is
 // done by looking at the namespaces/names of the items in the API; contents are not checked.
@@ -2487,12 +3016,13 @@ func TestRestoreWithRestic(t *testing.T) {
 
 func TestPrioritizeResources(t *testing.T) {
 	tests := []struct {
-		name         string
-		apiResources map[string][]string
-		priorities   []string
-		includes     []string
-		excludes     []string
-		expected     []string
+		name          string
+		apiResources  map[string][]string
+		priorities    []string
+		includes      []string
+		excludes      []string
+		expected      []string
+		expectedTiers [][]string
 	}{
 		{
 			name: "priorities & ordering are correctly applied",
@@ -2502,6 +3032,9 @@ func TestPrioritizeResources(t *testing.T) {
 			priorities: []string{"namespaces", "configmaps", "pods"},
 			includes:   []string{"*"},
 			expected:   []string{"namespaces", "configmaps", "pods", "aaa", "bbb", "ddd", "ooo", "sss"},
+			expectedTiers: [][]string{
+				{"namespaces"}, {"configmaps"}, {"pods"}, {"aaa", "bbb", "ddd", "ooo", "sss"},
+			},
 		},
 		{
 			name: "includes are correctly applied",
@@ -2511,6 +3044,9 @@ func TestPrioritizeResources(t *testing.T) {
 			priorities: []string{"namespaces", "configmaps", "pods"},
 			includes:   []string{"namespaces", "aaa", "sss"},
 			expected:   []string{"namespaces", "aaa", "sss"},
+			expectedTiers: [][]string{
+				{"namespaces"}, {"aaa", "sss"},
+			},
 		},
 		{
 			name: "excludes are correctly applied",
@@ -2521,6 +3057,9 @@ func TestPrioritizeResources(t *testing.T) {
 			includes:   []string{"*"},
 			excludes:   []string{"ooo", "pods"},
 			expected:   []string{"namespaces", "configmaps", "aaa", "bbb", "ddd", "sss"},
+			expectedTiers: [][]string{
+				{"namespaces"}, {"configmaps"}, {"aaa", "bbb", "ddd", "sss"},
+			},
 		},
 	}
 
@@ -2563,6 +3102,16 @@ func TestPrioritizeResources(t *testing.T) {
 					t.Errorf("index %d, expected %s, got %s", i, e, a)
 				}
 			}
+
+			var tiers [][]string
+			for _, tier := range priorityTiers(helper, tc.priorities, result) {
+				var resources []string
+				for _, resource := range tier {
+					resources = append(resources, resource.Resource)
+				}
+				tiers = append(tiers, resources)
+			}
+			assert.Equal(t, tc.expectedTiers, tiers)
 		})
 	}
 }
@@ -2595,7 +3144,7 @@ func TestResetMetadataAndStatus(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			res, err := resetMetadataAndStatus(test.obj)
+			res, err := ResetMetadataAndStatus(test.obj)
 
 			if assert.Equal(t, test.expectedErr, err != nil) {
 				assert.Equal(t, test.expectedRes, res)
@@ -2673,14 +3222,6 @@ func TestIsCompleted(t *testing.T) {
 	}
 }
 
-func TestGetItemFilePath(t *testing.T) {
-	res := getItemFilePath("root", "resource", "", "item")
-	assert.Equal(t, "root/resources/resource/cluster/item.json", res)
-
-	res = getItemFilePath("root", "resource", "namespace", "item")
-	assert.Equal(t, "root/resources/resource/namespaces/namespace/item.json", res)
-}
-
 // assertResourceCreationOrder ensures that resources were created in the expected
 // order. Any resources *not* in resourcePriorities are required to come *after* all
 // resources in any order.
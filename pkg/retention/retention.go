@@ -0,0 +1,157 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package retention evaluates a schedule's grandfather-father-son retention
+// policy against its backups.
+package retention
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// ReasonLast is the reason given for backups kept by the KeepLast rule.
+	ReasonLast = "last"
+	// ReasonHourly is the reason given for backups kept by the KeepHourly rule.
+	ReasonHourly = "hourly"
+	// ReasonDaily is the reason given for backups kept by the KeepDaily rule.
+	ReasonDaily = "daily"
+	// ReasonWeekly is the reason given for backups kept by the KeepWeekly rule.
+	ReasonWeekly = "weekly"
+	// ReasonMonthly is the reason given for backups kept by the KeepMonthly rule.
+	ReasonMonthly = "monthly"
+	// ReasonYearly is the reason given for backups kept by the KeepYearly rule.
+	ReasonYearly = "yearly"
+	// ReasonNoRules is the reason given for every backup when the policy
+	// doesn't have any rules.
+	ReasonNoRules = "no retention rules"
+	// ReasonNewestSuccessful is the reason given for the newest Completed
+	// backup when none of the policy's rules keep it.
+	ReasonNewestSuccessful = "newest successful"
+)
+
+// Decision records whether a backup is kept by a retention policy, and why.
+type Decision struct {
+	Backup *velerov1api.Backup
+
+	// Reasons are the rules that keep the backup. If it's empty, the
+	// backup is pruned.
+	Reasons []string
+}
+
+// Keep returns true if the backup is kept.
+func (d Decision) Keep() bool {
+	return len(d.Reasons) > 0
+}
+
+// Enabled returns true if the policy has at least one rule. A policy without
+// rules keeps every backup.
+func Enabled(policy *velerov1api.RetentionPolicy) bool {
+	return policy != nil &&
+		policy.KeepLast+policy.KeepHourly+policy.KeepDaily+policy.KeepWeekly+policy.KeepMonthly+policy.KeepYearly > 0
+}
+
+// Evaluate applies the retention policy to a schedule's backups. Only
+// Completed and PartiallyFailed backups are subject to the policy, so the
+// backups in other phases are left out of the result. The decisions are
+// returned newest first. If the policy isn't enabled, every backup is kept.
+func Evaluate(policy *velerov1api.RetentionPolicy, backups []*velerov1api.Backup) []Decision {
+	var decisions []Decision
+	for _, backup := range backups {
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed:
+			decisions = append(decisions, Decision{Backup: backup})
+		}
+	}
+
+	sort.SliceStable(decisions, func(i, j int) bool {
+		return backupTime(decisions[i].Backup).After(backupTime(decisions[j].Backup))
+	})
+
+	if !Enabled(policy) {
+		for i := range decisions {
+			decisions[i].Reasons = []string{ReasonNoRules}
+		}
+		return decisions
+	}
+
+	for i := range decisions {
+		if i < policy.KeepLast {
+			decisions[i].Reasons = append(decisions[i].Reasons, ReasonLast)
+		}
+	}
+
+	rules := []struct {
+		reason string
+		count  int
+		bucket func(time.Time) string
+	}{
+		{ReasonHourly, policy.KeepHourly, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{ReasonDaily, policy.KeepDaily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{ReasonWeekly, policy.KeepWeekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{ReasonMonthly, policy.KeepMonthly, func(t time.Time) string { return t.Format("2006-01") }},
+		{ReasonYearly, policy.KeepYearly, func(t time.Time) string { return t.Format("2006") }},
+	}
+
+	for _, rule := range rules {
+		// since the decisions are sorted newest first, the first backup
+		// seen in each bucket is the newest one in it.
+		var lastBucket string
+		kept := 0
+		for i := range decisions {
+			if kept >= rule.count {
+				break
+			}
+
+			bucket := rule.bucket(backupTime(decisions[i].Backup).UTC())
+			if bucket == lastBucket {
+				continue
+			}
+			lastBucket = bucket
+
+			decisions[i].Reasons = append(decisions[i].Reasons, rule.reason)
+			kept++
+		}
+	}
+
+	for i := range decisions {
+		if decisions[i].Backup.Status.Phase != velerov1api.BackupPhaseCompleted {
+			continue
+		}
+		if !decisions[i].Keep() {
+			decisions[i].Reasons = append(decisions[i].Reasons, ReasonNewestSuccessful)
+		}
+		break
+	}
+
+	return decisions
+}
+
+// backupTime returns the time a backup was started, or its creation time if it
+// doesn't have a start time.
+func backupTime(backup *velerov1api.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func backupAt(name, timestamp string, phase velerov1api.BackupPhase) *velerov1api.Backup {
+	t, _ := time.Parse(time.RFC3339, timestamp)
+	return builder.ForBackup(velerov1api.DefaultNamespace, name).StartTimestamp(t).Phase(phase).Result()
+}
+
+func TestEnabled(t *testing.T) {
+	assert.False(t, Enabled(nil))
+	assert.False(t, Enabled(&velerov1api.RetentionPolicy{}))
+	assert.True(t, Enabled(&velerov1api.RetentionPolicy{KeepDaily: 7}))
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *velerov1api.RetentionPolicy
+		backups []*velerov1api.Backup
+		want    map[string][]string
+	}{
+		{
+			name:   "policy without rules keeps every backup",
+			policy: &velerov1api.RetentionPolicy{},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2020-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-02T01:00:00Z", velerov1api.BackupPhaseCompleted),
+			},
+			want: map[string][]string{
+				"b-2": {ReasonNoRules},
+				"b-1": {ReasonNoRules},
+			},
+		},
+		{
+			name:   "keep last keeps the most recent backups",
+			policy: &velerov1api.RetentionPolicy{KeepLast: 2},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2020-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-3", "2020-06-03T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-02T01:00:00Z", velerov1api.BackupPhaseCompleted),
+			},
+			want: map[string][]string{
+				"b-3": {ReasonLast},
+				"b-2": {ReasonLast},
+				"b-1": nil,
+			},
+		},
+		{
+			name:   "backups that aren't completed or partially failed are ignored",
+			policy: &velerov1api.RetentionPolicy{KeepLast: 1},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2020-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-02T01:00:00Z", velerov1api.BackupPhaseFailed),
+				backupAt("b-3", "2020-06-03T01:00:00Z", velerov1api.BackupPhaseInProgress),
+				backupAt("b-4", "2020-06-04T01:00:00Z", velerov1api.BackupPhaseDeleting),
+			},
+			want: map[string][]string{
+				"b-1": {ReasonLast},
+			},
+		},
+		{
+			name:   "daily keeps the newest backup of each day",
+			policy: &velerov1api.RetentionPolicy{KeepDaily: 2},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2020-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-01T13:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-3", "2020-06-02T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-4", "2020-06-02T13:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-5", "2020-05-31T13:00:00Z", velerov1api.BackupPhaseCompleted),
+			},
+			want: map[string][]string{
+				"b-4": {ReasonDaily},
+				"b-3": nil,
+				"b-2": {ReasonDaily},
+				"b-1": nil,
+				"b-5": nil,
+			},
+		},
+		{
+			name:   "days are in UTC",
+			policy: &velerov1api.RetentionPolicy{KeepDaily: 2},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2020-06-01T23:00:00-05:00", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-02T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-3", "2020-06-01T12:00:00Z", velerov1api.BackupPhaseCompleted),
+			},
+			want: map[string][]string{
+				"b-1": {ReasonDaily},
+				"b-2": nil,
+				"b-3": {ReasonDaily},
+			},
+		},
+		{
+			name: "7 daily, 4 weekly and 12 monthly",
+			policy: &velerov1api.RetentionPolicy{
+				KeepDaily:   7,
+				KeepWeekly:  4,
+				KeepMonthly: 12,
+			},
+			backups: dailyBackups("2020-01-01T02:00:00Z", 366),
+			want: func() map[string][]string {
+				want := make(map[string][]string)
+				for _, backup := range dailyBackups("2020-01-01T02:00:00Z", 366) {
+					want[backup.Name] = nil
+				}
+				// the 7 most recent days
+				for _, day := range []string{"2020-12-31", "2020-12-30", "2020-12-29", "2020-12-28", "2020-12-27", "2020-12-26", "2020-12-25"} {
+					want[day] = append(want[day], ReasonDaily)
+				}
+				// the newest backup of the 4 most recent ISO weeks: W53 (ends
+				// on 2021-01-03), W52, W51 and W50 all end on a Sunday.
+				for _, day := range []string{"2020-12-31", "2020-12-27", "2020-12-20", "2020-12-13"} {
+					want[day] = append(want[day], ReasonWeekly)
+				}
+				// the last day of each month
+				for _, day := range []string{"2020-12-31", "2020-11-30", "2020-10-31", "2020-09-30", "2020-08-31", "2020-07-31",
+					"2020-06-30", "2020-05-31", "2020-04-30", "2020-03-31", "2020-02-29", "2020-01-31"} {
+					want[day] = append(want[day], ReasonMonthly)
+				}
+				return want
+			}(),
+		},
+		{
+			name:   "rules are combined",
+			policy: &velerov1api.RetentionPolicy{KeepLast: 1, KeepHourly: 2, KeepYearly: 2},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2019-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-3", "2020-06-01T02:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-4", "2020-06-01T02:30:00Z", velerov1api.BackupPhaseCompleted),
+			},
+			want: map[string][]string{
+				"b-4": {ReasonLast, ReasonHourly, ReasonYearly},
+				"b-3": nil,
+				"b-2": {ReasonHourly},
+				"b-1": {ReasonYearly},
+			},
+		},
+		{
+			name:   "newest completed backup is always kept",
+			policy: &velerov1api.RetentionPolicy{KeepLast: 1},
+			backups: []*velerov1api.Backup{
+				backupAt("b-1", "2020-06-01T01:00:00Z", velerov1api.BackupPhaseCompleted),
+				backupAt("b-2", "2020-06-02T01:00:00Z", velerov1api.BackupPhasePartiallyFailed),
+				backupAt("b-3", "2020-05-31T01:00:00Z", velerov1api.BackupPhaseCompleted),
+			},
+			want: map[string][]string{
+				"b-2": {ReasonLast},
+				"b-1": {ReasonNewestSuccessful},
+				"b-3": nil,
+			},
+		},
+		{
+			name:   "creation time is used if there's no start time",
+			policy: &velerov1api.RetentionPolicy{KeepLast: 1},
+			backups: []*velerov1api.Backup{
+				builder.ForBackup(velerov1api.DefaultNamespace, "b-1").
+					ObjectMeta(builder.WithCreationTimestamp(time.Date(2020, 6, 2, 0, 0, 0, 0, time.UTC))).
+					Phase(velerov1api.BackupPhasePartiallyFailed).
+					Result(),
+				backupAt("b-2", "2020-06-01T01:00:00Z", velerov1api.BackupPhasePartiallyFailed),
+			},
+			want: map[string][]string{
+				"b-1": {ReasonLast},
+				"b-2": nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decisions := Evaluate(tc.policy, tc.backups)
+
+			got := make(map[string][]string)
+			for _, decision := range decisions {
+				got[decision.Backup.Name] = decision.Reasons
+				assert.Equal(t, len(decision.Reasons) > 0, decision.Keep())
+			}
+			assert.Equal(t, tc.want, got)
+
+			for i := 1; i < len(decisions); i++ {
+				assert.False(t, backupTime(decisions[i].Backup).After(backupTime(decisions[i-1].Backup)), "decisions should be sorted newest first")
+			}
+		})
+	}
+}
+
+// dailyBackups returns the given number of Completed backups, one per day
+// starting at the given time, each named after its date.
+func dailyBackups(start string, days int) []*velerov1api.Backup {
+	t, _ := time.Parse(time.RFC3339, start)
+
+	var backups []*velerov1api.Backup
+	for i := 0; i < days; i++ {
+		ts := t.AddDate(0, 0, i)
+		backups = append(backups, backupAt(ts.Format("2006-01-02"), ts.Format(time.RFC3339), velerov1api.BackupPhaseCompleted))
+	}
+	return backups
+}
@@ -101,3 +101,7 @@ func (fs *FakeFileSystem) WithDirectories(path ...string) *FakeFileSystem {
 func (fs *FakeFileSystem) TempFile(dir, prefix string) (filesystem.NameWriteCloser, error) {
 	return afero.TempFile(fs.fs, dir, prefix)
 }
+
+func (fs *FakeFileSystem) Rename(oldpath, newpath string) error {
+	return fs.fs.Rename(oldpath, newpath)
+}
@@ -86,6 +86,17 @@ func Secrets(items ...metav1.Object) *APIResource {
 	}
 }
 
+func ConfigMaps(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "",
+		Version:    "v1",
+		Name:       "configmaps",
+		ShortName:  "cm",
+		Namespaced: true,
+		Items:      items,
+	}
+}
+
 func Deployments(items ...metav1.Object) *APIResource {
 	return &APIResource{
 		Group:      "apps",
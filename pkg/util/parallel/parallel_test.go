@@ -0,0 +1,83 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallel
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEach(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		n       int
+	}{
+		{name: "no workers", workers: 0, n: 10},
+		{name: "one worker", workers: 1, n: 10},
+		{name: "fewer workers than calls", workers: 3, n: 10},
+		{name: "more workers than calls", workers: 20, n: 10},
+		{name: "no calls", workers: 3, n: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				lock   sync.Mutex
+				called = map[int]int{}
+				active int32
+				max    int32
+			)
+
+			ForEach(tc.workers, tc.n, func(i int) {
+				current := atomic.AddInt32(&active, 1)
+				defer atomic.AddInt32(&active, -1)
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				called[i]++
+				if current > max {
+					max = current
+				}
+			})
+
+			assert.Len(t, called, tc.n)
+			for i := 0; i < tc.n; i++ {
+				assert.Equal(t, 1, called[i])
+			}
+
+			limit := int32(tc.workers)
+			if limit < 1 {
+				limit = 1
+			}
+			assert.True(t, max <= limit, "at most %d calls should run at once, got %d", limit, max)
+		})
+	}
+}
+
+func TestForEachSequentialIsInOrder(t *testing.T) {
+	var order []int
+	ForEach(1, 5, func(i int) {
+		order = append(order, i)
+	})
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, order)
+}
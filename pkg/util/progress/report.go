@@ -0,0 +1,46 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import "time"
+
+// Report calls report with the tracker's counts every interval until quit is
+// closed, e.g. to patch them into a backup's or restore's status. Counts that
+// haven't changed since report last succeeded aren't reported again, and counts
+// that report returned an error for are reported again on the next tick.
+func Report(tracker *Tracker, interval time.Duration, quit <-chan struct{}, report func(totalItems, itemsDone int) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastTotalItems, lastItemsDone int
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			totalItems, itemsDone := tracker.Counts()
+			if totalItems == lastTotalItems && itemsDone == lastItemsDone {
+				continue
+			}
+
+			if err := report(totalItems, itemsDone); err != nil {
+				continue
+			}
+			lastTotalItems, lastItemsDone = totalItems, itemsDone
+		}
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	tracker := NewTracker()
+	tracker.AddTotalItems(2)
+
+	type counts struct{ totalItems, itemsDone int }
+	reported := make(chan counts)
+	results := []error{errors.New("conflict"), nil, nil}
+	report := func(totalItems, itemsDone int) error {
+		reported <- counts{totalItems, itemsDone}
+		err := results[0]
+		results = results[1:]
+		return err
+	}
+
+	quit := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Report(tracker, time.Millisecond, quit, report)
+	}()
+
+	// counts that failed to be reported are reported again
+	assert.Equal(t, counts{2, 0}, <-reported)
+	assert.Equal(t, counts{2, 0}, <-reported)
+
+	// unchanged counts aren't reported again, so the next report has the new counts
+	tracker.ItemDone()
+	assert.Equal(t, counts{2, 1}, <-reported)
+
+	close(quit)
+	<-done
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import "sync"
+
+// Tracker keeps track of the estimated total number of items in a backup or
+// restore and the number of items that have been processed so far. It's safe
+// for concurrent use, and a nil *Tracker ignores all updates.
+type Tracker struct {
+	lock       sync.Mutex
+	totalItems int
+	itemsDone  int
+}
+
+// NewTracker returns a Tracker with no items.
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// AddTotalItems adds the specified number of items to the estimated total, e.g.
+// after a resource's items have been listed. A negative count removes items that
+// turned out not to need processing.
+func (t *Tracker) AddTotalItems(count int) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.totalItems += count
+}
+
+// ItemDone records that an item has been processed.
+func (t *Tracker) ItemDone() {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.itemsDone++
+}
+
+// Counts returns the estimated total number of items and the number of items
+// processed so far. Since the estimate doesn't include items that are only
+// discovered while processing, e.g. additional items returned by plugins, the
+// total is never reported as less than the number of items processed.
+func (t *Tracker) Counts() (totalItems, itemsDone int) {
+	if t == nil {
+		return 0, 0
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.itemsDone > t.totalItems {
+		return t.itemsDone, t.itemsDone
+	}
+	return t.totalItems, t.itemsDone
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker()
+
+	tracker.AddTotalItems(3)
+	tracker.ItemDone()
+	total, done := tracker.Counts()
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 1, done)
+
+	// items that don't need processing are removed from the total
+	tracker.AddTotalItems(-1)
+	tracker.ItemDone()
+	total, done = tracker.Counts()
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 2, done)
+
+	// the total is never less than the number of items processed
+	tracker.ItemDone()
+	total, done = tracker.Counts()
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 3, done)
+
+	// a nil tracker ignores updates
+	var nilTracker *Tracker
+	nilTracker.AddTotalItems(1)
+	nilTracker.ItemDone()
+	total, done = nilTracker.Counts()
+	assert.Zero(t, total)
+	assert.Zero(t, done)
+}